@@ -0,0 +1,224 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	// FlagAlertWebhooks is the app.toml key for the webhook URLs that receive
+	// alerts.
+	FlagAlertWebhooks = "alerts.webhooks"
+	// FlagAlertEvents is the app.toml key for the event types that trigger an
+	// alert, e.g. "nibiru.perp.v2.EventShiftPegMultiplier".
+	FlagAlertEvents = "alerts.events"
+
+	alertQueueSize      = 256
+	alertRequestTimeout = 5 * time.Second
+)
+
+// AlertConfigTemplate is appended to the app.toml template.
+const AlertConfigTemplate = `
+###############################################################################
+###                                 Alerts                                  ###
+###############################################################################
+
+[alerts]
+
+# Webhook URLs that receive a JSON POST for every event listed in "events"
+# emitted while executing a block. Alerting is disabled if empty.
+webhooks = [{{ range .Alerts.Webhooks }}{{ printf "%q, " . }}{{end}}]
+
+# Event types that trigger an alert, e.g. "nibiru.perp.v2.EventShiftPegMultiplier".
+events = [{{ range .Alerts.Events }}{{ printf "%q, " . }}{{end}}]
+`
+
+// AlertConfig configures the node-side alerting hook. It has no effect on
+// consensus: alerts are delivered on a best-effort basis from a background
+// goroutine and dropped if the webhooks cannot keep up.
+type AlertConfig struct {
+	Webhooks []string `mapstructure:"webhooks"`
+	Events   []string `mapstructure:"events"`
+}
+
+// AlertConfigFromAppOpts reads the [alerts] section of app.toml.
+func AlertConfigFromAppOpts(appOpts servertypes.AppOptions) AlertConfig {
+	return AlertConfig{
+		Webhooks: cast.ToStringSlice(appOpts.Get(FlagAlertWebhooks)),
+		Events:   cast.ToStringSlice(appOpts.Get(FlagAlertEvents)),
+	}
+}
+
+// Alert is the JSON payload posted to the webhooks.
+type Alert struct {
+	Height     int64             `json:"height"`
+	Stage      string            `json:"stage"`
+	TxHash     string            `json:"tx_hash,omitempty"`
+	EventType  string            `json:"event_type"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+var _ baseapp.StreamingService = (*AlertService)(nil)
+
+// AlertService is a streaming service that watches the events of every block
+// and posts the configured ones to webhooks, so that operators can be paged
+// without running an indexer.
+type AlertService struct {
+	webhooks []string
+	events   map[string]bool
+	client   *http.Client
+	logger   log.Logger
+
+	queue chan Alert
+	done  chan struct{}
+	wg    sync.WaitGroup
+}
+
+// NewAlertService creates an AlertService and starts its delivery goroutine.
+func NewAlertService(cfg AlertConfig, logger log.Logger) *AlertService {
+	events := make(map[string]bool, len(cfg.Events))
+	for _, eventType := range cfg.Events {
+		events[eventType] = true
+	}
+
+	s := &AlertService{
+		webhooks: cfg.Webhooks,
+		events:   events,
+		client:   &http.Client{Timeout: alertRequestTimeout},
+		logger:   logger.With("module", "alerts"),
+		queue:    make(chan Alert, alertQueueSize),
+		done:     make(chan struct{}),
+	}
+	s.wg.Add(1)
+	go s.deliver()
+	return s
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (s *AlertService) ListenBeginBlock(
+	_ context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock,
+) error {
+	s.collect(req.Header.Height, "begin_block", "", res.Events)
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.
+func (s *AlertService) ListenEndBlock(
+	_ context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock,
+) error {
+	s.collect(req.Height, "end_block", "", res.Events)
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener. Events of failed txs are
+// ignored since their state changes were reverted.
+func (s *AlertService) ListenDeliverTx(
+	goCtx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx,
+) error {
+	if res.IsErr() {
+		return nil
+	}
+	height := sdk.UnwrapSDKContext(goCtx).BlockHeight()
+	txHash := fmt.Sprintf("%X", tmtypes.Tx(req.Tx).Hash())
+	s.collect(height, "deliver_tx", txHash, res.Events)
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener.
+func (s *AlertService) ListenCommit(context.Context, abci.ResponseCommit) error {
+	return nil
+}
+
+// Stream implements baseapp.StreamingService. Delivery is started by
+// NewAlertService, so there is nothing to do here.
+func (s *AlertService) Stream(*sync.WaitGroup) error { return nil }
+
+// Listeners implements baseapp.StreamingService. The service does not listen
+// to store writes.
+func (s *AlertService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Close stops the delivery goroutine after the queued alerts are sent.
+func (s *AlertService) Close() error {
+	close(s.done)
+	s.wg.Wait()
+	return nil
+}
+
+func (s *AlertService) collect(height int64, stage, txHash string, events []abci.Event) {
+	for _, event := range events {
+		if !s.events[event.Type] {
+			continue
+		}
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		alert := Alert{
+			Height:     height,
+			Stage:      stage,
+			TxHash:     txHash,
+			EventType:  event.Type,
+			Attributes: attrs,
+		}
+		// Never block block execution on slow webhooks.
+		select {
+		case s.queue <- alert:
+		default:
+			s.logger.Error("alert queue full, dropping alert", "event", event.Type, "height", height)
+		}
+	}
+}
+
+func (s *AlertService) deliver() {
+	defer s.wg.Done()
+	for {
+		select {
+		case alert := <-s.queue:
+			s.post(alert)
+		case <-s.done:
+			for {
+				select {
+				case alert := <-s.queue:
+					s.post(alert)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *AlertService) post(alert Alert) {
+	bz, err := json.Marshal(alert)
+	if err != nil {
+		s.logger.Error("failed to encode alert", "error", err)
+		return
+	}
+	for _, url := range s.webhooks {
+		resp, err := s.client.Post(url, "application/json", bytes.NewReader(bz))
+		if err != nil {
+			s.logger.Error("failed to send alert", "webhook", url, "error", err)
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			s.logger.Error("webhook rejected alert", "webhook", url, "status", resp.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package app_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+)
+
+func TestAlertService(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		alerts []app.Alert
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert app.Alert
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+		mu.Lock()
+		alerts = append(alerts, alert)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	service := app.NewAlertService(app.AlertConfig{
+		Webhooks: []string{server.URL},
+		Events:   []string{"critical"},
+	}, log.NewNopLogger())
+
+	events := []abci.Event{
+		{Type: "ignored"},
+		{Type: "critical", Attributes: []abci.EventAttribute{{Key: "pair", Value: "ubtc:unusd"}}},
+	}
+	ctx := sdk.Context{}.WithBlockHeight(10)
+
+	require.NoError(t, service.ListenBeginBlock(ctx,
+		abci.RequestBeginBlock{Header: tmproto.Header{Height: 10}},
+		abci.ResponseBeginBlock{Events: events},
+	))
+	require.NoError(t, service.ListenDeliverTx(ctx,
+		abci.RequestDeliverTx{Tx: []byte("tx")},
+		abci.ResponseDeliverTx{Events: events},
+	))
+	// events of failed txs do not trigger alerts
+	require.NoError(t, service.ListenDeliverTx(ctx,
+		abci.RequestDeliverTx{Tx: []byte("failed tx")},
+		abci.ResponseDeliverTx{Code: 1, Events: events},
+	))
+	require.NoError(t, service.ListenEndBlock(ctx,
+		abci.RequestEndBlock{Height: 10},
+		abci.ResponseEndBlock{Events: events},
+	))
+	require.NoError(t, service.Close())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, alerts, 3)
+	for i, stage := range []string{"begin_block", "deliver_tx", "end_block"} {
+		require.Equal(t, stage, alerts[i].Stage)
+		require.EqualValues(t, 10, alerts[i].Height)
+		require.Equal(t, "critical", alerts[i].EventType)
+		require.Equal(t, map[string]string{"pair": "ubtc:unusd"}, alerts[i].Attributes)
+	}
+	require.Empty(t, alerts[0].TxHash)
+	require.NotEmpty(t, alerts[1].TxHash)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/NibiruChain/nibiru/app/ante"
 	devgasante "github.com/NibiruChain/nibiru/x/devgas/v1/ante"
 	devgaskeeper "github.com/NibiruChain/nibiru/x/devgas/v1/keeper"
+	oraclekeeper "github.com/NibiruChain/nibiru/x/oracle/keeper"
 )
 
 type AnteHandlerOptions struct {
@@ -21,6 +22,7 @@ type AnteHandlerOptions struct {
 	IBCKeeper        *ibckeeper.Keeper
 	DevGasKeeper     *devgaskeeper.Keeper
 	DevGasBankKeeper devgasante.BankKeeper
+	OracleKeeper     *oraclekeeper.Keeper
 
 	TxCounterStoreKey types.StoreKey
 	WasmConfig        *wasmtypes.WasmConfig
@@ -51,6 +53,9 @@ func NewAnteHandler(options AnteHandlerOptions) (sdk.AnteHandler, error) {
 	if options.IBCKeeper == nil {
 		return nil, AnteHandlerError("ibc keeper")
 	}
+	if options.OracleKeeper == nil {
+		return nil, AnteHandlerError("oracle keeper")
+	}
 
 	anteDecorators := []sdk.AnteDecorator{
 		sdkante.NewSetUpContextDecorator(),
@@ -61,6 +66,7 @@ func NewAnteHandler(options AnteHandlerOptions) (sdk.AnteHandler, error) {
 		sdkante.NewTxTimeoutHeightDecorator(),
 		sdkante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewPostPriceFixedPriceDecorator(),
+		ante.NewOracleFeeWaiverDecorator(*options.OracleKeeper),
 		ante.AnteDecoratorStakingCommission{},
 		sdkante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		// Replace fee ante from cosmos auth with a custom one.
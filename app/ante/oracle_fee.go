@@ -0,0 +1,184 @@
+package ante
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oraclekeeper "github.com/NibiruChain/nibiru/x/oracle/keeper"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+var _ sdk.AnteDecorator = OracleFeeWaiverDecorator{}
+
+// OracleFeeWaiverDecorator lets authorized price feeders submit their oracle
+// prevote and vote transactions without meeting the node's minimum gas
+// prices, so that feeders are not priced out of the mempool when fees spike.
+//
+// The waiver is rate limited to one prevote and one vote per validator per
+// vote period. Since CheckTx does not execute messages, the committed oracle
+// state only reflects the txs that made it into a block, so the decorator
+// also remembers which tx was granted each waiver of the current vote period.
+// Any other transaction, including one from a feeder that is not authorized
+// by its validator, goes through the regular fee checks. A waiver is only kept
+// by a tx that passes the rest of the ante handler, including the signature
+// checks, so that unsigned txs cannot take the waiver of a feeder.
+//
+// It must run after EnsureSinglePostPriceMessageDecorator, which guarantees
+// that oracle messages are never bundled with other messages, and before the
+// DeductFeeDecorator.
+type OracleFeeWaiverDecorator struct {
+	oracleKeeper oraclekeeper.Keeper
+	waivers      *feeWaivers
+}
+
+func NewOracleFeeWaiverDecorator(oracleKeeper oraclekeeper.Keeper) OracleFeeWaiverDecorator {
+	return OracleFeeWaiverDecorator{
+		oracleKeeper: oracleKeeper,
+		waivers:      &feeWaivers{granted: make(map[feeWaiverKey][sha256.Size]byte)},
+	}
+}
+
+// feeWaiverKey identifies a waiver: one prevote or one vote of a validator.
+type feeWaiverKey struct {
+	isVote    bool
+	validator string
+}
+
+// feeWaivers tracks the waivers granted in CheckTx during the current vote
+// period. It maps each waiver to the hash of the tx it was granted to, so
+// that the same tx keeps its waiver when it is rechecked.
+type feeWaivers struct {
+	mu      sync.Mutex
+	period  uint64
+	granted map[feeWaiverKey][sha256.Size]byte
+}
+
+// claim grants the waivers of the keys to the tx, unless one of them was
+// already granted to another tx in the vote period.
+func (w *feeWaivers) claim(period uint64, keys []feeWaiverKey, txHash [sha256.Size]byte) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if period != w.period {
+		w.period = period
+		w.granted = make(map[feeWaiverKey][sha256.Size]byte)
+	}
+	for _, key := range keys {
+		if hash, ok := w.granted[key]; ok && hash != txHash {
+			return false
+		}
+	}
+	for _, key := range keys {
+		w.granted[key] = txHash
+	}
+	return true
+}
+
+// release returns the waivers of the keys granted to the tx, so that a tx
+// failing the rest of the ante handler, e.g. an unsigned tx copying the
+// feeder and validator of a real feeder, does not hold on to them.
+func (w *feeWaivers) release(period uint64, keys []feeWaiverKey, txHash [sha256.Size]byte) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if period != w.period {
+		return
+	}
+	for _, key := range keys {
+		if hash, ok := w.granted[key]; ok && hash == txHash {
+			delete(w.granted, key)
+		}
+	}
+}
+
+func (d OracleFeeWaiverDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	// Minimum gas prices are only enforced in CheckTx.
+	if !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return next(ctx, tx, simulate)
+	}
+	votePeriod := d.oracleKeeper.VotePeriod(ctx)
+	if votePeriod == 0 {
+		return next(ctx, tx, simulate)
+	}
+	keys := make([]feeWaiverKey, 0, len(msgs))
+	for _, msg := range msgs {
+		key, ok := d.isFeeWaived(ctx, msg, votePeriod)
+		if !ok {
+			return next(ctx, tx, simulate)
+		}
+		keys = append(keys, key)
+	}
+
+	period := uint64(ctx.BlockHeight()) / votePeriod
+	txHash := sha256.Sum256(ctx.TxBytes())
+	if !d.waivers.claim(period, keys, txHash) {
+		return next(ctx, tx, simulate)
+	}
+
+	// The signatures are only verified further down the ante handler, so the
+	// waiver is given back if the tx fails there.
+	newCtx, err = next(ctx.WithMinGasPrices(sdk.DecCoins{}), tx, simulate)
+	if err != nil {
+		d.waivers.release(period, keys, txHash)
+	}
+	return newCtx, err
+}
+
+// isFeeWaived returns true if the message is an oracle prevote or vote from an
+// authorized feeder whose validator has not yet submitted one in the current
+// vote period, along with the key of the waiver it needs.
+func (d OracleFeeWaiverDecorator) isFeeWaived(
+	ctx sdk.Context, msg sdk.Msg, votePeriod uint64,
+) (feeWaiverKey, bool) {
+	switch msg := msg.(type) {
+	case *oracletypes.MsgAggregateExchangeRatePrevote:
+		valAddr, ok := d.authorizedValidator(ctx, msg.Feeder, msg.Validator)
+		if !ok {
+			return feeWaiverKey{}, false
+		}
+		key := feeWaiverKey{isVote: false, validator: valAddr.String()}
+		prevote, err := d.oracleKeeper.Prevotes.Get(ctx, valAddr)
+		if err != nil {
+			return key, true
+		}
+		return key, prevote.SubmitBlock/votePeriod != uint64(ctx.BlockHeight())/votePeriod
+	case *oracletypes.MsgAggregateExchangeRateVote:
+		valAddr, ok := d.authorizedValidator(ctx, msg.Feeder, msg.Validator)
+		if !ok {
+			return feeWaiverKey{}, false
+		}
+		// Votes are cleared at the end of every vote period.
+		_, err := d.oracleKeeper.Votes.Get(ctx, valAddr)
+		return feeWaiverKey{isVote: true, validator: valAddr.String()}, err != nil
+	default:
+		return feeWaiverKey{}, false
+	}
+}
+
+// authorizedValidator returns the validator address if the feeder is allowed
+// to submit prices on behalf of the validator.
+func (d OracleFeeWaiverDecorator) authorizedValidator(
+	ctx sdk.Context, feeder string, validator string,
+) (sdk.ValAddress, bool) {
+	feederAddr, err := sdk.AccAddressFromBech32(feeder)
+	if err != nil {
+		return nil, false
+	}
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return nil, false
+	}
+	if err := d.oracleKeeper.ValidateFeeder(ctx, feederAddr, valAddr); err != nil {
+		return nil, false
+	}
+	return valAddr, true
+}
@@ -0,0 +1,168 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	nibiruante "github.com/NibiruChain/nibiru/app/ante"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func TestOracleFeeWaiverDecorator(t *testing.T) {
+	nibiru, ctx := testapp.NewNibiruTestAppAndContext()
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoin("unibi", sdk.OneInt()))
+	ctx = ctx.WithIsCheckTx(true).WithMinGasPrices(minGasPrices)
+
+	iter := nibiru.OracleKeeper.StakingKeeper.ValidatorsPowerStoreIterator(ctx)
+	require.True(t, iter.Valid())
+	valAddr := sdk.ValAddress(iter.Value())
+	require.NoError(t, iter.Close())
+	feeder := sdk.AccAddress(valAddr)
+
+	prevote := &oracletypes.MsgAggregateExchangeRatePrevote{
+		Hash: "dummyHash", Feeder: feeder.String(), Validator: valAddr.String(),
+	}
+	vote := &oracletypes.MsgAggregateExchangeRateVote{
+		Salt: "dummySalt", ExchangeRates: "dummyRates", Feeder: feeder.String(), Validator: valAddr.String(),
+	}
+
+	decorator := nibiruante.NewOracleFeeWaiverDecorator(nibiru.OracleKeeper)
+	isWaived := func(ctx sdk.Context, txBytes string, msgs ...sdk.Msg) bool {
+		var gotMinGasPrices sdk.DecCoins
+		_, err := decorator.AnteHandle(ctx.WithTxBytes([]byte(txBytes)), mockTx{msgs: msgs}, false,
+			func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				gotMinGasPrices = ctx.MinGasPrices()
+				return ctx, nil
+			})
+		require.NoError(t, err)
+		return gotMinGasPrices.IsZero()
+	}
+	votePeriod := int64(nibiru.OracleKeeper.VotePeriod(ctx))
+	nextPeriod := func(ctx sdk.Context) sdk.Context {
+		return ctx.WithBlockHeight(ctx.BlockHeight() + votePeriod)
+	}
+
+	t.Log("authorized feeder votes are fee free")
+	require.True(t, isWaived(ctx, "prevote", prevote))
+	require.True(t, isWaived(ctx, "vote", vote))
+	require.True(t, isWaived(nextPeriod(ctx), "prevote and vote", prevote, vote))
+
+	t.Log("min gas prices are untouched outside of CheckTx")
+	require.False(t, isWaived(ctx.WithIsCheckTx(false), "prevote", prevote))
+
+	t.Log("unauthorized feeders and other messages pay fees")
+	require.False(t, isWaived(ctx, "unauthorized", &oracletypes.MsgAggregateExchangeRatePrevote{
+		Hash: "dummyHash", Feeder: testutil.AccAddress().String(), Validator: valAddr.String(),
+	}))
+	require.False(t, isWaived(ctx, "mixed", prevote, &banktypes.MsgSend{}))
+	require.False(t, isWaived(ctx, "empty"))
+
+	t.Log("pending txs only get one waiver per vote period")
+	ctx = nextPeriod(nextPeriod(ctx))
+	require.True(t, isWaived(ctx, "prevote", prevote))
+	require.False(t, isWaived(ctx, "another prevote", prevote))
+	require.True(t, isWaived(ctx.WithIsReCheckTx(true), "prevote", prevote))
+	require.False(t, isWaived(ctx, "prevote and vote", prevote, vote))
+	require.True(t, isWaived(ctx, "vote", vote))
+	require.False(t, isWaived(ctx, "another vote", vote))
+	require.True(t, isWaived(nextPeriod(ctx), "another prevote", prevote))
+
+	t.Log("a tx failing later in the ante handler gives its waiver back")
+	ctx = nextPeriod(nextPeriod(ctx))
+	_, err := decorator.AnteHandle(ctx.WithTxBytes([]byte("unsigned prevote")), mockTx{msgs: []sdk.Msg{prevote}}, false,
+		func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			return ctx, errors.New("signature verification failed")
+		})
+	require.Error(t, err)
+	require.True(t, isWaived(ctx, "prevote", prevote))
+
+	t.Log("fees are only waived once per vote period")
+	ctx = nextPeriod(nextPeriod(ctx))
+	nibiru.OracleKeeper.Prevotes.Insert(ctx, valAddr,
+		oracletypes.NewAggregateExchangeRatePrevote(oracletypes.AggregateVoteHash{}, valAddr, uint64(ctx.BlockHeight())))
+	require.False(t, isWaived(ctx, "prevote", prevote))
+	require.True(t, isWaived(nextPeriod(ctx), "prevote", prevote))
+
+	nibiru.OracleKeeper.Votes.Insert(ctx, valAddr,
+		oracletypes.NewAggregateExchangeRateVote(oracletypes.ExchangeRateTuples{}, valAddr))
+	require.False(t, isWaived(ctx, "vote", vote))
+}
+
+// TestOracleFeeWaiverNeedsSignature checks that a tx copying the feeder and
+// validator of a real feeder, without its signature, does not take the fee
+// waiver of the feeder.
+func (suite *AnteTestSuite) TestOracleFeeWaiverNeedsSignature() {
+	// an app with a bonded validator and the default oracle params
+	suite.app, suite.ctx = testapp.NewNibiruTestAppAndContext()
+	iter := suite.app.OracleKeeper.StakingKeeper.ValidatorsPowerStoreIterator(suite.ctx)
+	suite.Require().True(iter.Valid())
+	valAddr := sdk.ValAddress(iter.Value())
+	suite.Require().NoError(iter.Close())
+
+	feederPriv, _, feederAddr := testdata.KeyTestPubAddr()
+	attackerPriv, _, _ := testdata.KeyTestPubAddr()
+	suite.app.OracleKeeper.FeederDelegations.Insert(suite.ctx, valAddr, feederAddr)
+	feederAcc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, feederAddr)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, feederAcc)
+
+	var gotMinGasPrices sdk.DecCoins
+	anteHandler := sdk.ChainAnteDecorators(
+		nibiruante.NewOracleFeeWaiverDecorator(suite.app.OracleKeeper),
+		ante.NewSetPubKeyDecorator(suite.app.AccountKeeper),
+		ante.NewValidateSigCountDecorator(suite.app.AccountKeeper),
+		ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+		minGasPricesRecorder{minGasPrices: &gotMinGasPrices},
+	)
+	ctx := suite.ctx.WithIsCheckTx(true).
+		WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin("unibi", sdk.OneInt())))
+
+	runTx := func(priv cryptotypes.PrivKey) error {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(&oracletypes.MsgAggregateExchangeRatePrevote{
+			Hash: "dummyHash", Feeder: feederAddr.String(), Validator: valAddr.String(),
+		}))
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		tx, err := suite.CreateTestTx(
+			[]cryptotypes.PrivKey{priv}, []uint64{feederAcc.GetAccountNumber()}, []uint64{0}, ctx.ChainID())
+		suite.Require().NoError(err)
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+
+		gotMinGasPrices = nil
+		_, err = anteHandler(ctx.WithTxBytes(txBytes), tx, false)
+		return err
+	}
+
+	suite.Require().Error(runTx(attackerPriv))
+	suite.Require().NoError(runTx(feederPriv))
+	suite.Require().True(gotMinGasPrices.IsZero())
+}
+
+// minGasPricesRecorder is the last decorator of an ante handler, recording
+// the min gas prices it is called with.
+type minGasPricesRecorder struct {
+	minGasPrices *sdk.DecCoins
+}
+
+func (d minGasPricesRecorder) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	*d.minGasPrices = ctx.MinGasPrices()
+	return next(ctx, tx, simulate)
+}
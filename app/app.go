@@ -39,6 +39,7 @@ import (
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 	"github.com/cosmos/ibc-go/v7/testing/types"
@@ -46,6 +47,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cast"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 const (
@@ -98,6 +100,10 @@ type NibiruApp struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// nodeClientCtx is the client context of the node, kept from
+	// RegisterNodeService for the gRPC health service
+	nodeClientCtx client.Context
 }
 
 func init() {
@@ -197,6 +203,7 @@ func NewNibiruApp(
 		WasmConfig:        &wasmConfig,
 		DevGasKeeper:      &app.DevGasKeeper,
 		DevGasBankKeeper:  app.BankKeeper,
+		OracleKeeper:      &app.OracleKeeper,
 	})
 	if err != nil {
 		panic(fmt.Errorf("failed to create sdk.AnteHandler: %s", err))
@@ -204,6 +211,12 @@ func NewNibiruApp(
 
 	app.SetAnteHandler(anteHandler)
 	app.SetEndBlocker(app.EndBlocker)
+	app.SetPrepareProposal(
+		NewLanePrepareProposalHandler(txConfig.TxDecoder(), DefaultLanes()))
+
+	if alertCfg := AlertConfigFromAppOpts(appOpts); len(alertCfg.Webhooks) > 0 {
+		app.SetStreamingService(NewAlertService(alertCfg, logger))
+	}
 
 	if snapshotManager := app.SnapshotManager(); snapshotManager != nil {
 		if err = snapshotManager.RegisterExtensions(
@@ -271,6 +284,16 @@ func (app *NibiruApp) LoadHeight(height int64) error {
 
 func (app *NibiruApp) RegisterNodeService(clientCtx client.Context) {
 	node.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
+	app.nodeClientCtx = clientCtx
+}
+
+// RegisterGRPCServer registers the gRPC services of the app and the standard
+// gRPC health service on the node's gRPC server. The server starts after
+// RegisterNodeService, so the health service queries the node through its
+// client context.
+func (app *NibiruApp) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(server)
+	grpc_health_v1.RegisterHealthServer(server, NewHealthServer(app.nodeClientCtx.Client, app.nodeClientCtx))
 }
 
 // ModuleAccountAddrs returns all the app's module account addresses.
@@ -353,6 +376,8 @@ func (app *NibiruApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.API
 	// Register legacy and grpc-gateway routes for all modules.
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	RegisterHealthRoute(clientCtx, apiSvr.Router)
+
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
 		RegisterSwaggerAPI(clientCtx, apiSvr.Router)
@@ -0,0 +1,148 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+	perptypes "github.com/NibiruChain/nibiru/x/perp/v2/types"
+)
+
+const (
+	// HealthRoute is the REST route that serves the node health report.
+	HealthRoute = "/nibiru/health"
+
+	HealthStatusOK       = "ok"
+	HealthStatusDegraded = "degraded"
+
+	healthCheckTimeout = 5 * time.Second
+)
+
+// HealthReport describes whether a node can serve up-to-date protocol state.
+// A node is degraded if it is catching up with the chain, or if the oracle
+// has no live price for a pair used by an enabled perp market.
+type HealthReport struct {
+	Status     string       `json:"status"`
+	CatchingUp bool         `json:"catching_up"`
+	StalePairs []asset.Pair `json:"stale_pairs"`
+	Error      string       `json:"error,omitempty"`
+}
+
+// CheckHealth builds the HealthReport of a node. The sync status is read from
+// statusClient, if any, and the protocol state is queried through conn, e.g.
+// the client.Context of the node.
+func CheckHealth(
+	ctx context.Context, statusClient rpcclient.StatusClient, conn gogogrpc.ClientConn,
+) HealthReport {
+	report := HealthReport{Status: HealthStatusOK, StalePairs: []asset.Pair{}}
+	degrade := func(err error) HealthReport {
+		report.Status = HealthStatusDegraded
+		report.Error = err.Error()
+		return report
+	}
+
+	if statusClient != nil {
+		status, err := statusClient.Status(ctx)
+		if err != nil {
+			return degrade(err)
+		}
+		report.CatchingUp = status.SyncInfo.CatchingUp
+	}
+
+	actives, err := oracletypes.NewQueryClient(conn).Actives(
+		ctx, &oracletypes.QueryActivesRequest{})
+	if err != nil {
+		return degrade(err)
+	}
+	isActive := make(map[asset.Pair]bool, len(actives.Actives))
+	for _, pair := range actives.Actives {
+		isActive[pair] = true
+	}
+
+	perpClient := perptypes.NewQueryClient(conn)
+	seen := make(map[asset.Pair]bool)
+	pageReq := &sdkquery.PageRequest{}
+	for {
+		markets, err := perpClient.QueryMarkets(ctx, &perptypes.QueryMarketsRequest{Pagination: pageReq})
+		if err != nil {
+			return degrade(err)
+		}
+		for _, ammMarket := range markets.AmmMarkets {
+			oraclePair := ammMarket.Market.OraclePair
+			if !isActive[oraclePair] && !seen[oraclePair] {
+				report.StalePairs = append(report.StalePairs, oraclePair)
+			}
+			seen[oraclePair] = true
+		}
+		if markets.Pagination == nil || len(markets.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &sdkquery.PageRequest{Key: markets.Pagination.NextKey}
+	}
+
+	if report.CatchingUp || len(report.StalePairs) > 0 {
+		report.Status = HealthStatusDegraded
+	}
+	return report
+}
+
+// RegisterHealthRoute registers the health endpoint on the API server. It
+// responds with 200 when the node is healthy and 503 when it is degraded, so
+// it can be used directly as a load balancer health check.
+func RegisterHealthRoute(clientCtx client.Context, router *mux.Router) {
+	router.HandleFunc(HealthRoute, func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+		defer cancel()
+
+		report := CheckHealth(ctx, clientCtx.Client, clientCtx)
+		w.Header().Set("Content-Type", "application/json")
+		if report.Status != HealthStatusOK {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(report)
+	}).Methods(http.MethodGet)
+}
+
+// healthServer implements the standard gRPC health service, so that gRPC
+// load balancers and probes can use the same HealthReport as the REST route.
+type healthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+	statusClient rpcclient.StatusClient
+	conn         gogogrpc.ClientConn
+}
+
+// NewHealthServer returns a grpc_health_v1.HealthServer that reports the node
+// as serving when its HealthReport is ok. Only the overall server health,
+// requested with an empty service name, is reported.
+func NewHealthServer(statusClient rpcclient.StatusClient, conn gogogrpc.ClientConn) grpc_health_v1.HealthServer {
+	return healthServer{statusClient: statusClient, conn: conn}
+}
+
+func (s healthServer) Check(
+	ctx context.Context, req *grpc_health_v1.HealthCheckRequest,
+) (*grpc_health_v1.HealthCheckResponse, error) {
+	if req.Service != "" {
+		return nil, status.Errorf(codes.NotFound, "unknown service %s", req.Service)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	servingStatus := grpc_health_v1.HealthCheckResponse_SERVING
+	if CheckHealth(ctx, s.statusClient, s.conn).Status != HealthStatusOK {
+		servingStatus = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	return &grpc_health_v1.HealthCheckResponse{Status: servingStatus}, nil
+}
@@ -0,0 +1,109 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	oraclekeeper "github.com/NibiruChain/nibiru/x/oracle/keeper"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+	perpkeeper "github.com/NibiruChain/nibiru/x/perp/v2/keeper"
+	perptypes "github.com/NibiruChain/nibiru/x/perp/v2/types"
+)
+
+func TestCheckHealth(t *testing.T) {
+	btcNusd := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	ethNusd := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	btcUsd := asset.Registry.Pair(denoms.BTC, denoms.USD)
+
+	for _, tc := range []struct {
+		name           string
+		setup          func(nibiru *app.NibiruApp, ctx sdk.Context)
+		wantStatus     string
+		wantStalePairs []asset.Pair
+	}{
+		{
+			name:       "no enabled market",
+			setup:      func(nibiru *app.NibiruApp, ctx sdk.Context) {},
+			wantStatus: app.HealthStatusOK,
+		},
+		{
+			name: "disabled markets are ignored",
+			setup: func(nibiru *app.NibiruApp, ctx sdk.Context) {
+				require.NoError(t, nibiru.PerpKeeperV2.Sudo().CreateMarket(ctx, perpkeeper.ArgsCreateMarket{
+					Pair:            ethNusd,
+					PriceMultiplier: sdk.OneDec(),
+					SqrtDepth:       sdk.NewDec(1_000_000),
+				}))
+			},
+			wantStatus: app.HealthStatusOK,
+		},
+		{
+			name: "stale oracle price of an enabled market",
+			setup: func(nibiru *app.NibiruApp, ctx sdk.Context) {
+				require.NoError(t, nibiru.PerpKeeperV2.Sudo().CreateMarket(ctx, perpkeeper.ArgsCreateMarket{
+					Pair:            btcNusd,
+					PriceMultiplier: sdk.OneDec(),
+					SqrtDepth:       sdk.NewDec(1_000_000),
+					EnableMarket:    true,
+				}))
+			},
+			wantStatus:     app.HealthStatusDegraded,
+			wantStalePairs: []asset.Pair{btcUsd},
+		},
+		{
+			name: "healthy",
+			setup: func(nibiru *app.NibiruApp, ctx sdk.Context) {
+				require.NoError(t, nibiru.PerpKeeperV2.Sudo().CreateMarket(ctx, perpkeeper.ArgsCreateMarket{
+					Pair:            btcNusd,
+					PriceMultiplier: sdk.OneDec(),
+					SqrtDepth:       sdk.NewDec(1_000_000),
+					EnableMarket:    true,
+				}))
+				nibiru.OracleKeeper.SetPrice(ctx, btcUsd, sdk.NewDec(20_000))
+			},
+			wantStatus: app.HealthStatusOK,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			nibiru, ctx := testapp.NewNibiruTestAppAndContext()
+			tc.setup(nibiru, ctx)
+
+			queryHelper := baseapp.NewQueryServerTestHelper(ctx, nibiru.InterfaceRegistry())
+			oracletypes.RegisterQueryServer(queryHelper, oraclekeeper.NewQuerier(nibiru.OracleKeeper))
+			perptypes.RegisterQueryServer(queryHelper, perpkeeper.NewQuerier(nibiru.PerpKeeperV2))
+
+			report := app.CheckHealth(context.Background(), nil, queryHelper)
+			require.Equal(t, tc.wantStatus, report.Status, report.Error)
+			require.False(t, report.CatchingUp)
+			require.Empty(t, report.Error)
+			if tc.wantStalePairs == nil {
+				require.Empty(t, report.StalePairs)
+			} else {
+				require.Equal(t, tc.wantStalePairs, report.StalePairs)
+			}
+
+			// the gRPC health service reports the same status
+			res, err := app.NewHealthServer(nil, queryHelper).Check(
+				context.Background(), &grpc_health_v1.HealthCheckRequest{})
+			require.NoError(t, err)
+			wantServingStatus := grpc_health_v1.HealthCheckResponse_SERVING
+			if tc.wantStatus != app.HealthStatusOK {
+				wantServingStatus = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+			}
+			require.Equal(t, wantServingStatus, res.Status)
+
+			_, err = app.NewHealthServer(nil, queryHelper).Check(
+				context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "unknown"})
+			require.Error(t, err)
+		})
+	}
+}
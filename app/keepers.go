@@ -222,7 +222,7 @@ func initStoreKeys() (
 		devgastypes.StoreKey,
 		tokenfactorytypes.StoreKey,
 	)
-	tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey, perptypes.TStoreKey)
 	memKeys = sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 	return keys, tkeys, memKeys
 }
@@ -365,6 +365,7 @@ func (app *NibiruApp) InitKeepers(
 		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.stakingKeeper,
 		app.SudoKeeper,
 		distrtypes.ModuleName,
+		govModuleAddr,
 	)
 
 	app.EpochsKeeper = epochskeeper.NewKeeper(
@@ -372,7 +373,7 @@ func (app *NibiruApp) InitKeepers(
 	)
 
 	app.PerpKeeperV2 = perpkeeper.NewKeeper(
-		appCodec, keys[perptypes.StoreKey],
+		appCodec, keys[perptypes.StoreKey], tkeys[perptypes.TStoreKey],
 		app.AccountKeeper, app.BankKeeper, app.OracleKeeper, app.EpochsKeeper,
 		app.SudoKeeper,
 	)
@@ -0,0 +1,153 @@
+package app
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+	perptypes "github.com/NibiruChain/nibiru/x/perp/v2/types"
+)
+
+// Lane is a named partition of the block space. Transactions are placed in
+// the first lane whose Match function accepts them, and lanes are included in
+// the block in the order they are configured.
+type Lane struct {
+	Name  string
+	Match func(tx sdk.Tx) bool
+}
+
+const (
+	LaneOracle      = "oracle"
+	LaneLiquidation = "liquidation"
+	LaneDefault     = "default"
+)
+
+// DefaultLanes returns the lane configuration used by the Nibiru app: oracle
+// votes first so that prices are fresh for the rest of the block, then
+// liquidations, then everything else. Transactions that match no lane fall
+// into the default lane.
+func DefaultLanes() []Lane {
+	return []Lane{
+		{
+			Name: LaneOracle,
+			Match: MatchAllMsgs(func(msg sdk.Msg) bool {
+				switch msg.(type) {
+				case *oracletypes.MsgAggregateExchangeRatePrevote,
+					*oracletypes.MsgAggregateExchangeRateVote:
+					return true
+				}
+				return false
+			}),
+		},
+		{
+			Name: LaneLiquidation,
+			Match: MatchAllMsgs(func(msg sdk.Msg) bool {
+				_, ok := msg.(*perptypes.MsgMultiLiquidate)
+				return ok
+			}),
+		},
+	}
+}
+
+// MatchAllMsgs returns a lane matcher that accepts a tx only if it has at
+// least one message and every message satisfies the predicate. This prevents
+// bundling ordinary messages with priority ones to jump the queue.
+func MatchAllMsgs(predicate func(msg sdk.Msg) bool) func(tx sdk.Tx) bool {
+	return func(tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+		for _, msg := range msgs {
+			if !predicate(msg) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// NewLanePrepareProposalHandler returns a PrepareProposal handler that orders
+// the txs proposed by CometBFT by lane. The relative order of txs within a
+// lane is preserved, so the result is deterministic for a given request.
+// A tx is never placed ahead of an earlier tx of one of its signers: it goes
+// to the lane of that earlier tx if it is lower than its own, so that account
+// sequences are delivered in order.
+// Txs that fail to decode or have an invalid signer are kept in the default lane and left for
+// ProcessProposal/DeliverTx to reject.
+func NewLanePrepareProposalHandler(
+	txDecoder sdk.TxDecoder, lanes []Lane,
+) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		var maxBlockGas uint64
+		if b := ctx.ConsensusParams().Block; b != nil && b.MaxGas > 0 {
+			maxBlockGas = uint64(b.MaxGas)
+		}
+
+		type laneTx struct {
+			tx   sdk.Tx
+			txBz []byte
+		}
+		laneTxs := make([][]laneTx, len(lanes)+1)
+		// signerLanes maps a signer to the lowest lane of its txs so far
+		signerLanes := make(map[string]int)
+		for _, txBz := range req.Txs {
+			tx, err := txDecoder(txBz)
+			laneIdx := len(lanes) // default lane
+			if err == nil {
+				for i, lane := range lanes {
+					if lane.Match(tx) {
+						laneIdx = i
+						break
+					}
+				}
+				signers, ok := txSigners(tx)
+				if !ok {
+					laneIdx = len(lanes)
+				}
+				for _, signer := range signers {
+					if idx, ok := signerLanes[signer]; ok && idx > laneIdx {
+						laneIdx = idx
+					}
+				}
+				for _, signer := range signers {
+					signerLanes[signer] = laneIdx
+				}
+			} else {
+				tx = nil
+			}
+			laneTxs[laneIdx] = append(laneTxs[laneIdx], laneTx{tx: tx, txBz: txBz})
+		}
+
+		selector := baseapp.NewDefaultTxSelector()
+		defer selector.Clear()
+	selection:
+		for _, txs := range laneTxs {
+			for _, ltx := range txs {
+				if selector.SelectTxForProposal(uint64(req.MaxTxBytes), maxBlockGas, ltx.tx, ltx.txBz) {
+					break selection
+				}
+			}
+		}
+
+		return abci.ResponsePrepareProposal{Txs: selector.SelectedTxs()}
+	}
+}
+
+// txSigners returns the addresses of the signers of every message in the tx.
+// It returns false if a message has an invalid signer, since GetSigners panics
+// on malformed addresses.
+func txSigners(tx sdk.Tx) (signers []string, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			signers, ok = nil, false
+		}
+	}()
+	for _, msg := range tx.GetMsgs() {
+		for _, signer := range msg.GetSigners() {
+			signers = append(signers, signer.String())
+		}
+	}
+	return signers, true
+}
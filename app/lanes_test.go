@@ -0,0 +1,106 @@
+package app_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+	perptypes "github.com/NibiruChain/nibiru/x/perp/v2/types"
+)
+
+func TestLanePrepareProposalHandler(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	sender := testutil.AccAddress().String()
+	feeder := testutil.AccAddress().String()
+	liquidator := testutil.AccAddress().String()
+
+	encode := func(msgs ...sdk.Msg) []byte {
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		txBz, err := encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return txBz
+	}
+
+	send := &banktypes.MsgSend{
+		FromAddress: sender,
+		ToAddress:   sender,
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1)),
+	}
+	vote := &oracletypes.MsgAggregateExchangeRateVote{Salt: "1", Feeder: feeder, Validator: feeder}
+	prevote := &oracletypes.MsgAggregateExchangeRatePrevote{Hash: "hash", Feeder: feeder, Validator: feeder}
+	liquidate := &perptypes.MsgMultiLiquidate{Sender: liquidator}
+	senderVote := &oracletypes.MsgAggregateExchangeRateVote{Salt: "2", Feeder: sender, Validator: sender}
+	senderLiquidate := &perptypes.MsgMultiLiquidate{Sender: sender}
+
+	userTx := encode(send)
+	voteTx := encode(vote)
+	prevoteTx := encode(prevote)
+	liquidateTx := encode(liquidate)
+	mixedTx := encode(senderVote, send)
+	senderVoteTx := encode(senderVote)
+	senderLiquidateTx := encode(senderLiquidate)
+	invalidTx := []byte("not a tx")
+
+	ctx := sdk.Context{}.WithConsensusParams(&tmproto.ConsensusParams{})
+	handler := app.NewLanePrepareProposalHandler(encCfg.TxConfig.TxDecoder(), app.DefaultLanes())
+
+	for _, tc := range []struct {
+		name       string
+		txs        [][]byte
+		maxTxBytes int64
+		want       [][]byte
+	}{
+		{
+			name:       "priority lanes ahead of user txs",
+			txs:        [][]byte{userTx, liquidateTx, voteTx, prevoteTx},
+			maxTxBytes: 1 << 20,
+			want:       [][]byte{voteTx, prevoteTx, liquidateTx, userTx},
+		},
+		{
+			name:       "mixed and undecodable txs go to the default lane in order",
+			txs:        [][]byte{mixedTx, invalidTx, voteTx, userTx},
+			maxTxBytes: 1 << 20,
+			want:       [][]byte{voteTx, mixedTx, invalidTx, userTx},
+		},
+		{
+			name:       "txs of a signer are not moved ahead of its earlier txs",
+			txs:        [][]byte{senderVoteTx, userTx, senderLiquidateTx, voteTx},
+			maxTxBytes: 1 << 20,
+			want:       [][]byte{senderVoteTx, voteTx, userTx, senderLiquidateTx},
+		},
+		{
+			name:       "a signer's tx only moves up to the lane of its earlier txs",
+			txs:        [][]byte{senderLiquidateTx, senderVoteTx, liquidateTx, voteTx},
+			maxTxBytes: 1 << 20,
+			want:       [][]byte{voteTx, senderLiquidateTx, senderVoteTx, liquidateTx},
+		},
+		{
+			name:       "respects max tx bytes",
+			txs:        [][]byte{userTx, voteTx},
+			maxTxBytes: int64(len(voteTx)),
+			want:       [][]byte{voteTx},
+		},
+		{
+			name:       "empty",
+			maxTxBytes: 1 << 20,
+			want:       [][]byte{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := handler(ctx, abci.RequestPrepareProposal{
+				Txs:        tc.txs,
+				MaxTxBytes: tc.maxTxBytes,
+			})
+			require.Equal(t, tc.want, resp.Txs)
+		})
+	}
+}
@@ -121,6 +121,7 @@ func WasmAcceptedStargateQueries() wasmkeeper.AcceptedStargateQueries {
 		// nibiru oracle
 		"/nibiru.oracle.v1.Query/ExchangeRate":      new(oracle.QueryExchangeRateResponse),
 		"/nibiru.oracle.v1.Query/ExchangeRateTwap":  new(oracle.QueryExchangeRateResponse),
+		"/nibiru.oracle.v1.Query/ExchangeRateEma":   new(oracle.QueryExchangeRateResponse),
 		"/nibiru.oracle.v1.Query/ExchangeRates":     new(oracle.QueryExchangeRatesResponse),
 		"/nibiru.oracle.v1.Query/Actives":           new(oracle.QueryActivesResponse),
 		"/nibiru.oracle.v1.Query/VoteTargets":       new(oracle.QueryVoteTargetsResponse),
@@ -131,6 +132,10 @@ func WasmAcceptedStargateQueries() wasmkeeper.AcceptedStargateQueries {
 		"/nibiru.oracle.v1.Query/AggregateVote":     new(oracle.QueryAggregateVoteResponse),
 		"/nibiru.oracle.v1.Query/AggregateVotes":    new(oracle.QueryAggregateVotesResponse),
 		"/nibiru.oracle.v1.Query/Params":            new(oracle.QueryParamsResponse),
+		"/nibiru.oracle.v1.Query/PriceAtTime":       new(oracle.QueryPriceAtTimeResponse),
+		"/nibiru.oracle.v1.Query/PriceHistory":      new(oracle.QueryPriceHistoryResponse),
+		"/nibiru.oracle.v1.Query/OracleStatus":      new(oracle.QueryOracleStatusResponse),
+		"/nibiru.oracle.v1.Query/UsdPrice":          new(oracle.QueryUsdPriceResponse),
 
 		// nibiru sudo
 		"/nibiru.sudo.v1.Query/QuerySudoers": new(sudotypes.QuerySudoersResponse),
@@ -0,0 +1,56 @@
+package cmd_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+
+	nibid "github.com/NibiruChain/nibiru/cmd/nibid/cmd"
+)
+
+func TestGetModuleHashes(t *testing.T) {
+	db := dbm.NewMemDB()
+
+	_, err := nibid.GetModuleHashes(db, 0)
+	require.ErrorContains(t, err, "no committed state")
+
+	keyA := storetypes.NewKVStoreKey("alpha")
+	keyB := storetypes.NewKVStoreKey("beta")
+	cms := rootmulti.NewStore(db, log.NewNopLogger())
+	cms.MountStoreWithDB(keyB, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(keyA, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	cms.GetKVStore(keyA).Set([]byte("k"), []byte("v1"))
+	cms.GetKVStore(keyB).Set([]byte("k"), []byte("v1"))
+	commit1 := cms.Commit()
+
+	cms.GetKVStore(keyB).Set([]byte("k"), []byte("v2"))
+	commit2 := cms.Commit()
+
+	first, err := nibid.GetModuleHashes(db, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, first.Height)
+	require.Equal(t, strings.ToUpper(fmt.Sprintf("%x", commit1.Hash)), first.AppHash)
+	require.Len(t, first.Modules, 2)
+	require.Equal(t, "alpha", first.Modules[0].Module)
+	require.Equal(t, "beta", first.Modules[1].Module)
+
+	latest, err := nibid.GetModuleHashes(db, 0)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, latest.Height)
+	require.Equal(t, strings.ToUpper(fmt.Sprintf("%x", commit2.Hash)), latest.AppHash)
+
+	// Only the module that changed has a different hash.
+	require.Equal(t, first.Modules[0].Hash, latest.Modules[0].Hash)
+	require.NotEqual(t, first.Modules[1].Hash, latest.Modules[1].Hash)
+
+	_, err = nibid.GetModuleHashes(db, 5)
+	require.Error(t, err)
+}
@@ -96,6 +96,8 @@ func initAppConfig() (string, interface{}) {
 
 	type CustomAppConfig struct {
 		serverconfig.Config
+
+		Alerts app.AlertConfig `mapstructure:"alerts"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -115,9 +117,10 @@ func initAppConfig() (string, interface{}) {
 
 	customAppConfig := CustomAppConfig{
 		Config: *srvCfg,
+		Alerts: app.AlertConfig{},
 	}
 
-	customAppTemplate := serverconfig.DefaultConfigTemplate
+	customAppTemplate := serverconfig.DefaultConfigTemplate + app.AlertConfigTemplate
 
 	return customAppTemplate, customAppConfig
 }
@@ -141,6 +144,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig) {
 		AddGenesisAccountCmd(app.DefaultNodeHome),
 		GetBuildWasmMsg(),
 		DecodeBase64Cmd(app.DefaultNodeHome),
+		ModuleHashCmd(app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
@@ -183,7 +187,7 @@ func genesisCommand(encodingConfig app.EncodingConfig, cmds ...*cobra.Command) *
 	for _, subCmd := range cmds {
 		cmd.AddCommand(subCmd)
 	}
-	return cmd
+	return withGenesisTimeValidation(cmd)
 }
 
 func queryCommand() *cobra.Command {
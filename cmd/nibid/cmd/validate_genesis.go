@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// ValidateGenesisTime runs the checks of the module genesis states that
+// depend on the genesis time, which the ValidateGenesis function of a module
+// does not receive: no oracle price snapshot may be dated after it.
+func ValidateGenesisTime(cdc codec.JSONCodec, genDoc *tmtypes.GenesisDoc) error {
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+		return fmt.Errorf("error unmarshalling app state: %w", err)
+	}
+
+	oracleGenesis := oracletypes.GetGenesisStateFromAppState(cdc, appState)
+	if err := oracleGenesis.ValidatePriceSnapshotTimes(genDoc.GenesisTime); err != nil {
+		return fmt.Errorf("invalid %s genesis: %w", oracletypes.ModuleName, err)
+	}
+	return nil
+}
+
+// withGenesisTimeValidation adds ValidateGenesisTime to the validate-genesis
+// subcommand of the genesis command.
+func withGenesisTimeValidation(genesisCmd *cobra.Command) *cobra.Command {
+	for _, subCmd := range genesisCmd.Commands() {
+		if subCmd.Name() != "validate-genesis" {
+			continue
+		}
+
+		validateGenesis := subCmd.RunE
+		subCmd.RunE = func(cmd *cobra.Command, args []string) error {
+			genesis := server.GetServerContextFromCmd(cmd).Config.GenesisFile()
+			if len(args) > 0 {
+				genesis = args[0]
+			}
+
+			// an unreadable genesis file is reported by validate-genesis
+			if genDoc, err := tmtypes.GenesisDocFromFile(genesis); err == nil {
+				cdc := client.GetClientContextFromCmd(cmd).Codec
+				if err := ValidateGenesisTime(cdc, genDoc); err != nil {
+					return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
+				}
+			}
+			return validateGenesis(cmd, args)
+		}
+	}
+	return genesisCmd
+}
@@ -0,0 +1,40 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	nibid "github.com/NibiruChain/nibiru/cmd/nibid/cmd"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+func TestValidateGenesisTime(t *testing.T) {
+	encodingConfig := app.MakeEncodingConfig()
+	genesisTime := time.Date(2023, time.September, 15, 12, 0, 0, 0, time.UTC)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+
+	genDocWithSnapshotAt := func(snapshotTime time.Time) *tmtypes.GenesisDoc {
+		oracleGenesis := oracletypes.DefaultGenesisState()
+		oracleGenesis.PriceSnapshots = []oracletypes.PriceSnapshot{
+			{Pair: pair, Price: sdk.OneDec(), TimestampMs: snapshotTime.UnixMilli()},
+		}
+		genState := app.NewDefaultGenesisState(encodingConfig.Marshaler)
+		genState[oracletypes.ModuleName] = encodingConfig.Marshaler.MustMarshalJSON(oracleGenesis)
+		bz, err := json.Marshal(genState)
+		require.NoError(t, err)
+		return &tmtypes.GenesisDoc{GenesisTime: genesisTime, AppState: bz}
+	}
+
+	require.NoError(t, nibid.ValidateGenesisTime(encodingConfig.Marshaler, genDocWithSnapshotAt(genesisTime)))
+	require.ErrorContains(t,
+		nibid.ValidateGenesisTime(encodingConfig.Marshaler, genDocWithSnapshotAt(genesisTime.Add(time.Millisecond))),
+		"after genesis time")
+}
@@ -0,0 +1,78 @@
+#!/bin/bash
+set -e
+
+# Mock price feeder for localnet. Enabled with `--features mock-oracle` or
+# `--dev`. The validator votes the mock prices below every vote period, so
+# x/oracle and the markets that depend on it have fresh prices without
+# running an external price feeder.
+#
+# Prices can be configured with environment variables, e.g.
+#   MOCK_PRICE_BTC=42000 MOCK_PRICE_ETH=2500 make localnet FLAGS="--dev"
+MOCK_VOTE_PERIOD="${MOCK_VOTE_PERIOD:-4}"
+MOCK_PRICE_BTC="${MOCK_PRICE_BTC:-20000}"
+MOCK_PRICE_ETH="${MOCK_PRICE_ETH:-2000}"
+MOCK_PRICE_NIBI="${MOCK_PRICE_NIBI:-0.1}"
+
+# add_genesis_mock_oracle_params: Lets a single validator pass oracle ballots
+# and shortens the vote period so that prices refresh every few blocks.
+add_genesis_mock_oracle_params() {
+  add_genesis_param '.app_state.oracle.params.vote_period = "'"$MOCK_VOTE_PERIOD"'"'
+  add_genesis_param '.app_state.oracle.params.min_voters = "1"'
+  add_genesis_param '.app_state.oracle.params.whitelist = ["ubtc:uusd", "ueth:uusd", "unibi:uusd"]'
+}
+
+# mock_exchange_rates: Prints the mock prices in the format expected by
+# `nibid tx oracle aggregate-vote`.
+mock_exchange_rates() {
+  echo "(ubtc:uusd,$MOCK_PRICE_BTC)|(ueth:uusd,$MOCK_PRICE_ETH)|(unibi:uusd,$MOCK_PRICE_NIBI)"
+}
+
+latest_block_height() {
+  $BINARY status 2>&1 | jq -r '.SyncInfo.latest_block_height' 2>/dev/null || echo 0
+}
+
+# wait_for_next_block: Blocks until the chain height moves past $1.
+wait_for_next_block() {
+  local height=$1
+  while [ "$(latest_block_height)" -le "$height" ]; do
+    sleep 0.5
+  done
+}
+
+# run_mock_price_feeder: Posts the mock prices with commit-reveal voting. In
+# every vote period it reveals the prevote of the previous period and then
+# prevotes for the next one. The two txs go in separate blocks so that the
+# account sequence is committed in between.
+#
+# Args:
+#   $1 : key name of the validator that votes.
+run_mock_price_feeder() {
+  local key_name=$1
+  local tx_flags="--from $key_name --yes --gas 300000 --fees 10000unibi"
+  local last_period=-1
+  local salt=""
+  local rates=""
+
+  echo_info "Mock price feeder: waiting for the chain to start..."
+  while [ "$(latest_block_height)" -lt 1 ]; do
+    sleep 1
+  done
+  echo_success "Mock price feeder: voting every $MOCK_VOTE_PERIOD blocks"
+
+  while true; do
+    local height
+    height=$(latest_block_height)
+    local period=$((height / MOCK_VOTE_PERIOD))
+    if [ "$period" -ne "$last_period" ]; then
+      if [ -n "$salt" ]; then
+        $BINARY tx oracle aggregate-vote "$salt" "$rates" $tx_flags >/dev/null || true
+        wait_for_next_block "$height"
+      fi
+      salt="$RANDOM$RANDOM"
+      rates=$(mock_exchange_rates)
+      $BINARY tx oracle aggregate-prevote "$salt" "$rates" $tx_flags >/dev/null || true
+      last_period=$period
+    fi
+    sleep 1
+  done
+}
@@ -64,6 +64,10 @@ FLAG_PERP=false
 # $FLAG_SPOT: Feature flag for x/spot. Enabled with `--features spot`.
 FLAG_SPOT=false
 
+# $FLAG_MOCK_ORACLE: Runs a mock price feeder that votes prices from the
+#   validator every vote period. Enabled with `--features mock-oracle`.
+FLAG_MOCK_ORACLE=false
+
 build_from_source() {
   echo_info "Building from source..."
   if make install; then
@@ -79,17 +83,26 @@ enable_feature_flag() {
   case $1 in
     perp) FLAG_PERP=true ;;
     spot) FLAG_SPOT=true ;;
+    mock-oracle) FLAG_MOCK_ORACLE=true ;;
     *) echo_error "Unknown feature: $1" ;;
   esac
 }
 
-# Iterate over flags, handling the cases: "--no-build" and "--features"
+# Iterate over flags, handling the cases: "--no-build", "--dev", and "--features"
 while [[ $# -gt 0 ]]; do
   case $1 in
     --no-build)
       FLAG_NO_BUILD=true
       shift
       ;;
+    --dev)
+      # Dev mode: every feature plus the mock price feeder, so that front-end
+      # and contract developers get a working chain with one command.
+      FLAG_PERP=true
+      FLAG_SPOT=true
+      FLAG_MOCK_ORACLE=true
+      shift
+      ;;
     --features)
       shift # Remove '--features' from arguments
       while [[ $# -gt 0 && $1 != --* ]]; do
@@ -110,6 +123,7 @@ echo_info "Features flags:"
 echo "FLAG_NO_BUILD: $FLAG_NO_BUILD"
 echo "FLAG_PERP: $FLAG_PERP"
 echo "FLAG_SPOT: $FLAG_SPOT"
+echo "FLAG_MOCK_ORACLE: $FLAG_MOCK_ORACLE"
 
 SEDOPTION=""
 if [[ "$OSTYPE" == "darwin"* ]]; then
@@ -217,13 +231,20 @@ add_genesis_param '.app_state.sudo.sudoers.root = "'"$val_address"'"'
 # hack for localnet since we don't have a pricefeeder yet
 price_btc="50000"
 price_eth="2000"
-add_genesis_param '.app_state.oracle.exchange_rates[0].pair = "ubtc:uuusd"'
+add_genesis_param '.app_state.oracle.exchange_rates[0].pair = "ubtc:uusd"'
 add_genesis_param '.app_state.oracle.exchange_rates[0].exchange_rate = "'"$price_btc"'"'
-add_genesis_param '.app_state.oracle.exchange_rates[1].pair = "ueth:uuusd"'
+add_genesis_param '.app_state.oracle.exchange_rates[1].pair = "ueth:uusd"'
 add_genesis_param '.app_state.oracle.exchange_rates[1].exchange_rate = "'"$price_eth"'"'
 
 add_genesis_param '.app_state.inflation.params.inflation_enabled = false'
 
+if $FLAG_MOCK_ORACLE; then
+  curr_dir="$( cd "$( dirname "${BASH_SOURCE[0]}" )" &> /dev/null && pwd )"
+  source "$curr_dir/feat-oracle.sh"
+  add_genesis_mock_oracle_params
+  echo_success "set oracle params for the mock price feeder"
+fi
+
 # ------------------------------------------------------------------------
 # Gentx
 # ------------------------------------------------------------------------
@@ -247,4 +268,9 @@ fi
 # ------------------------------------------------------------------------
 
 echo_info "Starting $CHAIN_ID in $CHAIN_DIR..."
+if $FLAG_MOCK_ORACLE; then
+  run_mock_price_feeder $val_key_name &
+  feeder_pid=$!
+  trap 'kill $feeder_pid 2>/dev/null' EXIT
+fi
 $BINARY start --home "$CHAIN_DIR" --pruning nothing
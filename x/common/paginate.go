@@ -8,6 +8,12 @@ import (
 
 const DefaultPageItemsLimit uint64 = 50
 
+// UnpaginatedItemsLimit is the number of results returned by a
+// ParsePaginationOrAll query that is given no PageRequest. It is large enough
+// to hold every result in practice, while still bounding the response. A
+// response that hits it carries a next key to continue from.
+const UnpaginatedItemsLimit uint64 = 1_000
+
 // ParsePagination: Validates and cleans a PageRequest to make setting values
 // less error-prone and use Nibiru-specific defaults.
 //  1. This fn is intended to be used with sdkquery.Paginate, which paginates
@@ -53,3 +59,18 @@ func ParsePagination(
 	}
 	return newPageReq, page, err
 }
+
+// ParsePaginationOrAll is ParsePagination for list queries that returned all
+// of their results before they accepted a PageRequest. A nil PageRequest
+// selects up to UnpaginatedItemsLimit results, so clients that do not paginate
+// keep getting complete responses without the response being unbounded. A
+// given PageRequest is cleaned by ParsePagination.
+func ParsePaginationOrAll(
+	pageReq *sdkquery.PageRequest,
+) (newPageReq *sdkquery.PageRequest, err error) {
+	if pageReq == nil {
+		return &sdkquery.PageRequest{Limit: UnpaginatedItemsLimit}, nil
+	}
+	newPageReq, _, err = ParsePagination(pageReq)
+	return newPageReq, err
+}
@@ -104,3 +104,16 @@ func (s *paginateSuite) TestParsePagination() {
 		})
 	}
 }
+
+func (s *paginateSuite) TestParsePaginationOrAll() {
+	pageReq, err := common.ParsePaginationOrAll(nil)
+	s.NoError(err)
+	s.Equal(common.UnpaginatedItemsLimit, pageReq.Limit)
+
+	pageReq, err = common.ParsePaginationOrAll(&sdkquery.PageRequest{Limit: 1_000})
+	s.NoError(err)
+	s.Equal(common.DefaultPageItemsLimit, pageReq.Limit)
+
+	_, err = common.ParsePaginationOrAll(&sdkquery.PageRequest{Key: []byte("key"), Offset: 1})
+	s.Error(err)
+}
@@ -0,0 +1,99 @@
+package common
+
+import (
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxSeriesTime sorts after the timestamp of every entry, so that starting an
+// iteration after (series, maxSeriesTime) skips to the next series.
+var maxSeriesTime = time.Date(9999, 12, 31, 23, 59, 59, 999_999_999, time.UTC)
+
+// TimeSeriesPruner deletes the entries of a map keyed by (series, time), e.g.
+// price snapshots keyed by (pair, time), that are older than the retention
+// window of their series.
+type TimeSeriesPruner[K, V any] struct {
+	// Entries is the time series map to prune.
+	Entries collections.Map[collections.Pair[K, time.Time], V]
+	// NextPruneTimeMs stores the block time, in unix milliseconds, before
+	// which no entry expires. Prune does not iterate the entries before then.
+	NextPruneTimeMs collections.Item[uint64]
+	// Retention returns the retention window of a series.
+	Retention func(series K) time.Duration
+	// MinRetention is the shortest retention window of any series, which
+	// bounds when entries added after a prune can expire.
+	MinRetention time.Duration
+	// KeepLatestExpired keeps the newest expired entry of every series, e.g.
+	// to serve as the value at the start of a TWAP window.
+	KeepLatestExpired bool
+}
+
+// Prune deletes at most limit expired entries, across all series, and returns
+// the number of deleted entries. Since entries are ordered by series first,
+// every series with entries is visited, so the pass only runs once the stored
+// next prune time is reached.
+func (p TimeSeriesPruner[K, V]) Prune(ctx sdk.Context, limit uint64) (pruned uint64) {
+	now := ctx.BlockTime()
+	if limit == 0 || now.UnixMilli() < int64(p.NextPruneTimeMs.GetOr(ctx, 0)) {
+		return 0
+	}
+
+	next := now.Add(p.MinRetention)
+	seriesRange := collections.Range[collections.Pair[K, time.Time]]{}
+	for {
+		// find the next series holding entries
+		iter := p.Entries.Iterate(ctx, seriesRange)
+		if !iter.Valid() {
+			iter.Close()
+			break
+		}
+		series := iter.Key().K1()
+		iter.Close()
+
+		retention := p.Retention(series)
+		cutoff := now.Add(-retention)
+		remaining := limit - pruned
+
+		// collect up to remaining+1 expired entries, so that one is left over
+		// to keep if the limit is not reached
+		iter = p.Entries.Iterate(ctx, collections.PairRange[K, time.Time]{}.Prefix(series))
+		var keys []collections.Pair[K, time.Time]
+		for ; iter.Valid() && iter.Key().K2().Before(cutoff) && uint64(len(keys)) <= remaining; iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		limited := uint64(len(keys)) > remaining
+		switch {
+		case limited:
+			keys = keys[:remaining]
+		case p.KeepLatestExpired && len(keys) > 0:
+			keys = keys[:len(keys)-1]
+		case p.KeepLatestExpired && iter.Valid():
+			// the first entry is kept until the one after it expires
+			iter.Next()
+		}
+		if !limited && iter.Valid() {
+			if expiry := iter.Key().K2().Add(retention); expiry.Before(next) {
+				next = expiry
+			}
+		}
+		iter.Close()
+
+		for _, key := range keys {
+			_ = p.Entries.Delete(ctx, key)
+		}
+		pruned += uint64(len(keys))
+
+		if limited {
+			// continue in the next block
+			next = now
+			break
+		}
+		seriesRange = collections.Range[collections.Pair[K, time.Time]]{}.
+			StartExclusive(collections.Join(series, maxSeriesTime))
+	}
+
+	p.NextPruneTimeMs.Set(ctx, uint64(next.UnixMilli()))
+	return pruned
+}
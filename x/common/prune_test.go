@@ -0,0 +1,71 @@
+package common_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common"
+)
+
+func TestTimeSeriesPruner(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey("prune")
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_prune"))
+	now := time.Date(2023, time.September, 15, 12, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	entries := collections.NewMap(storeKey, 1,
+		collections.PairKeyEncoder(collections.StringKeyEncoder, collections.TimeKeyEncoder),
+		collections.Uint64ValueEncoder)
+	insert := func(series string, ages ...time.Duration) {
+		for _, age := range ages {
+			entries.Insert(ctx, collections.Join(series, now.Add(-age)), 0)
+		}
+	}
+	count := func(series string) int {
+		return len(entries.Iterate(ctx, collections.PairRange[string, time.Time]{}.Prefix(series)).Keys())
+	}
+	pruner := common.TimeSeriesPruner[string, uint64]{
+		Entries:         entries,
+		NextPruneTimeMs: collections.NewItem(storeKey, 2, collections.Uint64ValueEncoder),
+		Retention: func(series string) time.Duration {
+			if series == "long" {
+				return 2 * time.Hour
+			}
+			return time.Hour
+		},
+		MinRetention: time.Hour,
+	}
+
+	insert("a", 3*time.Hour, 2*time.Hour, 90*time.Minute, 30*time.Minute)
+	insert("long", 3*time.Hour, 90*time.Minute)
+	insert("b", 2*time.Hour)
+
+	require.Zero(t, pruner.Prune(ctx, 0))
+
+	// the limit is applied across series
+	require.EqualValues(t, 3, pruner.Prune(ctx, 3))
+	require.Equal(t, 1, count("a"))
+	require.Equal(t, 1, count("b"))
+	require.Equal(t, 2, count("long"))
+
+	require.EqualValues(t, 2, pruner.Prune(ctx, 100))
+	require.Equal(t, 1, count("long"))
+	require.Zero(t, count("b"))
+
+	// nothing expires until the "a" entry from 30 minutes ago does
+	insert("b", 2*time.Hour)
+	require.Zero(t, pruner.Prune(ctx.WithBlockTime(now.Add(29*time.Minute)), 100))
+	require.EqualValues(t, 1, pruner.Prune(ctx.WithBlockTime(now.Add(30*time.Minute)), 100))
+
+	// the newest expired entry of a series is kept
+	pruner.KeepLatestExpired = true
+	insert("c", 3*time.Hour, 2*time.Hour)
+	pruner.NextPruneTimeMs.Set(ctx, 0)
+	require.EqualValues(t, 1, pruner.Prune(ctx, 100))
+	require.Equal(t, 1, count("c"))
+}
@@ -0,0 +1,89 @@
+package common
+
+import (
+	"context"
+	"runtime/debug"
+
+	sdkerrors "cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+)
+
+// ErrorCodespace is the codespace of the errors registered by x/common. Like
+// "ante-nibiru" for app/ante, it is suffixed with "nibiru" so that it cannot
+// collide with the codespace of a module.
+const ErrorCodespace = "common-nibiru"
+
+// ErrMsgServerPanic is returned in place of a panic raised while executing a
+// message on a msg server wrapped with RecoverMsgServer. Code 1 is skipped, as
+// it is reserved for internal errors.
+var ErrMsgServerPanic = sdkerrors.Register(ErrorCodespace, 2, "recovered panic in msg server")
+
+// RecoverMsgServer wraps the msg server registrar of a module so that every
+// method of the services registered on it converts unexpected panics, for
+// example from sdk.Dec arithmetic on malformed input, into ErrMsgServerPanic.
+// The panic value and stack trace are written to the logs.
+//
+// Out of gas panics are re-raised so that baseapp keeps accounting for them
+// as usual.
+//
+// Usage, in a module's RegisterServices:
+//
+//	types.RegisterMsgServer(
+//	  common.RecoverMsgServer(cfg.MsgServer()), keeper.NewMsgServerImpl(am.keeper))
+func RecoverMsgServer(server gogogrpc.Server) gogogrpc.Server {
+	return recoverMsgServer{Server: server}
+}
+
+type recoverMsgServer struct {
+	gogogrpc.Server
+}
+
+// RegisterService implements the gogogrpc.Server interface.
+func (s recoverMsgServer) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	desc := *sd
+	desc.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		desc.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler:    recoverMethodHandler("/"+sd.ServiceName+"/"+method.MethodName, method.Handler),
+		}
+	}
+	s.Server.RegisterService(&desc, handler)
+}
+
+type methodHandler = func(
+	srv interface{}, ctx context.Context, dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error)
+
+func recoverMethodHandler(fqMethod string, handler methodHandler) methodHandler {
+	return func(
+		srv interface{}, ctx context.Context, dec func(interface{}) error,
+		interceptor grpc.UnaryServerInterceptor,
+	) (resp interface{}, err error) {
+		defer func() {
+			panicInfo := recover()
+			if panicInfo == nil {
+				return
+			}
+			switch panicInfo.(type) {
+			case storetypes.ErrorOutOfGas, storetypes.ErrorGasOverflow:
+				panic(panicInfo)
+			}
+
+			if sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context); ok {
+				sdkCtx.Logger().Error(
+					"recovered panic in msg server",
+					"method", fqMethod,
+					"panic", panicInfo,
+					"stack", string(debug.Stack()),
+				)
+			}
+			resp, err = nil, ErrMsgServerPanic.Wrapf("%s: %v", fqMethod, panicInfo)
+		}()
+		return handler(srv, ctx, dec, interceptor)
+	}
+}
@@ -0,0 +1,71 @@
+package common_test
+
+import (
+	"context"
+	"testing"
+
+	sdkerrors "cosmossdk.io/errors"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common"
+)
+
+type panickingMsgServer struct {
+	panicWith any
+}
+
+func (s panickingMsgServer) CreateDog(
+	_ context.Context, msg *testdata.MsgCreateDog,
+) (*testdata.MsgCreateDogResponse, error) {
+	if s.panicWith != nil {
+		panic(s.panicWith)
+	}
+	return &testdata.MsgCreateDogResponse{Name: msg.Dog.Name}, nil
+}
+
+func TestRecoverMsgServer(t *testing.T) {
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "spot"}}
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+
+	newHandler := func(server testdata.MsgServer) baseapp.MsgServiceHandler {
+		registry := codectypes.NewInterfaceRegistry()
+		testdata.RegisterInterfaces(registry)
+		router := baseapp.NewMsgServiceRouter()
+		router.SetInterfaceRegistry(registry)
+		testdata.RegisterMsgServer(common.RecoverMsgServer(router), server)
+		return router.Handler(msg)
+	}
+
+	t.Run("no panic", func(t *testing.T) {
+		_, err := newHandler(panickingMsgServer{})(ctx, msg)
+		require.NoError(t, err)
+	})
+
+	t.Run("panic becomes an error", func(t *testing.T) {
+		var err error
+		require.NotPanics(t, func() {
+			_, err = newHandler(panickingMsgServer{panicWith: "division by zero"})(ctx, msg)
+		})
+		require.ErrorIs(t, err, common.ErrMsgServerPanic)
+		require.ErrorContains(t, err, "/testpb.Msg/CreateDog: division by zero")
+
+		codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+		require.Equal(t, common.ErrorCodespace, codespace)
+		require.EqualValues(t, 2, code)
+	})
+
+	t.Run("out of gas still panics", func(t *testing.T) {
+		handler := newHandler(panickingMsgServer{panicWith: storetypes.ErrorOutOfGas{Descriptor: "test"}})
+		require.Panics(t, func() {
+			_, _ = handler(ctx, msg)
+		})
+	})
+}
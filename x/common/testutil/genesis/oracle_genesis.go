@@ -17,6 +17,10 @@ func AddOracleGenesis(gen app.GenesisState) app.GenesisState {
 
 func OracleGenesis() *oracletypes.GenesisState {
 	oracleGenesis := oracletypes.DefaultGenesisState()
+	oracleGenesis.Params.Whitelist = append(oracleGenesis.Params.Whitelist,
+		asset.Registry.Pair(denoms.ETH, denoms.NUSD),
+		asset.Registry.Pair(denoms.NIBI, denoms.NUSD),
+	)
 	oracleGenesis.ExchangeRates = []oracletypes.ExchangeRateTuple{
 		{Pair: asset.Registry.Pair(denoms.ETH, denoms.NUSD), ExchangeRate: sdk.NewDec(1_000)},
 		{Pair: asset.Registry.Pair(denoms.NIBI, denoms.NUSD), ExchangeRate: sdk.NewDec(10)},
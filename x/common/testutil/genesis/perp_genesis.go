@@ -23,6 +23,7 @@ func AddPerpV2Genesis(gen app.GenesisState) app.GenesisState {
 				Enabled:                         true,
 				MaintenanceMarginRatio:          sdk.MustNewDecFromStr("0.04"),
 				MaxLeverage:                     sdk.MustNewDecFromStr("20"),
+				InitialMarginRatio:              sdk.MustNewDecFromStr("0.05"),
 				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 				ExchangeFeeRatio:                sdk.MustNewDecFromStr("0.0010"),
 				EcosystemFundFeeRatio:           sdk.MustNewDecFromStr("0.0010"),
@@ -52,6 +53,7 @@ func AddPerpV2Genesis(gen app.GenesisState) app.GenesisState {
 				Version:                         1,
 				MaintenanceMarginRatio:          sdk.MustNewDecFromStr("0.0625"),
 				MaxLeverage:                     sdk.MustNewDecFromStr("15"),
+				InitialMarginRatio:              sdk.OneDec().Quo(sdk.NewDec(15)),
 				ExchangeFeeRatio:                sdk.MustNewDecFromStr("0.0010"),
 				EcosystemFundFeeRatio:           sdk.MustNewDecFromStr("0.0010"),
 				LiquidationFeeRatio:             sdk.MustNewDecFromStr("0.0500"),
@@ -81,6 +83,7 @@ func AddPerpV2Genesis(gen app.GenesisState) app.GenesisState {
 				Version:                         1,
 				MaintenanceMarginRatio:          sdk.MustNewDecFromStr("0.0625"),
 				MaxLeverage:                     sdk.MustNewDecFromStr("15"),
+				InitialMarginRatio:              sdk.OneDec().Quo(sdk.NewDec(15)),
 				ExchangeFeeRatio:                sdk.MustNewDecFromStr("0.0010"),
 				EcosystemFundFeeRatio:           sdk.MustNewDecFromStr("0.0010"),
 				LiquidationFeeRatio:             sdk.MustNewDecFromStr("0.0500"),
@@ -142,6 +145,7 @@ var START_MARKETS = map[asset.Pair]perpv2types.AmmMarket{
 			Version:                         1,
 			MaintenanceMarginRatio:          sdk.MustNewDecFromStr("0.0625"),
 			MaxLeverage:                     sdk.MustNewDecFromStr("15"),
+			InitialMarginRatio:              sdk.OneDec().Quo(sdk.NewDec(15)),
 			LatestCumulativePremiumFraction: sdk.ZeroDec(),
 			ExchangeFeeRatio:                sdk.MustNewDecFromStr("0.0010"),
 			EcosystemFundFeeRatio:           sdk.MustNewDecFromStr("0.0010"),
@@ -171,6 +175,7 @@ var START_MARKETS = map[asset.Pair]perpv2types.AmmMarket{
 			Version:                         1,
 			MaintenanceMarginRatio:          sdk.MustNewDecFromStr("0.04"),
 			MaxLeverage:                     sdk.MustNewDecFromStr("20"),
+			InitialMarginRatio:              sdk.MustNewDecFromStr("0.05"),
 			LatestCumulativePremiumFraction: sdk.ZeroDec(),
 			ExchangeFeeRatio:                sdk.MustNewDecFromStr("0.0010"),
 			EcosystemFundFeeRatio:           sdk.MustNewDecFromStr("0.0010"),
@@ -203,6 +208,7 @@ func PerpV2Genesis() *perpv2types.GenesisState {
 				Enabled:                         true,
 				MaintenanceMarginRatio:          sdk.MustNewDecFromStr("0.04"),
 				MaxLeverage:                     sdk.MustNewDecFromStr("20"),
+				InitialMarginRatio:              sdk.MustNewDecFromStr("0.05"),
 				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 				ExchangeFeeRatio:                sdk.MustNewDecFromStr("0.0010"),
 				EcosystemFundFeeRatio:           sdk.MustNewDecFromStr("0.0010"),
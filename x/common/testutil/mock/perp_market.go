@@ -18,6 +18,7 @@ func TestMarket() *types.Market {
 		Version:                         1,
 		MaintenanceMarginRatio:          sdk.MustNewDecFromStr("0.0625"),
 		MaxLeverage:                     sdk.MustNewDecFromStr("10"),
+		InitialMarginRatio:              sdk.MustNewDecFromStr("0.1"),
 		LatestCumulativePremiumFraction: sdk.ZeroDec(),
 		ExchangeFeeRatio:                sdk.MustNewDecFromStr("0.0010"),
 		EcosystemFundFeeRatio:           sdk.MustNewDecFromStr("0.0010"),
@@ -16,6 +16,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/devgas/v1/client/cli"
 	"github.com/NibiruChain/nibiru/x/devgas/v1/exported"
 	"github.com/NibiruChain/nibiru/x/devgas/v1/keeper"
@@ -140,7 +141,7 @@ func (am AppModule) QuerierRoute() string {
 // RegisterServices registers a GRPC query service to respond to the
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
+	types.RegisterMsgServer(common.RecoverMsgServer(cfg.MsgServer()), am.keeper)
 	types.RegisterQueryServer(
 		cfg.QueryServer(), keeper.NewQuerier(am.keeper),
 	)
@@ -27,4 +27,12 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	if types.IsPeriodLastBlock(ctx, params.SlashWindow) {
 		k.SlashAndResetMissCounters(ctx)
 	}
+
+	if params.MaxSnapshotPrunesPerBlock > 0 {
+		pruned := k.PruneExpiredSnapshots(ctx, params.MaxSnapshotPrunesPerBlock)
+		telemetry.IncrCounter(float32(pruned), types.ModuleName, "snapshots_pruned")
+		if pruned == params.MaxSnapshotPrunesPerBlock {
+			telemetry.IncrCounter(1, types.ModuleName, "snapshot_prune_limit_reached")
+		}
+	}
 }
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -33,6 +34,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryAggregatePrevote(),
 		GetCmdQueryAggregateVote(),
 		GetCmdQueryVoteTargets(),
+		GetCmdQueryPriceAtTime(),
+		GetCmdQueryPriceHistory(),
+		GetCmdQueryOracleStatus(),
+		GetCmdQueryUsdPrice(),
 	)
 
 	return oracleQueryCmd
@@ -62,7 +67,15 @@ $ nibid query oracle exchange-rates nibi:usd
 			queryClient := types.NewQueryClient(clientCtx)
 
 			if len(args) == 0 {
-				res, err := queryClient.ExchangeRates(context.Background(), &types.QueryExchangeRatesRequest{})
+				pageReq, err := client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+
+				res, err := queryClient.ExchangeRates(
+					context.Background(),
+					&types.QueryExchangeRatesRequest{Pagination: pageReq},
+				)
 				if err != nil {
 					return err
 				}
@@ -88,6 +101,7 @@ $ nibid query oracle exchange-rates nibi:usd
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "exchange rates")
 	return cmd
 }
 
@@ -251,9 +265,14 @@ $ nibid query oracle aggregate-prevotes nibivaloper...
 			queryClient := types.NewQueryClient(clientCtx)
 
 			if len(args) == 0 {
+				pageReq, err := client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+
 				res, err := queryClient.AggregatePrevotes(
 					context.Background(),
-					&types.QueryAggregatePrevotesRequest{},
+					&types.QueryAggregatePrevotesRequest{Pagination: pageReq},
 				)
 				if err != nil {
 					return err
@@ -281,6 +300,7 @@ $ nibid query oracle aggregate-prevotes nibivaloper...
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "aggregate prevotes")
 	return cmd
 }
 
@@ -307,9 +327,14 @@ $ nibid query oracle aggregate-votes nibivaloper...
 			queryClient := types.NewQueryClient(clientCtx)
 
 			if len(args) == 0 {
+				pageReq, err := client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+
 				res, err := queryClient.AggregateVotes(
 					context.Background(),
-					&types.QueryAggregateVotesRequest{},
+					&types.QueryAggregateVotesRequest{Pagination: pageReq},
 				)
 				if err != nil {
 					return err
@@ -337,6 +362,7 @@ $ nibid query oracle aggregate-votes nibivaloper...
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "aggregate votes")
 	return cmd
 }
 
@@ -368,3 +394,208 @@ func GetCmdQueryVoteTargets() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+const (
+	FlagStartTime = "start-time"
+	FlagEndTime   = "end-time"
+)
+
+// GetCmdQueryPriceAtTime implements the query price at time command.
+func GetCmdQueryPriceAtTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-at-time [pair] [time]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the price of a pair at a past time",
+		Long: strings.TrimSpace(`
+Query the latest price snapshot of a pair taken at or before the given time,
+formatted as RFC3339.
+
+$ nibid query oracle price-at-time ubtc:unusd 2023-06-01T12:00:00Z
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			assetPair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			at, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PriceAtTime(
+				context.Background(),
+				&types.QueryPriceAtTimeRequest{Pair: assetPair, Time: at},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPriceHistory implements the query price history command.
+func GetCmdQueryPriceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [pair]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the price snapshots of a pair",
+		Long: strings.TrimSpace(`
+Query the price snapshots of a pair, oldest first. The range can be narrowed
+with times formatted as RFC3339.
+
+$ nibid query oracle price-history ubtc:unusd --start-time 2023-06-01T00:00:00Z --end-time 2023-06-02T00:00:00Z
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			assetPair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPriceHistoryRequest{Pair: assetPair, Pagination: pageReq}
+			if req.StartTime, err = parseTimeFlag(cmd, FlagStartTime); err != nil {
+				return err
+			}
+			if req.EndTime, err = parseTimeFlag(cmd, FlagEndTime); err != nil {
+				return err
+			}
+
+			res, err := queryClient.PriceHistory(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStartTime, "", "exclude snapshots taken before this time (RFC3339)")
+	cmd.Flags().String(FlagEndTime, "", "exclude snapshots taken after this time (RFC3339)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "price history")
+	return cmd
+}
+
+// parseTimeFlag returns the RFC3339 time of the flag, or nil if it is unset.
+func parseTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// GetCmdQueryOracleStatus implements the query oracle status command
+func GetCmdQueryOracleStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [validator]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the feed health of validators",
+		Long: strings.TrimSpace(`
+Query the miss counter of validators and, per pair, their last vote and its
+deviation from the tallied price. If a validator is given, only its status is
+returned.
+
+$ nibid query oracle status
+$ nibid query oracle status nibivaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryOracleStatusRequest{Pagination: pageReq}
+			if len(args) != 0 {
+				validator, err := sdk.ValAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+				req.ValidatorAddr = validator.String()
+			}
+
+			res, err := queryClient.OracleStatus(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "oracle status")
+	return cmd
+}
+
+// GetCmdQueryUsdPrice implements the query usd price command.
+func GetCmdQueryUsdPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usd-price [pair]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the USD price of the base asset of a pair",
+		Long: strings.TrimSpace(`
+Query the USD price of the base asset of a pair. Pairs that are not quoted in
+USD are converted with the USD price of their quote asset.
+
+$ nibid query oracle usd-price ueth:uusdc
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			assetPair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UsdPrice(
+				context.Background(),
+				&types.QueryUsdPriceRequest{Pair: assetPair},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
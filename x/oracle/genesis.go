@@ -2,12 +2,14 @@ package oracle
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/collections"
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/set"
 	"github.com/NibiruChain/nibiru/x/oracle/keeper"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
@@ -29,6 +31,18 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.FeederDelegations.Insert(ctx, voter, feeder)
 	}
 
+	if err := data.ValidatePriceSnapshotTimes(ctx.BlockTime()); err != nil {
+		panic(err)
+	}
+	for _, snapshot := range data.PriceSnapshots {
+		snapshotTime := time.UnixMilli(snapshot.TimestampMs)
+		retention := data.Params.SnapshotRetentionWindow
+		if retention != 0 && snapshotTime.Before(ctx.BlockTime().Add(-retention)) {
+			continue
+		}
+		keeper.PriceSnapshots.Insert(ctx, collections.Join(snapshot.Pair, snapshotTime), snapshot)
+	}
+
 	for _, ex := range data.ExchangeRates {
 		keeper.SetPrice(ctx, ex.Pair, ex.ExchangeRate)
 	}
@@ -104,11 +118,25 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		})
 	}
 
+	// rates and snapshots of pairs no longer whitelisted are not exported,
+	// since ValidateGenesis rejects them
+	whitelist := set.New(params.Whitelist...)
+
 	exchangeRates := []types.ExchangeRateTuple{}
 	for _, er := range keeper.ExchangeRates.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
+		if !whitelist.Has(er.Key) {
+			continue
+		}
 		exchangeRates = append(exchangeRates, types.ExchangeRateTuple{Pair: er.Key, ExchangeRate: er.Value.ExchangeRate})
 	}
 
+	var priceSnapshots []types.PriceSnapshot
+	for _, snapshot := range keeper.PriceSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}).Values() {
+		if whitelist.Has(snapshot.Pair) {
+			priceSnapshots = append(priceSnapshots, snapshot)
+		}
+	}
+
 	missCounters := []types.MissCounter{}
 	for _, mc := range keeper.MissCounters.Iterate(ctx, collections.Range[sdk.ValAddress]{}).KeyValues() {
 		missCounters = append(missCounters, types.MissCounter{
@@ -129,5 +157,6 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		keeper.Votes.Iterate(ctx, collections.Range[sdk.ValAddress]{}).Values(),
 		pairs,
 		keeper.Rewards.Iterate(ctx, collections.Range[uint64]{}).Values(),
+		priceSnapshots,
 	)
 }
@@ -2,10 +2,15 @@ package oracle_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/oracle"
 	"github.com/NibiruChain/nibiru/x/oracle/keeper"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
@@ -13,10 +18,18 @@ import (
 
 func TestExportInitGenesis(t *testing.T) {
 	input := keeper.CreateTestFixture(t)
+	// a block time with nanoseconds, which snapshot timestamps do not keep
+	genesisTime := time.Date(2023, time.September, 15, 12, 0, 0, 123_456_789, time.UTC)
+	input.Ctx = input.Ctx.WithBlockTime(genesisTime)
 
-	input.OracleKeeper.Params.Set(input.Ctx, types.DefaultParams())
+	params := types.DefaultParams()
+	params.Whitelist = append(params.Whitelist, "pair1:pair2")
+	input.OracleKeeper.Params.Set(input.Ctx, params)
 	input.OracleKeeper.FeederDelegations.Insert(input.Ctx, keeper.ValAddrs[0], keeper.Addrs[1])
-	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, "pair1:pair2", types.DatedPrice{ExchangeRate: sdk.NewDec(123), CreatedBlock: 0})
+	input.OracleKeeper.SetPrice(input.Ctx.WithBlockTime(genesisTime.Add(-time.Minute)), "pair1:pair2", sdk.NewDec(120))
+	input.OracleKeeper.SetPrice(input.Ctx, "pair1:pair2", sdk.NewDec(123))
+	// a pair taken off the whitelist is not exported
+	input.OracleKeeper.SetPrice(input.Ctx, "delisted:pair", sdk.NewDec(1))
 	input.OracleKeeper.Prevotes.Insert(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{123}, keeper.ValAddrs[0], uint64(2)))
 	input.OracleKeeper.Votes.Insert(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{{Pair: "foo", ExchangeRate: sdk.NewDec(123)}}, keeper.ValAddrs[0]))
 	input.OracleKeeper.WhitelistedPairs.Insert(input.Ctx, "pair1:pair1")
@@ -29,11 +42,25 @@ func TestExportInitGenesis(t *testing.T) {
 	})
 	genesis := oracle.ExportGenesis(input.Ctx, input.OracleKeeper)
 
+	require.Len(t, genesis.PriceSnapshots, 2)
+	require.Len(t, genesis.ExchangeRates, 1)
+	require.NoError(t, types.ValidateGenesis(genesis))
+	require.NoError(t, genesis.ValidatePriceSnapshotTimes(genesisTime))
+
 	newInput := keeper.CreateTestFixture(t)
+	newInput.Ctx = newInput.Ctx.WithBlockTime(genesisTime)
 	oracle.InitGenesis(newInput.Ctx, newInput.OracleKeeper, genesis)
 	newGenesis := oracle.ExportGenesis(newInput.Ctx, newInput.OracleKeeper)
 
 	require.Equal(t, genesis, newGenesis)
+
+	// the snapshots keep their keys
+	snapshotKeys := func(input keeper.TestFixture) []collections.Pair[asset.Pair, time.Time] {
+		return input.OracleKeeper.PriceSnapshots.Iterate(
+			input.Ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix("pair1:pair2")).Keys()
+	}
+	require.Len(t, snapshotKeys(input), 2)
+	require.Equal(t, snapshotKeys(input), snapshotKeys(newInput))
 }
 
 func TestInitGenesis(t *testing.T) {
@@ -136,3 +163,34 @@ func TestInitGenesis(t *testing.T) {
 		oracle.InitGenesis(input.Ctx, input.OracleKeeper, genesis)
 	})
 }
+
+func TestInitGenesisPriceSnapshots(t *testing.T) {
+	input := keeper.CreateTestFixture(t)
+	genesisTime := input.Ctx.BlockTime()
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+
+	genesis := types.DefaultGenesisState()
+	genesis.PriceSnapshots = []types.PriceSnapshot{
+		{Pair: pair, Price: sdk.NewDec(1), TimestampMs: genesisTime.Add(-30 * 24 * time.Hour).UnixMilli()},
+		{Pair: pair, Price: sdk.NewDec(2), TimestampMs: genesisTime.Add(-time.Minute).UnixMilli()},
+	}
+	require.NoError(t, types.ValidateGenesis(genesis))
+	oracle.InitGenesis(input.Ctx, input.OracleKeeper, genesis)
+
+	// snapshots older than the retention window are dropped
+	snapshots := input.OracleKeeper.PriceSnapshots.Iterate(input.Ctx, collections.PairRange[asset.Pair, time.Time]{}).Values()
+	require.Len(t, snapshots, 1)
+	require.Equal(t, sdk.NewDec(2), snapshots[0].Price)
+	twap, err := input.OracleKeeper.GetExchangeRateTwap(input.Ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), twap)
+
+	// snapshots after the genesis time are rejected
+	genesis.PriceSnapshots = []types.PriceSnapshot{
+		{Pair: pair, Price: sdk.NewDec(2), TimestampMs: genesisTime.Add(time.Minute).UnixMilli()},
+	}
+	require.ErrorContains(t, genesis.ValidatePriceSnapshotTimes(genesisTime), "after genesis time")
+	require.Panics(t, func() {
+		oracle.InitGenesis(keeper.CreateTestFixture(t).Ctx.WithBlockTime(genesisTime), input.OracleKeeper, genesis)
+	})
+}
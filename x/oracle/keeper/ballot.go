@@ -140,16 +140,18 @@ func (k Keeper) removeInvalidVotes(
 
 // Tally calculates the median and returns it. Sets the set of voters to be
 // rewarded, i.e. voted within a reasonable spread from the weighted median to
-// the store.
+// the store. The median is weighted by the voter weights of the pair if it has
+// any, and by stake otherwise, see voterWeightedMedian.
 //
 // ALERT: This function mutates validatorPerformances slice based on the votes
 // made by the validators.
 func Tally(
 	votes types.ExchangeRateVotes,
 	rewardBand sdk.Dec,
+	voterWeights []types.VoterWeight,
 	validatorPerformances types.ValidatorPerformances,
 ) sdk.Dec {
-	weightedMedian := votes.WeightedMedianWithAssertion()
+	weightedMedian := voterWeightedMedian(votes, voterWeights)
 	standardDeviation := votes.StandardDeviation(weightedMedian)
 	rewardSpread := weightedMedian.Mul(rewardBand.QuoInt64(2))
 
@@ -181,3 +183,19 @@ func Tally(
 
 	return weightedMedian
 }
+
+// voterWeightedMedian returns the median of the votes weighted by the voter
+// weights, if there are any. Otherwise, or if none of the weighted voters
+// voted, the median weighted by stake is returned.
+func voterWeightedMedian(votes types.ExchangeRateVotes, voterWeights []types.VoterWeight) sdk.Dec {
+	stakeWeightedMedian := votes.WeightedMedianWithAssertion()
+	if len(voterWeights) == 0 {
+		return stakeWeightedMedian
+	}
+
+	weightedVotes := votes.WithVoterWeights(voterWeights)
+	if weightedVotes.Power() == 0 {
+		return stakeWeightedMedian
+	}
+	return weightedVotes.WeightedMedianWithAssertion()
+}
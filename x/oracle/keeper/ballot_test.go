@@ -197,7 +197,7 @@ func TestFuzzTally(t *testing.T) {
 	f.Fuzz(&rewardBand)
 
 	require.NotPanics(t, func() {
-		Tally(votes, rewardBand, claimMap)
+		Tally(votes, rewardBand, nil, claimMap)
 	})
 }
 
@@ -14,7 +14,9 @@ import (
 
 	"github.com/NibiruChain/collections"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
@@ -31,6 +33,10 @@ type Keeper struct {
 
 	distrModuleName string
 
+	// authority is the address allowed to replace the module params with
+	// MsgUpdateParams, usually the x/gov module account.
+	authority string
+
 	// Module parameters
 	Params            collections.Item[types.Params]
 	ExchangeRates     collections.Map[asset.Pair, types.DatedPrice]
@@ -43,6 +49,20 @@ type Keeper struct {
 	PriceSnapshots collections.Map[
 		collections.Pair[asset.Pair, time.Time],
 		types.PriceSnapshot]
+	// NextSnapshotPruneTimeMs is the block time, in unix milliseconds, before
+	// which no price snapshot expires.
+	NextSnapshotPruneTimeMs collections.Item[uint64]
+	// EmaPrices maps the exponential moving average of a pair's exchange rate to
+	// the pair. It is updated every time a new price is set for the pair.
+	EmaPrices collections.Map[asset.Pair, types.DatedPrice]
+	// SuspectPairs maps a pair whose price moved by more than the max price
+	// change ratio to the block height until which its price is suspect.
+	SuspectPairs collections.Map[asset.Pair, uint64]
+	// VoterStats maps the vote statistics of a validator for a pair to the
+	// validator and the pair.
+	VoterStats collections.Map[
+		collections.Pair[sdk.ValAddress, asset.Pair],
+		types.VoterPairStats]
 	WhitelistedPairs collections.KeySet[asset.Pair]
 	Rewards          collections.Map[uint64, types.Rewards]
 	RewardsID        collections.Sequence
@@ -60,6 +80,7 @@ func NewKeeper(
 	sudoKeeper types.SudoKeeper,
 
 	distrName string,
+	authority string,
 ) Keeper {
 	// ensure oracle module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -67,30 +88,59 @@ func NewKeeper(
 	}
 
 	k := Keeper{
-		cdc:               cdc,
-		storeKey:          storeKey,
-		AccountKeeper:     accountKeeper,
-		bankKeeper:        bankKeeper,
-		distrKeeper:       distrKeeper,
-		StakingKeeper:     stakingKeeper,
-		SudoKeeper:        sudoKeeper,
-		distrModuleName:   distrName,
-		Params:            collections.NewItem(storeKey, 11, collections.ProtoValueEncoder[types.Params](cdc)),
-		ExchangeRates:     collections.NewMap(storeKey, 1, asset.PairKeyEncoder, collections.ProtoValueEncoder[types.DatedPrice](cdc)),
-		PriceSnapshots:    collections.NewMap(storeKey, 10, collections.PairKeyEncoder(asset.PairKeyEncoder, collections.TimeKeyEncoder), collections.ProtoValueEncoder[types.PriceSnapshot](cdc)),
-		FeederDelegations: collections.NewMap(storeKey, 2, collections.ValAddressKeyEncoder, collections.AccAddressValueEncoder),
-		MissCounters:      collections.NewMap(storeKey, 3, collections.ValAddressKeyEncoder, collections.Uint64ValueEncoder),
-		Prevotes:          collections.NewMap(storeKey, 4, collections.ValAddressKeyEncoder, collections.ProtoValueEncoder[types.AggregateExchangeRatePrevote](cdc)),
-		Votes:             collections.NewMap(storeKey, 5, collections.ValAddressKeyEncoder, collections.ProtoValueEncoder[types.AggregateExchangeRateVote](cdc)),
-		WhitelistedPairs:  collections.NewKeySet(storeKey, 6, asset.PairKeyEncoder),
+		cdc:                     cdc,
+		storeKey:                storeKey,
+		AccountKeeper:           accountKeeper,
+		bankKeeper:              bankKeeper,
+		distrKeeper:             distrKeeper,
+		StakingKeeper:           stakingKeeper,
+		SudoKeeper:              sudoKeeper,
+		distrModuleName:         distrName,
+		authority:               authority,
+		Params:                  collections.NewItem(storeKey, NamespaceParams, collections.ProtoValueEncoder[types.Params](cdc)),
+		ExchangeRates:           collections.NewMap(storeKey, NamespaceExchangeRates, asset.PairKeyEncoder, collections.ProtoValueEncoder[types.DatedPrice](cdc)),
+		PriceSnapshots:          collections.NewMap(storeKey, NamespacePriceSnapshots, collections.PairKeyEncoder(asset.PairKeyEncoder, collections.TimeKeyEncoder), collections.ProtoValueEncoder[types.PriceSnapshot](cdc)),
+		NextSnapshotPruneTimeMs: collections.NewItem(storeKey, NamespaceNextSnapshotPruneTimeMs, collections.Uint64ValueEncoder),
+		EmaPrices:               collections.NewMap(storeKey, NamespaceEmaPrices, asset.PairKeyEncoder, collections.ProtoValueEncoder[types.DatedPrice](cdc)),
+		SuspectPairs:            collections.NewMap(storeKey, NamespaceSuspectPairs, asset.PairKeyEncoder, collections.Uint64ValueEncoder),
+		VoterStats:              collections.NewMap(storeKey, NamespaceVoterStats, collections.PairKeyEncoder(collections.ValAddressKeyEncoder, asset.PairKeyEncoder), collections.ProtoValueEncoder[types.VoterPairStats](cdc)),
+		FeederDelegations:       collections.NewMap(storeKey, NamespaceFeederDelegations, collections.ValAddressKeyEncoder, collections.AccAddressValueEncoder),
+		MissCounters:            collections.NewMap(storeKey, NamespaceMissCounters, collections.ValAddressKeyEncoder, collections.Uint64ValueEncoder),
+		Prevotes:                collections.NewMap(storeKey, NamespacePrevotes, collections.ValAddressKeyEncoder, collections.ProtoValueEncoder[types.AggregateExchangeRatePrevote](cdc)),
+		Votes:                   collections.NewMap(storeKey, NamespaceVotes, collections.ValAddressKeyEncoder, collections.ProtoValueEncoder[types.AggregateExchangeRateVote](cdc)),
+		WhitelistedPairs:        collections.NewKeySet(storeKey, NamespaceWhitelistedPairs, asset.PairKeyEncoder),
 		Rewards: collections.NewMap(
-			storeKey, 7,
+			storeKey, NamespaceRewards,
 			collections.Uint64KeyEncoder, collections.ProtoValueEncoder[types.Rewards](cdc)),
-		RewardsID: collections.NewSequence(storeKey, 9),
+		RewardsID: collections.NewSequence(storeKey, NamespaceRewardsID),
 	}
 	return k
 }
 
+// Namespaces of the keeper collections. Queries that paginate over the raw
+// store of a collection use them as store prefixes.
+const (
+	NamespaceExchangeRates           collections.Namespace = 1
+	NamespaceFeederDelegations       collections.Namespace = 2
+	NamespaceMissCounters            collections.Namespace = 3
+	NamespacePrevotes                collections.Namespace = 4
+	NamespaceVotes                   collections.Namespace = 5
+	NamespaceWhitelistedPairs        collections.Namespace = 6
+	NamespaceRewards                 collections.Namespace = 7
+	NamespaceRewardsID               collections.Namespace = 9
+	NamespacePriceSnapshots          collections.Namespace = 10
+	NamespaceParams                  collections.Namespace = 11
+	NamespaceEmaPrices               collections.Namespace = 12
+	NamespaceSuspectPairs            collections.Namespace = 13
+	NamespaceVoterStats              collections.Namespace = 14
+	NamespaceNextSnapshotPruneTimeMs collections.Namespace = 15
+)
+
+// GetAuthority returns the x/oracle module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -129,6 +179,10 @@ func (k Keeper) GetExchangeRateTwap(ctx sdk.Context, pair asset.Pair) (price sdk
 		return sdk.OneDec().Neg(), err
 	}
 
+	if err := k.checkNotSuspect(ctx, pair); err != nil {
+		return sdk.OneDec().Neg(), err
+	}
+
 	snapshots := k.PriceSnapshots.Iterate(
 		ctx,
 		collections.PairRange[asset.Pair, time.Time]{}.
@@ -177,18 +231,117 @@ func (k Keeper) GetExchangeRateTwap(ctx sdk.Context, pair asset.Pair) (price sdk
 	return cumulativePrice.QuoInt64(ctx.BlockTime().UnixMilli() - firstTimestampMs), nil
 }
 
+// GetExchangeRate returns the exchange rate of the pair. If no rate is posted
+// for the pair but one is posted for its inverse, the reciprocal of the
+// inverse rate is returned instead. Suspect prices are never returned.
 func (k Keeper) GetExchangeRate(ctx sdk.Context, pair asset.Pair) (price sdk.Dec, err error) {
 	exchangeRate, err := k.ExchangeRates.Get(ctx, pair)
-	price = exchangeRate.ExchangeRate
-	return
+	if err == nil {
+		if err := k.checkNotSuspect(ctx, pair); err != nil {
+			return price, err
+		}
+		return exchangeRate.ExchangeRate, nil
+	}
+	if pair.Validate() != nil {
+		return price, err
+	}
+
+	inverse := pair.Inverse()
+	inverseRate, inverseErr := k.ExchangeRates.Get(ctx, inverse)
+	if inverseErr != nil || !inverseRate.ExchangeRate.IsPositive() {
+		return price, err
+	}
+	if err := k.checkNotSuspect(ctx, inverse); err != nil {
+		return price, err
+	}
+	return sdk.OneDec().Quo(inverseRate.ExchangeRate), nil
+}
+
+// GetExchangeRateBlock returns the block height at which the exchange rate of
+// the pair was last set.
+func (k Keeper) GetExchangeRateBlock(ctx sdk.Context, pair asset.Pair) (height uint64, err error) {
+	exchangeRate, err := k.ExchangeRates.Get(ctx, pair)
+	if err != nil {
+		return 0, err
+	}
+	return exchangeRate.CreatedBlock, nil
+}
+
+// GetUsdPrice returns the USD price of the base asset of the pair. Pairs that
+// are not quoted in USD are converted with the USD price of their quote asset,
+// e.g. the price of X:COLL is multiplied by the price of COLL:USD.
+func (k Keeper) GetUsdPrice(ctx sdk.Context, pair asset.Pair) (price sdk.Dec, err error) {
+	if err := pair.Validate(); err != nil {
+		return price, err
+	}
+
+	price, err = k.GetExchangeRate(ctx, pair)
+	if err != nil {
+		return price, err
+	}
+	if pair.QuoteDenom() == denoms.USD {
+		return price, nil
+	}
+
+	quotePrice, err := k.GetExchangeRate(ctx, asset.NewPair(pair.QuoteDenom(), denoms.USD))
+	if err != nil {
+		return price, err
+	}
+	return price.Mul(quotePrice), nil
+}
+
+// GetUsdBaseUnitPrice returns the price of one base unit of the base asset of
+// the pair in base units of USD. Oracle prices are quoted per display unit, so
+// the USD price is scaled by the display exponents found in the bank denom
+// metadata. Denoms without metadata have an exponent of zero.
+func (k Keeper) GetUsdBaseUnitPrice(ctx sdk.Context, pair asset.Pair) (price sdk.Dec, err error) {
+	price, err = k.GetUsdPrice(ctx, pair)
+	if err != nil {
+		return price, err
+	}
+
+	usdExponent := int(k.displayExponent(ctx, denoms.USD))
+	baseExponent := int(k.displayExponent(ctx, pair.BaseDenom()))
+	if usdExponent >= baseExponent {
+		return price.Mul(sdk.NewDec(10).Power(uint64(usdExponent - baseExponent))), nil
+	}
+	return price.Quo(sdk.NewDec(10).Power(uint64(baseExponent - usdExponent))), nil
+}
+
+// displayExponent returns the exponent of the display unit of the denom, or
+// zero if the denom has no metadata.
+func (k Keeper) displayExponent(ctx sdk.Context, denom string) uint32 {
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return 0
+	}
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return unit.Exponent
+		}
+	}
+	return 0
+}
+
+// checkNotSuspect returns ErrSuspectPrice if the price of the pair is suspect
+// at the current block height.
+func (k Keeper) checkNotSuspect(ctx sdk.Context, pair asset.Pair) error {
+	suspectUntil, err := k.SuspectPairs.Get(ctx, pair)
+	if err == nil && uint64(ctx.BlockHeight()) < suspectUntil {
+		return types.ErrSuspectPrice.Wrapf("pair %s is suspect until block %d", pair, suspectUntil)
+	}
+	return nil
 }
 
 // SetPrice sets the price for a pair as well as the price snapshot.
 func (k Keeper) SetPrice(ctx sdk.Context, pair asset.Pair, price sdk.Dec) {
 	k.ExchangeRates.Insert(ctx, pair, types.DatedPrice{ExchangeRate: price, CreatedBlock: uint64(ctx.BlockHeight())})
+	k.updateEma(ctx, pair, price)
 
-	key := collections.Join(pair, ctx.BlockTime())
+	// The snapshot is keyed by the block time truncated to milliseconds, the
+	// precision of its timestamp, so that genesis exports keep its key.
 	timestampMs := ctx.BlockTime().UnixMilli()
+	key := collections.Join(pair, time.UnixMilli(timestampMs))
 	k.PriceSnapshots.Insert(ctx, key, types.PriceSnapshot{
 		Pair:        pair,
 		Price:       price,
@@ -202,3 +355,47 @@ func (k Keeper) SetPrice(ctx sdk.Context, pair asset.Pair, price sdk.Dec) {
 		ctx.Logger().Error("failed to emit OraclePriceUpdate", "pair", pair, "error", err)
 	}
 }
+
+// updateEma folds the new price of the pair into its exponential moving
+// average, weighting the new price by the pair's EMA smoothing factor. The
+// first price of a pair seeds its EMA.
+func (k Keeper) updateEma(ctx sdk.Context, pair asset.Pair, price sdk.Dec) {
+	ema := price
+	if previous, err := k.EmaPrices.Get(ctx, pair); err == nil {
+		smoothing := types.DefaultEmaSmoothing
+		if params, err := k.Params.Get(ctx); err == nil {
+			smoothing = params.EmaSmoothingFor(pair)
+		}
+		// ema = previous + smoothing * (price - previous)
+		ema = previous.ExchangeRate.Add(smoothing.Mul(price.Sub(previous.ExchangeRate)))
+	}
+	k.EmaPrices.Insert(ctx, pair, types.DatedPrice{ExchangeRate: ema, CreatedBlock: uint64(ctx.BlockHeight())})
+}
+
+// GetExchangeRateEma returns the exponential moving average of the pair's
+// exchange rate.
+func (k Keeper) GetExchangeRateEma(ctx sdk.Context, pair asset.Pair) (price sdk.Dec, err error) {
+	ema, err := k.EmaPrices.Get(ctx, pair)
+	if err != nil {
+		return price, types.ErrNoValidEma.Wrapf("no ema for pair %s", pair.String())
+	}
+	return ema.ExchangeRate, nil
+}
+
+// PruneExpiredSnapshots deletes at most limit snapshots, across all pairs,
+// that are older than the snapshot retention window and returns the number
+// of deleted snapshots. This also reaches pairs that no longer receive prices,
+// e.g. pairs removed from the whitelist.
+func (k Keeper) PruneExpiredSnapshots(ctx sdk.Context, limit uint64) (pruned uint64) {
+	params, err := k.Params.Get(ctx)
+	if err != nil || params.SnapshotRetentionWindow == 0 {
+		return 0
+	}
+
+	return common.TimeSeriesPruner[asset.Pair, types.PriceSnapshot]{
+		Entries:         k.PriceSnapshots,
+		NextPruneTimeMs: k.NextSnapshotPruneTimeMs,
+		Retention:       func(asset.Pair) time.Duration { return params.SnapshotRetentionWindow },
+		MinRetention:    params.SnapshotRetentionWindow,
+	}.Prune(ctx, limit)
+}
@@ -2,13 +2,19 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
+	"github.com/NibiruChain/collections"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
 func TestValidateFeeder(t *testing.T) {
@@ -53,3 +59,134 @@ func TestValidateFeeder(t *testing.T) {
 	input.StakingKeeper.SetValidator(input.Ctx, validator)
 	require.Error(t, input.OracleKeeper.ValidateFeeder(input.Ctx, sdk.AccAddress(addr1), addr))
 }
+
+func TestPruneExpiredSnapshots(t *testing.T) {
+	input := CreateTestFixture(t)
+	pairs := []asset.Pair{
+		asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+		asset.Registry.Pair(denoms.ETH, denoms.NUSD),
+		asset.Registry.Pair(denoms.ATOM, denoms.NUSD),
+	}
+
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.TwapLookbackWindow = time.Minute
+	params.SnapshotRetentionWindow = time.Hour
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	// three expired snapshots and one live snapshot per pair, none of which
+	// are pruned by SetPrice
+	start := input.Ctx.BlockTime()
+	for _, pair := range pairs {
+		for i := 0; i < 4; i++ {
+			blockTime := start.Add(time.Duration(i) * time.Minute)
+			if i == 3 {
+				blockTime = start.Add(2 * time.Hour)
+			}
+			input.OracleKeeper.PriceSnapshots.Insert(input.Ctx, collections.Join(pair, blockTime),
+				types.PriceSnapshot{Pair: pair, Price: sdk.OneDec(), TimestampMs: blockTime.UnixMilli()})
+		}
+	}
+	countSnapshots := func(pair asset.Pair) int {
+		return len(input.OracleKeeper.PriceSnapshots.Iterate(
+			input.Ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair)).Keys())
+	}
+
+	ctx := input.Ctx.WithBlockTime(start.Add(2 * time.Hour))
+	require.Zero(t, input.OracleKeeper.PruneExpiredSnapshots(ctx, 0))
+
+	// the limit is applied across pairs
+	require.EqualValues(t, 5, input.OracleKeeper.PruneExpiredSnapshots(ctx, 5))
+	var remaining []int
+	for _, pair := range pairs {
+		remaining = append(remaining, countSnapshots(pair))
+	}
+	require.ElementsMatch(t, []int{1, 2, 4}, remaining)
+
+	// the remaining expired snapshots are pruned by the next call
+	require.EqualValues(t, 4, input.OracleKeeper.PruneExpiredSnapshots(ctx, 100))
+	for _, pair := range pairs {
+		require.Equal(t, 1, countSnapshots(pair))
+	}
+	require.Zero(t, input.OracleKeeper.PruneExpiredSnapshots(ctx, 100))
+
+	// the next pass waits until the oldest live snapshot expires
+	nextPruneTimeMs, err := input.OracleKeeper.NextSnapshotPruneTimeMs.Get(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, start.Add(3*time.Hour).UnixMilli(), nextPruneTimeMs)
+	expired := collections.Join(pairs[0], start)
+	input.OracleKeeper.PriceSnapshots.Insert(ctx, expired,
+		types.PriceSnapshot{Pair: pairs[0], Price: sdk.OneDec(), TimestampMs: start.UnixMilli()})
+	require.Zero(t, input.OracleKeeper.PruneExpiredSnapshots(ctx.WithBlockTime(start.Add(3*time.Hour-time.Second)), 100))
+	require.EqualValues(t, 1, input.OracleKeeper.PruneExpiredSnapshots(ctx.WithBlockTime(start.Add(3*time.Hour)), 100))
+
+	// updating the params triggers a pass
+	params.SnapshotRetentionWindow = 30 * time.Minute
+	input.OracleKeeper.UpdateParams(input.Ctx, params)
+	input.OracleKeeper.PriceSnapshots.Insert(ctx, expired,
+		types.PriceSnapshot{Pair: pairs[0], Price: sdk.OneDec(), TimestampMs: start.UnixMilli()})
+	require.EqualValues(t, 1, input.OracleKeeper.PruneExpiredSnapshots(ctx, 100))
+
+	// zero retention disables pruning
+	params.SnapshotRetentionWindow = 0
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+	require.Zero(t, input.OracleKeeper.PruneExpiredSnapshots(ctx.WithBlockTime(start.Add(48*time.Hour)), 100))
+}
+
+func TestGetExchangeRateInverse(t *testing.T) {
+	input := CreateTestFixture(t)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	_, err := input.OracleKeeper.GetExchangeRate(input.Ctx, pair.Inverse())
+	require.Error(t, err)
+
+	input.OracleKeeper.SetPrice(input.Ctx, pair, sdk.NewDec(20_000))
+
+	price, err := input.OracleKeeper.GetExchangeRate(input.Ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(20_000), price)
+
+	price, err = input.OracleKeeper.GetExchangeRate(input.Ctx, pair.Inverse())
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.00005"), price)
+
+	// a rate posted for the pair itself takes precedence over its inverse
+	input.OracleKeeper.SetPrice(input.Ctx, pair.Inverse(), sdk.MustNewDecFromStr("0.0001"))
+	price, err = input.OracleKeeper.GetExchangeRate(input.Ctx, pair.Inverse())
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.0001"), price)
+
+	_, err = input.OracleKeeper.GetExchangeRate(input.Ctx, "invalid")
+	require.Error(t, err)
+}
+
+func TestSetPriceUpdatesEma(t *testing.T) {
+	input := CreateTestFixture(t)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	_, err := input.OracleKeeper.GetExchangeRateEma(input.Ctx, pair)
+	require.ErrorIs(t, err, types.ErrNoValidEma)
+
+	// the first price seeds the ema
+	input.OracleKeeper.SetPrice(input.Ctx, pair, sdk.NewDec(100))
+	ema, err := input.OracleKeeper.GetExchangeRateEma(input.Ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(100), ema)
+
+	// default smoothing: 100 + 0.1 * (200 - 100)
+	input.OracleKeeper.SetPrice(input.Ctx, pair, sdk.NewDec(200))
+	ema, err = input.OracleKeeper.GetExchangeRateEma(input.Ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(110), ema)
+
+	// pair smoothing: 110 + 0.5 * (10 - 110)
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.EmaSmoothings = []types.PairEmaSmoothing{{Pair: pair, Smoothing: sdk.NewDecWithPrec(5, 1)}}
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	input.OracleKeeper.SetPrice(input.Ctx, pair, sdk.NewDec(10))
+	ema, err = input.OracleKeeper.GetExchangeRateEma(input.Ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(60), ema)
+}
@@ -7,6 +7,7 @@ import (
 
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/NibiruChain/nibiru/x/oracle/types"
@@ -185,3 +186,25 @@ func (ms msgServer) EditOracleParams(
 	}
 	return resp, err
 }
+
+// UpdateParams: gRPC tx msg for replacing the oracle module params.
+// Only callable by the module authority, usually x/gov.
+func (ms msgServer) UpdateParams(
+	goCtx context.Context, msg *types.MsgUpdateParams,
+) (resp *types.MsgUpdateParamsResponse, err error) {
+	if ms.authority != msg.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.Params.Validate(); err != nil {
+		return resp, err
+	}
+	if err := types.ValidateWhitelist(msg.Params.Whitelist); err != nil {
+		return resp, err
+	}
+	ms.Keeper.UpdateParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
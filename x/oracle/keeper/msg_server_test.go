@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
@@ -190,3 +192,39 @@ func TestAggregatePrevoteVote(t *testing.T) {
 	_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(input.Ctx), aggregateExchangeRateVoteMsg)
 	require.NoError(t, err)
 }
+
+func TestUpdateParams(t *testing.T) {
+	input, msgServer := Setup(t)
+	goCtx := sdk.WrapSDKContext(input.Ctx)
+	govModuleAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	newParams := types.DefaultParams()
+	newParams.VotePeriod = 60
+	newParams.Whitelist = []asset.Pair{asset.Registry.Pair(denoms.ETH, denoms.USD)}
+
+	// Case 1: only the authority may update the params
+	_, err := msgServer.UpdateParams(goCtx, &types.MsgUpdateParams{
+		Authority: Addrs[0].String(),
+		Params:    newParams,
+	})
+	require.Error(t, err)
+
+	// Case 2: invalid params are rejected
+	invalidParams := newParams
+	invalidParams.Whitelist = []asset.Pair{}
+	_, err = msgServer.UpdateParams(goCtx, &types.MsgUpdateParams{
+		Authority: govModuleAddr,
+		Params:    invalidParams,
+	})
+	require.Error(t, err)
+
+	// Case 3: the authority replaces the params
+	_, err = msgServer.UpdateParams(goCtx, &types.MsgUpdateParams{
+		Authority: govModuleAddr,
+		Params:    newParams,
+	})
+	require.NoError(t, err)
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, newParams, params)
+}
@@ -10,6 +10,8 @@ import (
 // UpdateParams updates the oracle parameters
 func (k Keeper) UpdateParams(ctx sdk.Context, params types.Params) {
 	k.Params.Set(ctx, params)
+	// the snapshot retention window may have changed
+	k.NextSnapshotPruneTimeMs.Set(ctx, 0)
 }
 
 // VotePeriod returns the number of blocks during which voting takes place.
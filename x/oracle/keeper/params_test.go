@@ -45,6 +45,8 @@ func TestParams(t *testing.T) {
 		SlashWindow:       slashWindow,
 		MinValidPerWindow: minValidPerWindow,
 		ValidatorFeeRatio: minFeeRatio,
+
+		MaxPriceChangeRatio: sdk.ZeroDec(),
 	}
 	input.OracleKeeper.Params.Set(input.Ctx, newParams)
 
@@ -1,14 +1,20 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/NibiruChain/collections"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
@@ -79,19 +85,57 @@ func (q querier) ExchangeRateTwap(c context.Context, req *types.QueryExchangeRat
 	return &types.QueryExchangeRateResponse{ExchangeRate: twap}, nil
 }
 
+// ExchangeRateEma queries the exponential moving average exchange rate of a pair
+func (q querier) ExchangeRateEma(c context.Context, req *types.QueryExchangeRateRequest) (response *types.QueryExchangeRateResponse, err error) {
+	if _, err = q.ExchangeRate(c, req); err != nil {
+		return
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ema, err := q.Keeper.GetExchangeRateEma(ctx, req.Pair)
+	if err != nil {
+		return &types.QueryExchangeRateResponse{}, err
+	}
+	return &types.QueryExchangeRateResponse{ExchangeRate: ema}, nil
+}
+
 // ExchangeRates queries exchange rates of all pairs
-func (q querier) ExchangeRates(c context.Context, _ *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
+func (q querier) ExchangeRates(
+	c context.Context, req *types.QueryExchangeRatesRequest,
+) (*types.QueryExchangeRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), NamespaceExchangeRates.Prefix())
+
+	pagination, err := common.ParsePaginationOrAll(req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	var exchangeRates types.ExchangeRateTuples
-	for _, er := range q.Keeper.ExchangeRates.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
+	pageRes, err := sdkquery.Paginate(store, pagination, func(key, value []byte) error {
+		_, pair := asset.PairKeyEncoder.Decode(key)
+		datedPrice := new(types.DatedPrice)
+		if err := q.cdc.Unmarshal(value, datedPrice); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
 		exchangeRates = append(exchangeRates, types.ExchangeRateTuple{
-			Pair:         er.Key,
-			ExchangeRate: er.Value.ExchangeRate,
+			Pair:         pair,
+			ExchangeRate: datedPrice.ExchangeRate,
 		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return &types.QueryExchangeRatesResponse{ExchangeRates: exchangeRates}, nil
+	return &types.QueryExchangeRatesResponse{
+		ExchangeRates: exchangeRates,
+		Pagination:    pageRes,
+	}, nil
 }
 
 // Actives queries all pairs for which exchange rates exist
@@ -162,8 +206,36 @@ func (q querier) AggregatePrevote(c context.Context, req *types.QueryAggregatePr
 }
 
 // AggregatePrevotes queries aggregate prevotes of all validators
-func (q querier) AggregatePrevotes(c context.Context, _ *types.QueryAggregatePrevotesRequest) (*types.QueryAggregatePrevotesResponse, error) {
-	return &types.QueryAggregatePrevotesResponse{AggregatePrevotes: q.Prevotes.Iterate(sdk.UnwrapSDKContext(c), collections.Range[sdk.ValAddress]{}).Values()}, nil
+func (q querier) AggregatePrevotes(c context.Context, req *types.QueryAggregatePrevotesRequest) (*types.QueryAggregatePrevotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), NamespacePrevotes.Prefix())
+
+	pagination, err := common.ParsePaginationOrAll(req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var prevotes []types.AggregateExchangeRatePrevote
+	pageRes, err := sdkquery.Paginate(store, pagination, func(_, value []byte) error {
+		prevote := new(types.AggregateExchangeRatePrevote)
+		if err := q.cdc.Unmarshal(value, prevote); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		prevotes = append(prevotes, *prevote)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAggregatePrevotesResponse{
+		AggregatePrevotes: prevotes,
+		Pagination:        pageRes,
+	}, nil
 }
 
 // AggregateVote queries an aggregate vote of a validator
@@ -189,6 +261,217 @@ func (q querier) AggregateVote(c context.Context, req *types.QueryAggregateVoteR
 }
 
 // AggregateVotes queries aggregate votes of all validators
-func (q querier) AggregateVotes(c context.Context, _ *types.QueryAggregateVotesRequest) (*types.QueryAggregateVotesResponse, error) {
-	return &types.QueryAggregateVotesResponse{AggregateVotes: q.Keeper.Votes.Iterate(sdk.UnwrapSDKContext(c), collections.Range[sdk.ValAddress]{}).Values()}, nil
+func (q querier) AggregateVotes(c context.Context, req *types.QueryAggregateVotesRequest) (*types.QueryAggregateVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), NamespaceVotes.Prefix())
+
+	pagination, err := common.ParsePaginationOrAll(req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var votes []types.AggregateExchangeRateVote
+	pageRes, err := sdkquery.Paginate(store, pagination, func(_, value []byte) error {
+		vote := new(types.AggregateExchangeRateVote)
+		if err := q.cdc.Unmarshal(value, vote); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		votes = append(votes, *vote)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAggregateVotesResponse{
+		AggregateVotes: votes,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// PriceAtTime queries the latest price snapshot of a pair taken at or before
+// the given time
+func (q querier) PriceAtTime(c context.Context, req *types.QueryPriceAtTimeRequest) (*types.QueryPriceAtTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.Pair.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	iter := q.PriceSnapshots.Iterate(ctx,
+		collections.PairRange[asset.Pair, time.Time]{}.
+			Prefix(req.Pair).
+			EndInclusive(req.Time).
+			Descending(),
+	)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, status.Errorf(codes.NotFound, "no price snapshot for pair %s at or before %s", req.Pair, req.Time)
+	}
+	return &types.QueryPriceAtTimeResponse{Snapshot: iter.Value()}, nil
+}
+
+// PriceHistory queries the price snapshots of a pair within an optional time
+// range, oldest first
+func (q querier) PriceHistory(c context.Context, req *types.QueryPriceHistoryRequest) (*types.QueryPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.Pair.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	// the snapshots of the pair, keyed by time
+	store := prefix.NewStore(ctx.KVStore(q.storeKey),
+		append(NamespacePriceSnapshots.Prefix(), asset.PairKeyEncoder.Encode(req.Pair)...))
+
+	pagination, _, err := common.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// only iterate over the snapshots within [StartTime, EndTime]
+	bounded := boundedStore{KVStore: store}
+	if req.StartTime != nil {
+		// snapshots are keyed with millisecond precision
+		bounded.start = collections.TimeKeyEncoder.Encode(time.UnixMilli(req.StartTime.UnixMilli()))
+	}
+	if req.EndTime != nil {
+		// the smallest key after EndTime, so that EndTime is inclusive
+		bounded.end = append(collections.TimeKeyEncoder.Encode(*req.EndTime), 0)
+	}
+
+	var snapshots []types.PriceSnapshot
+	pageRes, err := sdkquery.Paginate(bounded, pagination, func(_, value []byte) error {
+		snapshot := new(types.PriceSnapshot)
+		if err := q.cdc.Unmarshal(value, snapshot); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		snapshots = append(snapshots, *snapshot)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPriceHistoryResponse{
+		Snapshots:  snapshots,
+		Pagination: pageRes,
+	}, nil
+}
+
+// OracleStatus queries the feed health of one or all validators
+func (q querier) OracleStatus(c context.Context, req *types.QueryOracleStatusRequest) (*types.QueryOracleStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	// the statistics of all validators, or of the requested one
+	var valPrefix []byte
+	if req.ValidatorAddr != "" {
+		valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		valPrefix = collections.ValAddressKeyEncoder.Encode(valAddr)
+	}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), append(NamespaceVoterStats.Prefix(), valPrefix...))
+
+	pagination, err := common.ParsePaginationOrAll(req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var statuses []types.ValidatorOracleStatus
+	pageRes, err := sdkquery.Paginate(store, pagination, func(key, value []byte) error {
+		_, statsKey := collections.PairKeyEncoder(collections.ValAddressKeyEncoder, asset.PairKeyEncoder).Decode(append(append([]byte{}, valPrefix...), key...))
+		stats := new(types.VoterPairStats)
+		if err := q.cdc.Unmarshal(value, stats); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		valAddr := statsKey.K1()
+		if len(statuses) == 0 || statuses[len(statuses)-1].ValidatorAddr != valAddr.String() {
+			statuses = append(statuses, types.ValidatorOracleStatus{
+				ValidatorAddr: valAddr.String(),
+				MissCounter:   q.MissCounters.GetOr(ctx, valAddr, 0),
+			})
+		}
+		last := &statuses[len(statuses)-1]
+		last.Pairs = append(last.Pairs, types.PairOracleStatus{
+			Pair:  statsKey.K2(),
+			Stats: *stats,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOracleStatusResponse{
+		Statuses:   statuses,
+		Pagination: pageRes,
+	}, nil
+}
+
+// UsdPrice queries the USD price of the base asset of a pair
+func (q querier) UsdPrice(c context.Context, req *types.QueryUsdPriceRequest) (*types.QueryUsdPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.Pair.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	price, err := q.GetUsdPrice(ctx, req.Pair)
+	if err != nil {
+		return nil, err
+	}
+	baseUnitPrice, err := q.GetUsdBaseUnitPrice(ctx, req.Pair)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUsdPriceResponse{Price: price, BaseUnitPrice: baseUnitPrice}, nil
+}
+
+// boundedStore restricts the iterators of a KVStore to the keys in [start, end).
+// A nil bound leaves that side of the iteration open.
+type boundedStore struct {
+	storetypes.KVStore
+	start, end []byte
+}
+
+func (s boundedStore) Iterator(start, end []byte) storetypes.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s boundedStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+func (s boundedStore) clamp(start, end []byte) ([]byte, []byte) {
+	if s.start != nil && (start == nil || bytes.Compare(start, s.start) < 0) {
+		start = s.start
+	}
+	if s.end != nil && (end == nil || bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		// empty range
+		start = end
+	}
+	return start, end
 }
@@ -1,16 +1,20 @@
 package keeper
 
 import (
+	"fmt"
 	"sort"
 	"testing"
 	"time"
 
 	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 
 	testutilevents "github.com/NibiruChain/nibiru/x/common/testutil"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
@@ -88,6 +92,77 @@ func TestQueryExchangeRates(t *testing.T) {
 	}, res.ExchangeRates)
 }
 
+func TestQueryExchangeRatesPagination(t *testing.T) {
+	input := CreateTestFixture(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	pairs := []asset.Pair{
+		asset.Registry.Pair(denoms.ATOM, denoms.NUSD),
+		asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+		asset.Registry.Pair(denoms.ETH, denoms.NUSD),
+	}
+	for _, pair := range pairs {
+		input.OracleKeeper.ExchangeRates.Insert(input.Ctx, pair, types.DatedPrice{ExchangeRate: sdk.OneDec()})
+	}
+
+	res, err := querier.ExchangeRates(ctx, &types.QueryExchangeRatesRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.ExchangeRates, 2)
+	require.Equal(t, pairs[:2], []asset.Pair{res.ExchangeRates[0].Pair, res.ExchangeRates[1].Pair})
+	require.EqualValues(t, 3, res.Pagination.Total)
+
+	res, err = querier.ExchangeRates(ctx, &types.QueryExchangeRatesRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.ExchangeRates, 1)
+	require.Equal(t, pairs[2], res.ExchangeRates[0].Pair)
+	require.Nil(t, res.Pagination.NextKey)
+
+	res, err = querier.ExchangeRates(ctx, &types.QueryExchangeRatesRequest{
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.ExchangeRates, 1)
+	require.Equal(t, pairs[2], res.ExchangeRates[0].Pair)
+
+	_, err = querier.ExchangeRates(ctx, &types.QueryExchangeRatesRequest{
+		Pagination: &query.PageRequest{Key: []byte("key"), Offset: 1},
+	})
+	require.Error(t, err)
+}
+
+func TestQueryExchangeRatesWithoutPagination(t *testing.T) {
+	input := CreateTestFixture(t)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// more rates than the default page limit, all returned without a page request
+	numRates := int(common.DefaultPageItemsLimit) + 10
+	for i := 0; i < numRates; i++ {
+		pair := asset.NewPair(fmt.Sprintf("token%d", i), denoms.NUSD)
+		input.OracleKeeper.ExchangeRates.Insert(input.Ctx, pair, types.DatedPrice{ExchangeRate: sdk.OneDec()})
+	}
+
+	res, err := querier.ExchangeRates(sdk.WrapSDKContext(input.Ctx), &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.ExchangeRates, numRates)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// but no more than the unpaginated limit
+	for i := numRates; i <= int(common.UnpaginatedItemsLimit); i++ {
+		pair := asset.NewPair(fmt.Sprintf("token%d", i), denoms.NUSD)
+		input.OracleKeeper.ExchangeRates.Insert(input.Ctx, pair, types.DatedPrice{ExchangeRate: sdk.OneDec()})
+	}
+
+	res, err = querier.ExchangeRates(sdk.WrapSDKContext(input.Ctx), &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.ExchangeRates, int(common.UnpaginatedItemsLimit))
+	require.NotNil(t, res.Pagination.NextKey)
+}
+
 func TestQueryExchangeRateTwap(t *testing.T) {
 	input := CreateTestFixture(t)
 	querier := NewQuerier(input.OracleKeeper)
@@ -290,6 +365,19 @@ func TestQueryAggregatePrevotes(t *testing.T) {
 	res, err := querier.AggregatePrevotes(ctx, &types.QueryAggregatePrevotesRequest{})
 	require.NoError(t, err)
 	require.Equal(t, expectedPrevotes, res.AggregatePrevotes)
+
+	res, err = querier.AggregatePrevotes(ctx, &types.QueryAggregatePrevotesRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedPrevotes[:2], res.AggregatePrevotes)
+	require.EqualValues(t, 3, res.Pagination.Total)
+
+	res, err = querier.AggregatePrevotes(ctx, &types.QueryAggregatePrevotesRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedPrevotes[2:], res.AggregatePrevotes)
 }
 
 func TestQueryAggregateVote(t *testing.T) {
@@ -341,6 +429,19 @@ func TestQueryAggregateVotes(t *testing.T) {
 	res, err := querier.AggregateVotes(ctx, &types.QueryAggregateVotesRequest{})
 	require.NoError(t, err)
 	require.Equal(t, expectedVotes, res.AggregateVotes)
+
+	res, err = querier.AggregateVotes(ctx, &types.QueryAggregateVotesRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedVotes[:2], res.AggregateVotes)
+	require.EqualValues(t, 3, res.Pagination.Total)
+
+	res, err = querier.AggregateVotes(ctx, &types.QueryAggregateVotesRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedVotes[2:], res.AggregateVotes)
 }
 
 func TestQueryVoteTargets(t *testing.T) {
@@ -362,3 +463,240 @@ func TestQueryVoteTargets(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, voteTargets, res.VoteTargets)
 }
+
+func TestQueryPriceAtTime(t *testing.T) {
+	input := CreateTestFixture(t)
+	querier := NewQuerier(input.OracleKeeper)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	t0 := input.Ctx.BlockTime()
+	input.OracleKeeper.SetPrice(input.Ctx, pair, sdk.NewDec(1700))
+	input.OracleKeeper.SetPrice(input.Ctx.WithBlockTime(t0.Add(time.Minute)), pair, sdk.NewDec(1800))
+
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	_, err := querier.PriceAtTime(ctx, &types.QueryPriceAtTimeRequest{Pair: pair, Time: t0.Add(-time.Second)})
+	require.Error(t, err)
+
+	res, err := querier.PriceAtTime(ctx, &types.QueryPriceAtTimeRequest{Pair: pair, Time: t0.Add(30 * time.Second)})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1700), res.Snapshot.Price)
+
+	res, err = querier.PriceAtTime(ctx, &types.QueryPriceAtTimeRequest{Pair: pair, Time: t0.Add(time.Minute)})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1800), res.Snapshot.Price)
+}
+
+func TestQueryPriceHistory(t *testing.T) {
+	input := CreateTestFixture(t)
+	querier := NewQuerier(input.OracleKeeper)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	t0 := input.Ctx.BlockTime()
+	for i := int64(0); i < 5; i++ {
+		input.OracleKeeper.SetPrice(input.Ctx.WithBlockTime(t0.Add(time.Duration(i)*time.Minute)), pair, sdk.NewDec(1700+i))
+	}
+	input.OracleKeeper.SetPrice(input.Ctx, asset.Registry.Pair(denoms.ETH, denoms.NUSD), sdk.NewDec(100))
+
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	res, err := querier.PriceHistory(ctx, &types.QueryPriceHistoryRequest{Pair: pair})
+	require.NoError(t, err)
+	require.Len(t, res.Snapshots, 5)
+	require.Equal(t, sdk.NewDec(1700), res.Snapshots[0].Price)
+
+	start, end := t0.Add(time.Minute), t0.Add(3*time.Minute)
+	res, err = querier.PriceHistory(ctx, &types.QueryPriceHistoryRequest{
+		Pair:       pair,
+		StartTime:  &start,
+		EndTime:    &end,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Snapshots, 2)
+	require.Equal(t, sdk.NewDec(1701), res.Snapshots[0].Price)
+	require.Equal(t, sdk.NewDec(1702), res.Snapshots[1].Price)
+	require.EqualValues(t, 3, res.Pagination.Total)
+
+	res, err = querier.PriceHistory(ctx, &types.QueryPriceHistoryRequest{
+		Pair:       pair,
+		StartTime:  &start,
+		EndTime:    &end,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Snapshots, 1)
+	require.Equal(t, sdk.NewDec(1703), res.Snapshots[0].Price)
+	require.Nil(t, res.Pagination.NextKey)
+
+	res, err = querier.PriceHistory(ctx, &types.QueryPriceHistoryRequest{
+		Pair:       pair,
+		StartTime:  &start,
+		EndTime:    &end,
+		Pagination: &query.PageRequest{Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Snapshots, 3)
+	require.Equal(t, sdk.NewDec(1703), res.Snapshots[0].Price)
+	require.Equal(t, sdk.NewDec(1701), res.Snapshots[2].Price)
+
+	empty := t0.Add(10 * time.Minute)
+	res, err = querier.PriceHistory(ctx, &types.QueryPriceHistoryRequest{Pair: pair, StartTime: &empty})
+	require.NoError(t, err)
+	require.Empty(t, res.Snapshots)
+}
+
+func TestQueryExchangeRateEma(t *testing.T) {
+	input := CreateTestFixture(t)
+	querier := NewQuerier(input.OracleKeeper)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	input.OracleKeeper.SetPrice(input.Ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD), sdk.NewDec(1700))
+
+	_, err := querier.ExchangeRateEma(ctx, &types.QueryExchangeRateRequest{Pair: asset.Registry.Pair(denoms.ETH, denoms.NUSD)})
+	require.Error(t, err)
+
+	res, err := querier.ExchangeRateEma(ctx, &types.QueryExchangeRateRequest{Pair: asset.Registry.Pair(denoms.BTC, denoms.NUSD)})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1700), res.ExchangeRate)
+}
+
+func TestQueryOracleStatus(t *testing.T) {
+	input, _ := Setup(t)
+	querier := NewQuerier(input.OracleKeeper)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	btc := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	eth := asset.Registry.Pair(denoms.ETH, denoms.USD)
+
+	input.OracleKeeper.MissCounters.Insert(input.Ctx, ValAddrs[0], 3)
+	input.OracleKeeper.updateVoterStats(input.Ctx, btc, types.ExchangeRateVotes{
+		types.NewExchangeRateVote(sdk.NewDec(110), btc, ValAddrs[0], 1),
+		types.NewExchangeRateVote(sdk.NewDec(100), btc, ValAddrs[1], 1),
+		types.NewExchangeRateVote(sdk.ZeroDec(), btc, ValAddrs[2], 1),
+	}, sdk.NewDec(100))
+	input.OracleKeeper.updateVoterStats(input.Ctx, btc, types.ExchangeRateVotes{
+		types.NewExchangeRateVote(sdk.NewDec(130), btc, ValAddrs[0], 1),
+	}, sdk.NewDec(100))
+	input.OracleKeeper.updateVoterStats(input.Ctx, eth, types.ExchangeRateVotes{
+		types.NewExchangeRateVote(sdk.NewDec(10), eth, ValAddrs[0], 1),
+	}, sdk.NewDec(10))
+
+	// empty request
+	_, err := querier.OracleStatus(ctx, nil)
+	require.Error(t, err)
+
+	// all validators, abstain votes are not recorded
+	res, err := querier.OracleStatus(ctx, &types.QueryOracleStatusRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Statuses, 2)
+
+	// single validator
+	res, err = querier.OracleStatus(ctx, &types.QueryOracleStatusRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Len(t, res.Statuses, 1)
+	status := res.Statuses[0]
+	require.Equal(t, ValAddrs[0].String(), status.ValidatorAddr)
+	require.EqualValues(t, 3, status.MissCounter)
+	require.Len(t, status.Pairs, 2)
+
+	btcStats := status.Pairs[0].Stats
+	require.Equal(t, btc, status.Pairs[0].Pair)
+	require.EqualValues(t, input.Ctx.BlockHeight(), btcStats.LastVoteBlock)
+	require.Equal(t, sdk.NewDec(130), btcStats.LastExchangeRate)
+	require.Equal(t, sdk.NewDecWithPrec(3, 1), btcStats.LastDeviation)
+	require.EqualValues(t, 2, btcStats.VoteCount)
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), btcStats.MeanDeviation)
+	require.Equal(t, sdk.NewDecWithPrec(3, 1), btcStats.MaxDeviation)
+
+	// paginated over the (validator, pair) statistics
+	res, err = querier.OracleStatus(ctx, &types.QueryOracleStatusRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Statuses, 1)
+	require.Len(t, res.Statuses[0].Pairs, 1)
+	require.EqualValues(t, 3, res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	// the slash window statistics are reset with the miss counters, and the
+	// statistics of unbonded validators and delisted pairs are deleted
+	unbonded := sdk.ValAddress(testutilevents.AccAddress())
+	delisted := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	input.OracleKeeper.updateVoterStats(input.Ctx, btc, types.ExchangeRateVotes{
+		types.NewExchangeRateVote(sdk.NewDec(100), btc, unbonded, 1),
+	}, sdk.NewDec(100))
+	input.OracleKeeper.updateVoterStats(input.Ctx, delisted, types.ExchangeRateVotes{
+		types.NewExchangeRateVote(sdk.NewDec(100), delisted, ValAddrs[0], 1),
+	}, sdk.NewDec(100))
+	input.OracleKeeper.resetVoterStatsWindow(input.Ctx)
+	res, err = querier.OracleStatus(ctx, &types.QueryOracleStatusRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Len(t, res.Statuses[0].Pairs, 2)
+	btcStats = res.Statuses[0].Pairs[0].Stats
+	require.Equal(t, sdk.NewDec(130), btcStats.LastExchangeRate)
+	require.Zero(t, btcStats.VoteCount)
+	require.True(t, btcStats.MaxDeviation.IsZero())
+	res, err = querier.OracleStatus(ctx, &types.QueryOracleStatusRequest{ValidatorAddr: unbonded.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Statuses)
+
+	// invalid validator
+	_, err = querier.OracleStatus(ctx, &types.QueryOracleStatusRequest{ValidatorAddr: "invalid"})
+	require.Error(t, err)
+}
+
+func TestQueryUsdPrice(t *testing.T) {
+	input := CreateTestFixture(t)
+	querier := NewQuerier(input.OracleKeeper)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	btcUsd := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	btcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	ethUsdc := asset.Registry.Pair(denoms.ETH, denoms.USDC)
+
+	input.OracleKeeper.SetPrice(input.Ctx, btcUsd, sdk.NewDec(20_000))
+	input.OracleKeeper.SetPrice(input.Ctx, btcUsdc, sdk.NewDec(20_000))
+	input.OracleKeeper.SetPrice(input.Ctx, ethUsdc, sdk.NewDec(1_000))
+	// only the inverse of the quote chain is posted
+	input.OracleKeeper.SetPrice(input.Ctx, asset.NewPair(denoms.USD, denoms.USDC), sdk.NewDecWithPrec(5, 1))
+
+	// empty request
+	_, err := querier.UsdPrice(ctx, nil)
+	require.Error(t, err)
+
+	// quoted in USD
+	res, err := querier.UsdPrice(ctx, &types.QueryUsdPriceRequest{Pair: btcUsd})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(20_000), res.Price)
+	require.Equal(t, sdk.NewDec(20_000), res.BaseUnitPrice)
+
+	// converted through the quote asset
+	res, err = querier.UsdPrice(ctx, &types.QueryUsdPriceRequest{Pair: ethUsdc})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2_000), res.Price)
+
+	// base unit price uses the display exponents of the denom metadata
+	input.BankKeeper.SetDenomMetaData(input.Ctx, banktypes.Metadata{
+		Base:    denoms.BTC,
+		Display: "btc",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denoms.BTC, Exponent: 0},
+			{Denom: "btc", Exponent: 8},
+		},
+	})
+	input.BankKeeper.SetDenomMetaData(input.Ctx, banktypes.Metadata{
+		Base:    denoms.USD,
+		Display: "usd",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denoms.USD, Exponent: 0},
+			{Denom: "usd", Exponent: 6},
+		},
+	})
+	res, err = querier.UsdPrice(ctx, &types.QueryUsdPriceRequest{Pair: btcUsd})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(20_000), res.Price)
+	require.Equal(t, sdk.NewDec(200), res.BaseUnitPrice)
+
+	// missing quote price
+	_, err = querier.UsdPrice(ctx, &types.QueryUsdPriceRequest{Pair: asset.Registry.Pair(denoms.ETH, denoms.ATOM)})
+	require.Error(t, err)
+}
@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
 )
 
 // SlashAndResetMissCounters do slash any operator who over criteria & clear all operators miss counter to zero
@@ -53,4 +55,27 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 			k.Logger(ctx).Error("fail to delete miss counter", "operator", operator.String(), "error", err)
 		}
 	}
+
+	k.resetVoterStatsWindow(ctx)
+}
+
+// resetVoterStatsWindow clears the slash window statistics of all voters while
+// keeping their last vote. The statistics of validators that are no longer
+// bonded and of pairs that are no longer whitelisted are deleted.
+func (k Keeper) resetVoterStatsWindow(ctx sdk.Context) {
+	for _, kv := range k.VoterStats.Iterate(ctx, collections.PairRange[sdk.ValAddress, asset.Pair]{}).KeyValues() {
+		validator := k.StakingKeeper.Validator(ctx, kv.Key.K1())
+		if validator == nil || !validator.IsBonded() || !k.WhitelistedPairs.Has(ctx, kv.Key.K2()) {
+			if err := k.VoterStats.Delete(ctx, kv.Key); err != nil {
+				k.Logger(ctx).Error("fail to delete voter stats", "operator", kv.Key.K1().String(), "error", err)
+			}
+			continue
+		}
+
+		stats := kv.Value
+		stats.VoteCount = 0
+		stats.MeanDeviation = sdk.ZeroDec()
+		stats.MaxDeviation = sdk.ZeroDec()
+		k.VoterStats.Insert(ctx, kv.Key, stats)
+	}
 }
@@ -40,8 +40,11 @@ func (k sudoExtension) EditOracleParams(
 	}
 
 	paramsAfter = MergeOracleParams(newParams, params)
+	if err := paramsAfter.Validate(); err != nil {
+		return paramsAfter, err
+	}
 	k.UpdateParams(ctx, paramsAfter)
-	return paramsAfter, paramsAfter.Validate()
+	return paramsAfter, nil
 }
 
 // MergeOracleParams: Takes the given oracle params and merges them into the
@@ -96,5 +99,33 @@ func MergeOracleParams(
 		oracleParams.ValidatorFeeRatio = *partial.ValidatorFeeRatio
 	}
 
+	if len(partial.QuorumFallbacks) > 0 {
+		oracleParams.QuorumFallbacks = partial.QuorumFallbacks
+	}
+
+	if partial.SnapshotRetentionWindow != nil {
+		oracleParams.SnapshotRetentionWindow = time.Duration(partial.SnapshotRetentionWindow.Int64())
+	}
+
+	if len(partial.EmaSmoothings) > 0 {
+		oracleParams.EmaSmoothings = partial.EmaSmoothings
+	}
+
+	if partial.MaxPriceChangeRatio != nil {
+		oracleParams.MaxPriceChangeRatio = *partial.MaxPriceChangeRatio
+	}
+
+	if partial.SuspectBlocks != nil {
+		oracleParams.SuspectBlocks = partial.SuspectBlocks.Uint64()
+	}
+
+	if len(partial.VoterWeights) > 0 {
+		oracleParams.VoterWeights = partial.VoterWeights
+	}
+
+	if partial.MaxSnapshotPrunesPerBlock != nil {
+		oracleParams.MaxSnapshotPrunesPerBlock = partial.MaxSnapshotPrunesPerBlock.Uint64()
+	}
+
 	return oracleParams
 }
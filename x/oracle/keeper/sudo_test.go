@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	oraclekeeper "github.com/NibiruChain/nibiru/x/oracle/keeper"
@@ -38,17 +39,39 @@ func (s *SuiteOracleSudo) TestEditOracleParams() {
 	twapLookbackWindow := sdk.NewInt(int64(time.Second * 30))
 	minVoters := sdk.NewInt(2)
 	validatorFeeRatio := sdk.MustNewDecFromStr("0.7")
+	snapshotRetentionWindow := sdk.NewInt(int64(time.Hour))
+	quorumFallbacks := []oracletypes.PairQuorumFallback{
+		{Pair: asset.MustNewPair("sol:usdc"), Fallback: oracletypes.QuorumFallback_TWAP},
+	}
+	emaSmoothings := []oracletypes.PairEmaSmoothing{
+		{Pair: asset.MustNewPair("sol:usdc"), Smoothing: sdk.MustNewDecFromStr("0.5")},
+	}
+	maxPriceChangeRatio := sdk.MustNewDecFromStr("0.2")
+	suspectBlocks := sdk.NewInt(60)
+	voterWeights := []oracletypes.PairVoterWeights{{
+		Pair:    asset.MustNewPair("sol:usdc"),
+		Weights: []oracletypes.VoterWeight{{Validator: sdk.ValAddress(testutil.AccAddress()).String(), Weight: 1}},
+	}}
+	maxSnapshotPrunesPerBlock := sdk.NewInt(10)
 	msgEditParams := oracletypes.MsgEditOracleParams{
-		VotePeriod:         &votePeriod,
-		VoteThreshold:      &voteThreshold,
-		RewardBand:         &rewardBand,
-		Whitelist:          whitelist,
-		SlashFraction:      &slashFraction,
-		SlashWindow:        &slashWindow,
-		MinValidPerWindow:  &minValidPerWindow,
-		TwapLookbackWindow: &twapLookbackWindow,
-		MinVoters:          &minVoters,
-		ValidatorFeeRatio:  &validatorFeeRatio,
+		VotePeriod:              &votePeriod,
+		VoteThreshold:           &voteThreshold,
+		RewardBand:              &rewardBand,
+		Whitelist:               whitelist,
+		SlashFraction:           &slashFraction,
+		SlashWindow:             &slashWindow,
+		MinValidPerWindow:       &minValidPerWindow,
+		TwapLookbackWindow:      &twapLookbackWindow,
+		MinVoters:               &minVoters,
+		ValidatorFeeRatio:       &validatorFeeRatio,
+		QuorumFallbacks:         quorumFallbacks,
+		SnapshotRetentionWindow: &snapshotRetentionWindow,
+		EmaSmoothings:           emaSmoothings,
+		MaxPriceChangeRatio:     &maxPriceChangeRatio,
+		SuspectBlocks:           &suspectBlocks,
+		VoterWeights:            voterWeights,
+
+		MaxSnapshotPrunesPerBlock: &maxSnapshotPrunesPerBlock,
 	}
 
 	s.T().Log("Params before MUST NOT be equal to default")
@@ -91,4 +114,16 @@ func (s *SuiteOracleSudo) TestEditOracleParams() {
 	)
 	s.Require().Error(err)
 	s.ErrorContains(err, "oracle parameter SlashWindow must be greater")
+
+	s.T().Log("Disabling suspect blocks while the price change check is on MUST fail")
+	suspectBlocks = sdk.ZeroInt()
+	msgEditParams = oracletypes.MsgEditOracleParams{
+		Sender:        okSender.String(),
+		SuspectBlocks: &suspectBlocks,
+	}
+	_, err = oracleMsgServer.EditOracleParams(
+		goCtx, &msgEditParams,
+	)
+	s.Require().Error(err)
+	s.ErrorContains(err, "oracle parameter SuspectBlocks must be positive")
 }
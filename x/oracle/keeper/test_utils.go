@@ -255,6 +255,7 @@ func CreateTestFixture(t *testing.T) TestFixture {
 		stakingKeeper,
 		sudoKeeper,
 		distrtypes.ModuleName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	defaults := types.DefaultParams()
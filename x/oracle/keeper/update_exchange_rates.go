@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/collections"
@@ -14,20 +16,27 @@ import (
 // UpdateExchangeRates updates the ExchangeRates, this is supposed to be executed on EndBlock.
 func (k Keeper) UpdateExchangeRates(ctx sdk.Context) types.ValidatorPerformances {
 	k.Logger(ctx).Info("processing validator price votes")
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("failed to get oracle params", "error", err)
+		return types.ValidatorPerformances{}
+	}
+
 	validatorPerformances := k.newValidatorPerformances(ctx)
 	whitelistedPairs := set.New[asset.Pair](k.GetWhitelistedPairs(ctx)...)
 
 	pairVotes := k.getPairVotes(ctx, validatorPerformances, whitelistedPairs)
 
-	k.clearExchangeRates(ctx, pairVotes)
-	k.tallyVotesAndUpdatePrices(ctx, pairVotes, validatorPerformances)
+	k.clearExpiredSuspectPairs(ctx)
+
+	k.clearExchangeRates(ctx, params, pairVotes)
+	k.tallyVotesAndUpdatePrices(ctx, params, pairVotes, validatorPerformances)
 
 	k.incrementMissCounters(ctx, whitelistedPairs, validatorPerformances)
 	k.incrementAbstainsByOmission(ctx, len(whitelistedPairs), validatorPerformances)
 
 	k.rewardWinners(ctx, validatorPerformances)
 
-	params, _ := k.Params.Get(ctx)
 	k.clearVotesAndPrevotes(ctx, params.VotePeriod)
 	k.refreshWhitelist(ctx, params.Whitelist, whitelistedPairs)
 
@@ -81,18 +90,106 @@ func (k Keeper) incrementAbstainsByOmission(
 // tallyVotesAndUpdatePrices processes the votes and updates the ExchangeRates based on the results.
 func (k Keeper) tallyVotesAndUpdatePrices(
 	ctx sdk.Context,
+	params types.Params,
 	pairVotes map[asset.Pair]types.ExchangeRateVotes,
 	validatorPerformances types.ValidatorPerformances,
 ) {
-	rewardBand := k.RewardBand(ctx)
 	// Iterate through sorted keys for deterministic ordering.
 	orderedPairVotes := omap.OrderedMap_Pair[types.ExchangeRateVotes](pairVotes)
 	for pair := range orderedPairVotes.Range() {
-		exchangeRate := Tally(pairVotes[pair], rewardBand, validatorPerformances)
+		exchangeRate := Tally(
+			pairVotes[pair], params.RewardBand, params.VoterWeightsFor(pair), validatorPerformances)
+		k.updateVoterStats(ctx, pair, pairVotes[pair], exchangeRate)
+		k.checkPriceChange(ctx, params, pair, exchangeRate)
 		k.SetPrice(ctx, pair, exchangeRate)
 	}
 }
 
+// updateVoterStats records how far each vote of the ballot deviates from the
+// tallied exchange rate. Abstain votes are not recorded.
+func (k Keeper) updateVoterStats(
+	ctx sdk.Context, pair asset.Pair, votes types.ExchangeRateVotes, exchangeRate sdk.Dec,
+) {
+	if !exchangeRate.IsPositive() {
+		return
+	}
+
+	for _, vote := range votes {
+		if !vote.ExchangeRate.IsPositive() {
+			continue
+		}
+
+		key := collections.Join(vote.Voter, pair)
+		stats, err := k.VoterStats.Get(ctx, key)
+		if err != nil {
+			stats = types.NewVoterPairStats()
+		}
+
+		deviation := vote.ExchangeRate.Sub(exchangeRate).Abs().Quo(exchangeRate)
+		stats.LastVoteBlock = uint64(ctx.BlockHeight())
+		stats.LastVoteTimeMs = ctx.BlockTime().UnixMilli()
+		stats.LastExchangeRate = vote.ExchangeRate
+		stats.LastDeviation = deviation
+		stats.VoteCount++
+		// mean = mean + (deviation - mean) / count
+		stats.MeanDeviation = stats.MeanDeviation.Add(
+			deviation.Sub(stats.MeanDeviation).QuoInt64(int64(stats.VoteCount)))
+		if deviation.GT(stats.MaxDeviation) {
+			stats.MaxDeviation = deviation
+		}
+		k.VoterStats.Insert(ctx, key, stats)
+	}
+}
+
+// checkPriceChange marks the pair as suspect for SuspectBlocks if the new
+// price deviates from the latest price snapshot of the pair by more than
+// MaxPriceChangeRatio.
+func (k Keeper) checkPriceChange(ctx sdk.Context, params types.Params, pair asset.Pair, price sdk.Dec) {
+	if params.MaxPriceChangeRatio.IsNil() || !params.MaxPriceChangeRatio.IsPositive() {
+		return
+	}
+
+	iter := k.PriceSnapshots.Iterate(ctx,
+		collections.PairRange[asset.Pair, time.Time]{}.
+			Prefix(pair).
+			Descending(),
+	)
+	defer iter.Close()
+	if !iter.Valid() {
+		return
+	}
+	previousPrice := iter.Value().Price
+	if !previousPrice.IsPositive() {
+		return
+	}
+
+	changeRatio := price.Sub(previousPrice).Abs().Quo(previousPrice)
+	if changeRatio.LTE(params.MaxPriceChangeRatio) {
+		return
+	}
+
+	suspectUntil := uint64(ctx.BlockHeight()) + params.SuspectBlocks
+	k.SuspectPairs.Insert(ctx, pair, suspectUntil)
+	k.Logger(ctx).Info("suspect price", "pair", pair.String(), "previous_price", previousPrice, "price", price)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPriceSuspect{
+		Pair:              pair.String(),
+		PreviousPrice:     previousPrice,
+		Price:             price,
+		SuspectUntilBlock: suspectUntil,
+	}); err != nil {
+		k.Logger(ctx).Error("failed to emit EventPriceSuspect", "pair", pair.String(), "error", err)
+	}
+}
+
+// clearExpiredSuspectPairs removes the pairs whose suspect period is over.
+func (k Keeper) clearExpiredSuspectPairs(ctx sdk.Context) {
+	for _, kv := range k.SuspectPairs.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
+		if kv.Value <= uint64(ctx.BlockHeight()) {
+			_ = k.SuspectPairs.Delete(ctx, kv.Key)
+		}
+	}
+}
+
 // getPairVotes returns a map of pairs and votes excluding abstained votes and votes that don't meet the threshold criteria
 func (k Keeper) getPairVotes(
 	ctx sdk.Context,
@@ -108,9 +205,9 @@ func (k Keeper) getPairVotes(
 
 // clearExchangeRates removes all exchange rates from the state
 // We remove the price for pair with expired prices or valid votes
-func (k Keeper) clearExchangeRates(ctx sdk.Context, pairVotes map[asset.Pair]types.ExchangeRateVotes) {
-	params, _ := k.Params.Get(ctx)
-
+func (k Keeper) clearExchangeRates(
+	ctx sdk.Context, params types.Params, pairVotes map[asset.Pair]types.ExchangeRateVotes,
+) {
 	for _, key := range k.ExchangeRates.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
 		_, isValid := pairVotes[key]
 		previousExchangeRate, _ := k.ExchangeRates.Get(ctx, key)
@@ -121,7 +218,36 @@ func (k Keeper) clearExchangeRates(ctx sdk.Context, pairVotes map[asset.Pair]typ
 			if err != nil {
 				k.Logger(ctx).Error("failed to delete exchange rate", "pair", key.String(), "error", err)
 			}
+			continue
+		}
+
+		k.applyQuorumFallback(ctx, params.QuorumFallbackFor(key), key, previousExchangeRate)
+	}
+}
+
+// applyQuorumFallback updates the exchange rate of a pair whose ballot did not
+// pass in this vote period, according to the pair's QuorumFallback.
+func (k Keeper) applyQuorumFallback(
+	ctx sdk.Context, fallback types.QuorumFallback, pair asset.Pair, previous types.DatedPrice,
+) {
+	switch fallback {
+	case types.QuorumFallback_INVALIDATE:
+		if err := k.ExchangeRates.Delete(ctx, pair); err != nil {
+			k.Logger(ctx).Error("failed to delete exchange rate", "pair", pair.String(), "error", err)
 		}
+	case types.QuorumFallback_TWAP:
+		twap, err := k.GetExchangeRateTwap(ctx, pair)
+		if err != nil {
+			// Keep the frozen price if there are no snapshots to fall back on.
+			k.Logger(ctx).Error("failed to compute fallback twap", "pair", pair.String(), "error", err)
+			return
+		}
+		k.ExchangeRates.Insert(ctx, pair, types.DatedPrice{
+			ExchangeRate: twap,
+			CreatedBlock: previous.CreatedBlock,
+		})
+	default:
+		// FREEZE: the previous price stays until it expires.
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"math"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/rand"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +18,7 @@ import (
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
@@ -108,13 +110,13 @@ func TestResetExchangeRates(t *testing.T) {
 
 	// reset exchange rates at block 2
 	// Price should still be there because not expired yet
-	fixture.OracleKeeper.clearExchangeRates(fixture.Ctx.WithBlockHeight(2), emptyVotes)
+	fixture.OracleKeeper.clearExchangeRates(fixture.Ctx.WithBlockHeight(2), params, emptyVotes)
 	_, err := fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, pair)
 	assert.NoError(t, err)
 
 	// reset exchange rates at block 3 but pair is in votes
 	// Price should be removed there because there was a valid votes
-	fixture.OracleKeeper.clearExchangeRates(fixture.Ctx.WithBlockHeight(3), validVotes)
+	fixture.OracleKeeper.clearExchangeRates(fixture.Ctx.WithBlockHeight(3), params, validVotes)
 	_, err = fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, pair)
 	assert.Error(t, err)
 
@@ -122,12 +124,185 @@ func TestResetExchangeRates(t *testing.T) {
 	// reset exchange rates at block 79
 	// Price should not be there anymore because expired
 	fixture.OracleKeeper.SetPrice(fixture.Ctx.WithBlockHeight(69), pair, testExchangeRate)
-	fixture.OracleKeeper.clearExchangeRates(fixture.Ctx.WithBlockHeight(79), emptyVotes)
+	fixture.OracleKeeper.clearExchangeRates(fixture.Ctx.WithBlockHeight(79), params, emptyVotes)
 
 	_, err = fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, pair)
 	assert.Error(t, err)
 }
 
+func TestQuorumFallback(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	emptyVotes := map[asset.Pair]types.ExchangeRateVotes{}
+
+	setup := func(t *testing.T, fallback types.QuorumFallback) (TestFixture, types.Params) {
+		fixture, _ := Setup(t)
+		params, _ := fixture.OracleKeeper.Params.Get(fixture.Ctx)
+		params.ExpirationBlocks = 10
+		params.QuorumFallbacks = []types.PairQuorumFallback{{Pair: pair, Fallback: fallback}}
+		fixture.OracleKeeper.Params.Set(fixture.Ctx, params)
+
+		// Two voted prices, 1 second apart, with the same weight in the TWAP.
+		start := fixture.Ctx.BlockTime()
+		fixture.OracleKeeper.SetPrice(fixture.Ctx.WithBlockHeight(1).WithBlockTime(start), pair, sdk.NewDec(10))
+		fixture.OracleKeeper.SetPrice(fixture.Ctx.WithBlockHeight(2).WithBlockTime(start.Add(time.Second)), pair, sdk.NewDec(20))
+		fixture.Ctx = fixture.Ctx.WithBlockHeight(3).WithBlockTime(start.Add(2 * time.Second))
+		return fixture, params
+	}
+
+	t.Run("freeze keeps the last price until it expires", func(t *testing.T) {
+		fixture, params := setup(t, types.QuorumFallback_FREEZE)
+		fixture.OracleKeeper.clearExchangeRates(fixture.Ctx, params, emptyVotes)
+		price, err := fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, pair)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(20), price.ExchangeRate)
+		require.EqualValues(t, 2, price.CreatedBlock)
+
+		fixture.OracleKeeper.clearExchangeRates(fixture.Ctx.WithBlockHeight(12), params, emptyVotes)
+		_, err = fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, pair)
+		require.Error(t, err)
+	})
+
+	t.Run("invalidate removes the price immediately", func(t *testing.T) {
+		fixture, params := setup(t, types.QuorumFallback_INVALIDATE)
+		fixture.OracleKeeper.clearExchangeRates(fixture.Ctx, params, emptyVotes)
+		_, err := fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, pair)
+		require.Error(t, err)
+	})
+
+	t.Run("twap replaces the price and keeps its age", func(t *testing.T) {
+		fixture, params := setup(t, types.QuorumFallback_TWAP)
+		fixture.OracleKeeper.clearExchangeRates(fixture.Ctx, params, emptyVotes)
+		price, err := fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, pair)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(15), price.ExchangeRate)
+		require.EqualValues(t, 2, price.CreatedBlock)
+
+		fixture.OracleKeeper.clearExchangeRates(fixture.Ctx.WithBlockHeight(12), params, emptyVotes)
+		_, err = fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, pair)
+		require.Error(t, err)
+	})
+}
+
+func TestPriceChangeCircuitBreaker(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	fixture, _ := Setup(t)
+	params, _ := fixture.OracleKeeper.Params.Get(fixture.Ctx)
+	params.MaxPriceChangeRatio = sdk.NewDecWithPrec(1, 1) // 10%
+	params.SuspectBlocks = 5
+	fixture.OracleKeeper.Params.Set(fixture.Ctx, params)
+
+	start := fixture.Ctx.BlockTime()
+	ctxAt := func(height int64) sdk.Context {
+		return fixture.Ctx.WithBlockHeight(height).WithBlockTime(start.Add(time.Duration(height) * time.Second))
+	}
+	setPrice := func(height int64, price sdk.Dec) {
+		ctx := ctxAt(height)
+		fixture.OracleKeeper.checkPriceChange(ctx, params, pair, price)
+		fixture.OracleKeeper.SetPrice(ctx, pair, price)
+	}
+
+	t.Log("the first price and small changes are not suspect")
+	setPrice(1, sdk.NewDec(100))
+	setPrice(2, sdk.NewDec(109))
+	_, err := fixture.OracleKeeper.SuspectPairs.Get(ctxAt(2), pair)
+	require.Error(t, err)
+	price, err := fixture.OracleKeeper.GetExchangeRate(ctxAt(2), pair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(109), price)
+
+	t.Log("a large change marks the pair as suspect")
+	ctx := ctxAt(3)
+	fixture.OracleKeeper.checkPriceChange(ctx, params, pair, sdk.NewDec(200))
+	fixture.OracleKeeper.SetPrice(ctx, pair, sdk.NewDec(200))
+	suspectUntil, err := fixture.OracleKeeper.SuspectPairs.Get(ctx, pair)
+	require.NoError(t, err)
+	require.EqualValues(t, 8, suspectUntil)
+	testutil.RequireContainsTypedEvent(t, ctx, &types.EventPriceSuspect{
+		Pair:              pair.String(),
+		PreviousPrice:     sdk.NewDec(109),
+		Price:             sdk.NewDec(200),
+		SuspectUntilBlock: 8,
+	})
+
+	t.Log("consumers reject the suspect price")
+	_, err = fixture.OracleKeeper.GetExchangeRate(ctxAt(7), pair)
+	require.ErrorIs(t, err, types.ErrSuspectPrice)
+	_, err = fixture.OracleKeeper.GetExchangeRate(ctxAt(7), pair.Inverse())
+	require.ErrorIs(t, err, types.ErrSuspectPrice)
+	_, err = fixture.OracleKeeper.GetExchangeRateTwap(ctxAt(7), pair)
+	require.ErrorIs(t, err, types.ErrSuspectPrice)
+
+	t.Log("the price is served again once the suspect period is over")
+	price, err = fixture.OracleKeeper.GetExchangeRate(ctxAt(8), pair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(200), price)
+	fixture.OracleKeeper.clearExpiredSuspectPairs(ctxAt(8))
+	_, err = fixture.OracleKeeper.SuspectPairs.Get(ctxAt(8), pair)
+	require.Error(t, err)
+}
+
+func TestVoterWeightedMedian(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	stakeWeightedMedian := sdk.NewDec(100)
+	votes := types.ExchangeRateVotes{
+		types.NewExchangeRateVote(sdk.NewDec(100), pair, ValAddrs[0], 10),
+		types.NewExchangeRateVote(sdk.NewDec(100), pair, ValAddrs[1], 10),
+		types.NewExchangeRateVote(sdk.NewDec(105), pair, ValAddrs[2], 1),
+		types.NewExchangeRateVote(sdk.ZeroDec(), pair, ValAddrs[3], 0),
+	}
+
+	require.Equal(t, stakeWeightedMedian, voterWeightedMedian(votes, nil),
+		"pairs without weights use the stake weighted median")
+
+	weights := []types.VoterWeight{
+		{Validator: ValAddrs[2].String(), Weight: 10},
+		{Validator: ValAddrs[0].String(), Weight: 1},
+		{Validator: ValAddrs[3].String(), Weight: 100},
+	}
+	require.Equal(t, sdk.NewDec(105), voterWeightedMedian(votes, weights),
+		"the weighted voters dominate and abstain votes are left out")
+
+	require.Equal(t, stakeWeightedMedian, voterWeightedMedian(
+		votes, []types.VoterWeight{{Validator: ValAddrs[4].String(), Weight: 1}}),
+		"falls back to the stake weighted median when no weighted voter voted")
+}
+
+func TestTallyWithVoterWeights(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	votes := types.ExchangeRateVotes{
+		types.NewExchangeRateVote(sdk.NewDec(100), pair, ValAddrs[0], 10),
+		types.NewExchangeRateVote(sdk.NewDec(100), pair, ValAddrs[1], 10),
+		types.NewExchangeRateVote(sdk.NewDec(200), pair, ValAddrs[2], 1),
+		types.NewExchangeRateVote(sdk.NewDec(200), pair, ValAddrs[3], 1),
+		types.NewExchangeRateVote(sdk.NewDec(200), pair, ValAddrs[4], 1),
+	}
+	weights := []types.VoterWeight{
+		{Validator: ValAddrs[2].String(), Weight: 1},
+		{Validator: ValAddrs[3].String(), Weight: 1},
+		{Validator: ValAddrs[4].String(), Weight: 1},
+	}
+	validatorPerformances := types.ValidatorPerformances{}
+	for i, vote := range votes {
+		validatorPerformances[ValAddrs[i].String()] = types.NewValidatorPerformance(vote.Power, ValAddrs[i])
+	}
+
+	exchangeRate := Tally(votes, sdk.MustNewDecFromStr("0.02"), weights, validatorPerformances)
+	require.Equal(t, sdk.NewDec(200), exchangeRate)
+
+	// the votes are rewarded and missed against the published median, 200, and
+	// not against the stake weighted median, 100
+	for i := 0; i < 2; i++ {
+		performance := validatorPerformances[ValAddrs[i].String()]
+		require.EqualValues(t, 1, performance.MissCount, "validator %d", i)
+		require.EqualValues(t, 0, performance.WinCount, "validator %d", i)
+	}
+	for i := 2; i < 5; i++ {
+		performance := validatorPerformances[ValAddrs[i].String()]
+		require.EqualValues(t, 1, performance.WinCount, "validator %d", i)
+		require.EqualValues(t, 0, performance.MissCount, "validator %d", i)
+	}
+}
+
 func TestOracleTally(t *testing.T) {
 	fixture, _ := Setup(t)
 
@@ -208,7 +383,7 @@ func TestOracleTally(t *testing.T) {
 	}
 
 	tallyMedian := Tally(
-		votes, fixture.OracleKeeper.RewardBand(fixture.Ctx), validatorPerformances)
+		votes, fixture.OracleKeeper.RewardBand(fixture.Ctx), nil, validatorPerformances)
 
 	assert.Equal(t, expectedValidatorPerformances, validatorPerformances)
 	assert.Equal(t, tallyMedian.MulInt64(100).TruncateInt(), weightedMedian.MulInt64(100).TruncateInt())
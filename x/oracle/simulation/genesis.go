@@ -116,6 +116,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		[]types.AggregateExchangeRateVote{},
 		[]asset.Pair{},
 		[]types.Rewards{},
+		[]types.PriceSnapshot{},
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis, "", " ")
@@ -104,6 +104,25 @@ func (votes ExchangeRateVotes) WeightedMedian() sdk.Dec {
 	return sdk.ZeroDec()
 }
 
+// WithVoterWeights returns a copy of the votes whose power is replaced by the
+// given voter weights. Voters without a weight and abstain votes get zero power.
+func (pb ExchangeRateVotes) WithVoterWeights(weights []VoterWeight) ExchangeRateVotes {
+	weightOf := make(map[string]int64, len(weights))
+	for _, w := range weights {
+		weightOf[w.Validator] = int64(w.Weight)
+	}
+
+	weighted := make(ExchangeRateVotes, len(pb))
+	for i, vote := range pb {
+		vote.Power = 0
+		if vote.ExchangeRate.IsPositive() {
+			vote.Power = weightOf[vote.Voter.String()]
+		}
+		weighted[i] = vote
+	}
+	return weighted
+}
+
 // WeightedMedianWithAssertion returns the median weighted by the power of the ExchangeRateVote.
 func (pb ExchangeRateVotes) WeightedMedianWithAssertion() sdk.Dec {
 	sort.Sort(pb)
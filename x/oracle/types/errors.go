@@ -36,4 +36,6 @@ var (
 	ErrNoAggregateVote        = registerError("no aggregate vote")
 	ErrUnknownPair            = registerError("unknown pair")
 	ErrNoValidTWAP            = registerError("TWA price not found")
+	ErrNoValidEma             = registerError("EMA price not found")
+	ErrSuspectPrice           = registerError("price is suspect")
 )
@@ -79,6 +79,63 @@ func (m *EventPriceUpdate) GetTimestampMs() int64 {
 	return 0
 }
 
+// Emitted when a new price deviates from the previous price of the pair by
+// more than max_price_change_ratio, marking the pair's price as suspect.
+type EventPriceSuspect struct {
+	Pair          string                                 `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	PreviousPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=previous_price,json=previousPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"previous_price"`
+	Price         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// block height until which the price of the pair is suspect
+	SuspectUntilBlock uint64 `protobuf:"varint,4,opt,name=suspect_until_block,json=suspectUntilBlock,proto3" json:"suspect_until_block,omitempty"`
+}
+
+func (m *EventPriceSuspect) Reset()         { *m = EventPriceSuspect{} }
+func (m *EventPriceSuspect) String() string { return proto.CompactTextString(m) }
+func (*EventPriceSuspect) ProtoMessage()    {}
+func (*EventPriceSuspect) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94ec441b793fc0ea, []int{1}
+}
+func (m *EventPriceSuspect) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPriceSuspect) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPriceSuspect.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPriceSuspect) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPriceSuspect.Merge(m, src)
+}
+func (m *EventPriceSuspect) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPriceSuspect) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPriceSuspect.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPriceSuspect proto.InternalMessageInfo
+
+func (m *EventPriceSuspect) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *EventPriceSuspect) GetSuspectUntilBlock() uint64 {
+	if m != nil {
+		return m.SuspectUntilBlock
+	}
+	return 0
+}
+
 // Emitted when a valoper delegates oracle voting rights to a feeder address.
 type EventDelegateFeederConsent struct {
 	// Validator is the Bech32 address that is delegating voting rights.
@@ -92,7 +149,7 @@ func (m *EventDelegateFeederConsent) Reset()         { *m = EventDelegateFeederC
 func (m *EventDelegateFeederConsent) String() string { return proto.CompactTextString(m) }
 func (*EventDelegateFeederConsent) ProtoMessage()    {}
 func (*EventDelegateFeederConsent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94ec441b793fc0ea, []int{1}
+	return fileDescriptor_94ec441b793fc0ea, []int{2}
 }
 func (m *EventDelegateFeederConsent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -149,7 +206,7 @@ func (m *EventAggregateVote) Reset()         { *m = EventAggregateVote{} }
 func (m *EventAggregateVote) String() string { return proto.CompactTextString(m) }
 func (*EventAggregateVote) ProtoMessage()    {}
 func (*EventAggregateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94ec441b793fc0ea, []int{2}
+	return fileDescriptor_94ec441b793fc0ea, []int{3}
 }
 func (m *EventAggregateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,7 +270,7 @@ func (m *EventAggregatePrevote) Reset()         { *m = EventAggregatePrevote{} }
 func (m *EventAggregatePrevote) String() string { return proto.CompactTextString(m) }
 func (*EventAggregatePrevote) ProtoMessage()    {}
 func (*EventAggregatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94ec441b793fc0ea, []int{3}
+	return fileDescriptor_94ec441b793fc0ea, []int{4}
 }
 func (m *EventAggregatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,7 +333,7 @@ func (m *EventValidatorPerformance) Reset()         { *m = EventValidatorPerform
 func (m *EventValidatorPerformance) String() string { return proto.CompactTextString(m) }
 func (*EventValidatorPerformance) ProtoMessage()    {}
 func (*EventValidatorPerformance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94ec441b793fc0ea, []int{4}
+	return fileDescriptor_94ec441b793fc0ea, []int{5}
 }
 func (m *EventValidatorPerformance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,6 +406,7 @@ func (m *EventValidatorPerformance) GetMissCount() int64 {
 
 func init() {
 	proto.RegisterType((*EventPriceUpdate)(nil), "nibiru.oracle.v1.EventPriceUpdate")
+	proto.RegisterType((*EventPriceSuspect)(nil), "nibiru.oracle.v1.EventPriceSuspect")
 	proto.RegisterType((*EventDelegateFeederConsent)(nil), "nibiru.oracle.v1.EventDelegateFeederConsent")
 	proto.RegisterType((*EventAggregateVote)(nil), "nibiru.oracle.v1.EventAggregateVote")
 	proto.RegisterType((*EventAggregatePrevote)(nil), "nibiru.oracle.v1.EventAggregatePrevote")
@@ -358,39 +416,44 @@ func init() {
 func init() { proto.RegisterFile("nibiru/oracle/v1/event.proto", fileDescriptor_94ec441b793fc0ea) }
 
 var fileDescriptor_94ec441b793fc0ea = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0x6e, 0xe8, 0x56, 0x51, 0xb7, 0x48, 0x93, 0x05, 0xa8, 0x94, 0x2e, 0xdd, 0x3a, 0x09, 0xf5,
-	0x02, 0x12, 0x0d, 0x9e, 0x80, 0xb6, 0xdb, 0xdd, 0x50, 0x15, 0xc1, 0x26, 0x71, 0x53, 0xb9, 0xc9,
-	0x99, 0x6b, 0x91, 0xd8, 0x91, 0xed, 0x26, 0xe3, 0x29, 0xe0, 0x1d, 0xb8, 0xe3, 0x49, 0x76, 0xb9,
-	0x4b, 0xc4, 0xc5, 0x40, 0xed, 0x8b, 0x20, 0x3b, 0xee, 0xf8, 0xd9, 0x05, 0xd2, 0xae, 0x72, 0xfc,
-	0x7d, 0x9f, 0xbf, 0x7c, 0xc9, 0x39, 0x07, 0xf5, 0x38, 0x9b, 0x33, 0xb9, 0x0c, 0x85, 0x24, 0x71,
-	0x0a, 0x61, 0x71, 0x18, 0x42, 0x01, 0x5c, 0x07, 0xb9, 0x14, 0x5a, 0xe0, 0x9d, 0x8a, 0x0d, 0x2a,
-	0x36, 0x28, 0x0e, 0xbb, 0xbb, 0xb7, 0xf4, 0x8e, 0xb3, 0x17, 0xba, 0x0f, 0xa9, 0xa0, 0xc2, 0x96,
-	0xa1, 0xa9, 0x1c, 0xda, 0xa3, 0x42, 0xd0, 0x14, 0x42, 0x92, 0xb3, 0x90, 0x70, 0x2e, 0x34, 0xd1,
-	0x4c, 0x70, 0x55, 0xb1, 0x83, 0x4f, 0x1e, 0xda, 0x39, 0x32, 0x2f, 0x9d, 0x4a, 0x16, 0xc3, 0xbb,
-	0x3c, 0x21, 0x1a, 0x30, 0x46, 0x5b, 0x39, 0x61, 0xb2, 0xe3, 0xed, 0x79, 0xc3, 0x66, 0x64, 0x6b,
-	0x3c, 0x41, 0xdb, 0xb9, 0x91, 0x74, 0xee, 0x19, 0x70, 0x14, 0x5c, 0x5e, 0xf7, 0x6b, 0xdf, 0xaf,
-	0xfb, 0xcf, 0x28, 0xd3, 0x8b, 0xe5, 0x3c, 0x88, 0x45, 0x16, 0xc6, 0x42, 0x65, 0x42, 0xb9, 0xc7,
-	0x0b, 0x95, 0x7c, 0x08, 0xf5, 0xc7, 0x1c, 0x54, 0x30, 0x81, 0x38, 0xaa, 0x2e, 0xe3, 0x7d, 0xd4,
-	0xd6, 0x2c, 0x03, 0xa5, 0x49, 0x96, 0xcf, 0x32, 0xd5, 0xa9, 0xef, 0x79, 0xc3, 0x7a, 0xd4, 0xba,
-	0xc1, 0x4e, 0xd4, 0x20, 0x42, 0x5d, 0x1b, 0x68, 0x02, 0x29, 0x50, 0xa2, 0xe1, 0x18, 0x20, 0x01,
-	0x39, 0x16, 0x5c, 0x01, 0xd7, 0xb8, 0x87, 0x9a, 0x05, 0x49, 0x59, 0x42, 0xb4, 0xd8, 0xe4, 0xfb,
-	0x0d, 0xe0, 0xc7, 0xa8, 0x71, 0x6e, 0xe5, 0x55, 0xca, 0xc8, 0x9d, 0x06, 0x5f, 0x3c, 0x84, 0xad,
-	0xe9, 0x6b, 0x4a, 0xa5, 0x75, 0x3d, 0x15, 0x1a, 0xee, 0x66, 0x86, 0xcf, 0x50, 0xc3, 0x7e, 0x8c,
-	0x49, 0x5f, 0x1f, 0xb6, 0x5e, 0x1e, 0x04, 0xff, 0x36, 0x2a, 0x38, 0xba, 0x88, 0x17, 0x84, 0x53,
-	0x88, 0x88, 0x86, 0xb7, 0xcb, 0x3c, 0x85, 0x51, 0xd7, 0xfc, 0xaf, 0xaf, 0x3f, 0xfa, 0xf8, 0x16,
-	0xa5, 0x22, 0x67, 0x37, 0x38, 0x41, 0x8f, 0xfe, 0x0e, 0x39, 0x95, 0x50, 0xdc, 0x39, 0xe7, 0x60,
-	0xe5, 0xa1, 0x27, 0xd6, 0xef, 0x74, 0x23, 0x9d, 0x82, 0x3c, 0x17, 0x32, 0x23, 0x3c, 0xfe, 0x9f,
-	0xe7, 0x3e, 0x6a, 0x17, 0x42, 0x33, 0x4e, 0x67, 0xb9, 0x28, 0x9d, 0x73, 0x3d, 0x6a, 0x55, 0xd8,
-	0xd4, 0x40, 0xf8, 0x00, 0x3d, 0x90, 0x50, 0x12, 0x99, 0xcc, 0x4a, 0x60, 0x74, 0xa1, 0x5d, 0x2f,
-	0xdb, 0x15, 0x78, 0x66, 0x31, 0xfc, 0x14, 0x35, 0x4b, 0xc6, 0x67, 0xb1, 0x58, 0x72, 0xdd, 0xd9,
-	0xb2, 0x82, 0xfb, 0x25, 0xe3, 0x63, 0x73, 0x36, 0x0e, 0x64, 0xae, 0x34, 0xb9, 0x11, 0x6c, 0x57,
-	0x0e, 0x0e, 0xac, 0x44, 0xbb, 0x08, 0x65, 0x4c, 0x29, 0xa7, 0x68, 0x58, 0x45, 0xd3, 0x20, 0x96,
-	0x1e, 0x1d, 0x5f, 0xae, 0x7c, 0xef, 0x6a, 0xe5, 0x7b, 0x3f, 0x57, 0xbe, 0xf7, 0x79, 0xed, 0xd7,
-	0xae, 0xd6, 0x7e, 0xed, 0xdb, 0xda, 0xaf, 0xbd, 0x7f, 0xfe, 0xc7, 0x64, 0xbe, 0xb1, 0x0d, 0x1a,
-	0x2f, 0x08, 0xe3, 0xa1, 0xdb, 0xa1, 0x8b, 0xcd, 0x16, 0xd9, 0x19, 0x9d, 0x37, 0xec, 0x3a, 0xbc,
-	0xfa, 0x15, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xd7, 0x9a, 0x89, 0x93, 0x03, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xdf, 0x6e, 0xd3, 0x3e,
+	0x18, 0x6d, 0x7e, 0xd9, 0xaa, 0x5f, 0xbd, 0x0d, 0x6d, 0xe6, 0x8f, 0x4a, 0xd9, 0xb2, 0x2d, 0x93,
+	0x50, 0x2f, 0x20, 0xd1, 0xe0, 0x09, 0x68, 0xbb, 0xdd, 0x0d, 0x55, 0x81, 0x6e, 0x12, 0x37, 0x91,
+	0x9b, 0x7e, 0x4b, 0xad, 0x25, 0x76, 0x64, 0x3b, 0xe9, 0x78, 0x0a, 0x78, 0x07, 0xee, 0x78, 0x92,
+	0x5d, 0xee, 0x12, 0x71, 0x31, 0x50, 0xfb, 0x0a, 0x3c, 0x00, 0xb2, 0x93, 0xae, 0xc0, 0x90, 0x90,
+	0xb6, 0xab, 0x38, 0xe7, 0x1c, 0x1f, 0x9f, 0xcf, 0xfe, 0xf4, 0xa1, 0x4d, 0x46, 0x87, 0x54, 0xe4,
+	0x3e, 0x17, 0x24, 0x4a, 0xc0, 0x2f, 0xf6, 0x7d, 0x28, 0x80, 0x29, 0x2f, 0x13, 0x5c, 0x71, 0xbc,
+	0x5e, 0xb2, 0x5e, 0xc9, 0x7a, 0xc5, 0x7e, 0x6b, 0xeb, 0x86, 0xbe, 0xe2, 0xcc, 0x86, 0xd6, 0x83,
+	0x98, 0xc7, 0xdc, 0x2c, 0x7d, 0xbd, 0xaa, 0xd0, 0xcd, 0x98, 0xf3, 0x38, 0x01, 0x9f, 0x64, 0xd4,
+	0x27, 0x8c, 0x71, 0x45, 0x14, 0xe5, 0x4c, 0x96, 0xac, 0xfb, 0xc1, 0x42, 0xeb, 0x07, 0xfa, 0xd0,
+	0xbe, 0xa0, 0x11, 0x0c, 0xb2, 0x11, 0x51, 0x80, 0x31, 0x5a, 0xca, 0x08, 0x15, 0x4d, 0x6b, 0xc7,
+	0x6a, 0x37, 0x02, 0xb3, 0xc6, 0x3d, 0xb4, 0x9c, 0x69, 0x49, 0xf3, 0x3f, 0x0d, 0x76, 0xbc, 0x8b,
+	0xab, 0xed, 0xda, 0xd7, 0xab, 0xed, 0xa7, 0x31, 0x55, 0xe3, 0x7c, 0xe8, 0x45, 0x3c, 0xf5, 0x23,
+	0x2e, 0x53, 0x2e, 0xab, 0xcf, 0x73, 0x39, 0x3a, 0xf3, 0xd5, 0xfb, 0x0c, 0xa4, 0xd7, 0x83, 0x28,
+	0x28, 0x37, 0xe3, 0x5d, 0xb4, 0xaa, 0x68, 0x0a, 0x52, 0x91, 0x34, 0x0b, 0x53, 0xd9, 0xb4, 0x77,
+	0xac, 0xb6, 0x1d, 0xac, 0x5c, 0x63, 0x47, 0xd2, 0xfd, 0x61, 0xa1, 0x8d, 0x45, 0xa2, 0x37, 0xb9,
+	0xcc, 0x20, 0x52, 0x7f, 0x8d, 0x34, 0x40, 0xf7, 0x32, 0x01, 0x05, 0xe5, 0xb9, 0x0c, 0xef, 0x92,
+	0x6d, 0x6d, 0xee, 0x62, 0x4e, 0x5c, 0x54, 0x6a, 0xdf, 0xa5, 0x52, 0x0f, 0xdd, 0x97, 0x65, 0xf6,
+	0x30, 0x67, 0x8a, 0x26, 0xe1, 0x30, 0xe1, 0xd1, 0x59, 0x73, 0x69, 0xc7, 0x6a, 0x2f, 0x05, 0x1b,
+	0x15, 0x35, 0xd0, 0x4c, 0x47, 0x13, 0x6e, 0x80, 0x5a, 0xa6, 0xea, 0x1e, 0x24, 0x10, 0x13, 0x05,
+	0x87, 0x00, 0x23, 0x10, 0x5d, 0xce, 0x24, 0x30, 0x85, 0x37, 0x51, 0xa3, 0x20, 0x09, 0x1d, 0x11,
+	0xc5, 0xe7, 0x77, 0xb0, 0x00, 0xf0, 0x23, 0x54, 0x3f, 0x35, 0xf2, 0xf2, 0x02, 0x82, 0xea, 0xcf,
+	0xfd, 0x64, 0x21, 0x6c, 0x4c, 0x5f, 0xc5, 0xb1, 0x30, 0xae, 0xc7, 0x5c, 0xc1, 0xed, 0xcc, 0xf0,
+	0x09, 0xaa, 0x9b, 0xca, 0xf4, 0xa3, 0xd9, 0xed, 0x95, 0x17, 0x7b, 0xde, 0x9f, 0xfd, 0xe9, 0x1d,
+	0x9c, 0x47, 0x63, 0xc2, 0x62, 0x08, 0x88, 0x82, 0xb7, 0x79, 0x96, 0x40, 0xa7, 0xa5, 0x2f, 0xef,
+	0xf3, 0xb7, 0x6d, 0x7c, 0x83, 0x92, 0x41, 0x65, 0xe7, 0x1e, 0xa1, 0x87, 0xbf, 0x87, 0xec, 0x0b,
+	0x28, 0x6e, 0x9d, 0xd3, 0x9d, 0x5a, 0xe8, 0xb1, 0xf1, 0x3b, 0x9e, 0x4b, 0xfb, 0x20, 0x4e, 0xb9,
+	0x48, 0x09, 0x8b, 0xfe, 0xe5, 0xb9, 0x8b, 0x56, 0x0b, 0xae, 0x28, 0x8b, 0xc3, 0x8c, 0x4f, 0x2a,
+	0x67, 0x3b, 0x58, 0x29, 0xb1, 0xbe, 0x86, 0xf0, 0x1e, 0x5a, 0x13, 0x30, 0x21, 0x62, 0x14, 0x4e,
+	0x80, 0xc6, 0x63, 0x55, 0xb5, 0xf0, 0x6a, 0x09, 0x9e, 0x18, 0x0c, 0x3f, 0x41, 0x8d, 0x09, 0x65,
+	0x61, 0xc4, 0x73, 0xa6, 0xcc, 0x93, 0xdb, 0xc1, 0xff, 0x13, 0xca, 0xba, 0xfa, 0x5f, 0x3b, 0x90,
+	0xa1, 0x54, 0xe4, 0x5a, 0xb0, 0x5c, 0x3a, 0x54, 0x60, 0x29, 0xda, 0x42, 0x28, 0xa5, 0x52, 0x56,
+	0x8a, 0xba, 0x51, 0x34, 0x34, 0x62, 0xe8, 0xce, 0xe1, 0xc5, 0xd4, 0xb1, 0x2e, 0xa7, 0x8e, 0xf5,
+	0x7d, 0xea, 0x58, 0x1f, 0x67, 0x4e, 0xed, 0x72, 0xe6, 0xd4, 0xbe, 0xcc, 0x9c, 0xda, 0xbb, 0x67,
+	0xbf, 0xb4, 0xe9, 0x6b, 0xf3, 0x40, 0xdd, 0x31, 0xa1, 0xcc, 0xaf, 0x46, 0xc7, 0xf9, 0x7c, 0x78,
+	0x98, 0x86, 0x1d, 0xd6, 0xcd, 0x14, 0x78, 0xf9, 0x73, 0x00, 0x3e, 0x70, 0x08, 0xe2, 0x8a, 0x04,
+	0x00, 0x00,
 }
 
 func (m *EventPriceUpdate) Marshal() (dAtA []byte, err error) {
@@ -438,6 +501,61 @@ func (m *EventPriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPriceSuspect) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPriceSuspect) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPriceSuspect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SuspectUntilBlock != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SuspectUntilBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PreviousPrice.Size()
+		i -= size
+		if _, err := m.PreviousPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventDelegateFeederConsent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -647,6 +765,26 @@ func (m *EventPriceUpdate) Size() (n int) {
 	return n
 }
 
+func (m *EventPriceSuspect) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.PreviousPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.SuspectUntilBlock != 0 {
+		n += 1 + sovEvent(uint64(m.SuspectUntilBlock))
+	}
+	return n
+}
+
 func (m *EventDelegateFeederConsent) Size() (n int) {
 	if m == nil {
 		return 0
@@ -873,6 +1011,175 @@ func (m *EventPriceUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventPriceSuspect) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPriceSuspect: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPriceSuspect: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspectUntilBlock", wireType)
+			}
+			m.SuspectUntilBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuspectUntilBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventDelegateFeederConsent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
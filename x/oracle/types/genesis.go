@@ -2,10 +2,13 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/set"
 )
 
 // NewGenesisState creates a new GenesisState object
@@ -16,6 +19,7 @@ func NewGenesisState(
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
 	pairs []asset.Pair,
 	rewards []Rewards,
+	priceSnapshots []PriceSnapshot,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                        params,
@@ -26,6 +30,7 @@ func NewGenesisState(
 		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
 		Pairs:                         pairs,
 		Rewards:                       rewards,
+		PriceSnapshots:                priceSnapshots,
 	}
 }
 
@@ -39,12 +44,67 @@ func DefaultGenesisState() *GenesisState {
 		[]AggregateExchangeRatePrevote{},
 		[]AggregateExchangeRateVote{},
 		[]asset.Pair{},
-		[]Rewards{})
+		[]Rewards{},
+		[]PriceSnapshot{})
 }
 
-// ValidateGenesis validates the oracle genesis state
+// ValidateGenesis validates the oracle genesis state. The exchange rates and
+// price snapshots must be of pairs of the whitelist in the params.
 func ValidateGenesis(data *GenesisState) error {
-	return data.Params.Validate()
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	whitelist := set.New(data.Params.Whitelist...)
+	seenPairs := make(map[asset.Pair]bool)
+	for _, rate := range data.ExchangeRates {
+		if err := rate.Pair.Validate(); err != nil {
+			return fmt.Errorf("invalid exchange rate pair: %w", err)
+		}
+		if !whitelist.Has(rate.Pair) {
+			return fmt.Errorf("exchange rate pair %s is not whitelisted", rate.Pair)
+		}
+		if seenPairs[rate.Pair] {
+			return fmt.Errorf("duplicate exchange rate for pair %s", rate.Pair)
+		}
+		seenPairs[rate.Pair] = true
+		if rate.ExchangeRate.IsNil() || !rate.ExchangeRate.IsPositive() {
+			return fmt.Errorf("exchange rate of pair %s must be positive", rate.Pair)
+		}
+	}
+
+	seenSnapshots := make(map[string]bool)
+	for _, snapshot := range data.PriceSnapshots {
+		if err := snapshot.Pair.Validate(); err != nil {
+			return fmt.Errorf("invalid price snapshot pair: %w", err)
+		}
+		if !whitelist.Has(snapshot.Pair) {
+			return fmt.Errorf("price snapshot pair %s is not whitelisted", snapshot.Pair)
+		}
+		key := fmt.Sprintf("%s/%d", snapshot.Pair, snapshot.TimestampMs)
+		if seenSnapshots[key] {
+			return fmt.Errorf("duplicate price snapshot for pair %s at %d", snapshot.Pair, snapshot.TimestampMs)
+		}
+		seenSnapshots[key] = true
+		if snapshot.Price.IsNil() || !snapshot.Price.IsPositive() {
+			return fmt.Errorf("price snapshot of pair %s at %d must be positive", snapshot.Pair, snapshot.TimestampMs)
+		}
+	}
+
+	return nil
+}
+
+// ValidatePriceSnapshotTimes checks that no price snapshot is dated after the
+// genesis time. It is separate from ValidateGenesis, which does not know the
+// genesis time of the chain.
+func (gs GenesisState) ValidatePriceSnapshotTimes(genesisTime time.Time) error {
+	for _, snapshot := range gs.PriceSnapshots {
+		if snapshotTime := time.UnixMilli(snapshot.TimestampMs); snapshotTime.After(genesisTime) {
+			return fmt.Errorf("price snapshot of pair %s at %s is after genesis time %s",
+				snapshot.Pair, snapshotTime.UTC(), genesisTime.UTC())
+		}
+	}
+	return nil
 }
 
 // GetGenesisStateFromAppState returns x/oracle GenesisState given raw application
@@ -35,6 +35,10 @@ type GenesisState struct {
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote                         `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
 	Pairs                         []github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,7,rep,name=pairs,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pairs"`
 	Rewards                       []Rewards                                           `protobuf:"bytes,8,rep,name=rewards,proto3" json:"rewards"`
+	// price_snapshots seed the price history used for TWAPs. Snapshots taken
+	// after the genesis time are rejected and snapshots older than the snapshot
+	// retention window are dropped.
+	PriceSnapshots []PriceSnapshot `protobuf:"bytes,9,rep,name=price_snapshots,json=priceSnapshots,proto3" json:"price_snapshots"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -119,6 +123,13 @@ func (m *GenesisState) GetRewards() []Rewards {
 	return nil
 }
 
+func (m *GenesisState) GetPriceSnapshots() []PriceSnapshot {
+	if m != nil {
+		return m.PriceSnapshots
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -237,42 +248,44 @@ func init() {
 func init() { proto.RegisterFile("nibiru/oracle/v1/genesis.proto", fileDescriptor_d88ebb2fa2659942) }
 
 var fileDescriptor_d88ebb2fa2659942 = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x5f, 0x6b, 0x13, 0x4d,
-	0x14, 0xc6, 0xb3, 0xfd, 0xfb, 0x76, 0xd2, 0x94, 0x74, 0x78, 0x2f, 0xd6, 0x40, 0x36, 0x31, 0x22,
-	0x04, 0x2a, 0xbb, 0xa4, 0x82, 0xd0, 0xcb, 0xa6, 0x5a, 0xbd, 0x51, 0xc3, 0x2a, 0x0a, 0x82, 0x84,
-	0xc9, 0xee, 0xc9, 0x66, 0x20, 0xbb, 0xb3, 0xcc, 0x99, 0xc4, 0x7a, 0xe1, 0x77, 0xf0, 0x73, 0xf8,
-	0x49, 0x7a, 0x59, 0xbc, 0x12, 0x2f, 0xaa, 0x24, 0x5f, 0x44, 0x76, 0x66, 0xdb, 0xc4, 0x6c, 0xab,
-	0xde, 0x85, 0xf3, 0xfc, 0xce, 0xf3, 0x9c, 0x09, 0x4f, 0x42, 0x9c, 0x84, 0x0f, 0xb8, 0x9c, 0x78,
-	0x42, 0xb2, 0x60, 0x0c, 0xde, 0xb4, 0xe3, 0x45, 0x90, 0x00, 0x72, 0x74, 0x53, 0x29, 0x94, 0xa0,
-	0x55, 0xa3, 0xbb, 0x46, 0x77, 0xa7, 0x9d, 0xda, 0xff, 0x91, 0x88, 0x84, 0x16, 0xbd, 0xec, 0x93,
-	0xe1, 0x6a, 0xf5, 0x82, 0x4f, 0xbe, 0x61, 0x64, 0x27, 0x10, 0x18, 0x0b, 0xf4, 0x06, 0x0c, 0x33,
-	0x71, 0x00, 0x8a, 0x75, 0xbc, 0x40, 0xf0, 0xc4, 0xe8, 0xad, 0xaf, 0x9b, 0x64, 0xf7, 0xa9, 0x09,
-	0x7e, 0xa5, 0x98, 0x02, 0xfa, 0x88, 0x6c, 0xa5, 0x4c, 0xb2, 0x18, 0x6d, 0xab, 0x69, 0xb5, 0xcb,
-	0x87, 0xb6, 0xbb, 0x7a, 0x88, 0xdb, 0xd3, 0x7a, 0x77, 0xe3, 0xfc, 0xb2, 0x51, 0xf2, 0x73, 0x9a,
-	0xbe, 0x25, 0x74, 0x08, 0x10, 0x82, 0xec, 0x87, 0x30, 0x86, 0x88, 0x29, 0x2e, 0x12, 0xb4, 0xd7,
-	0x9a, 0xeb, 0xed, 0xf2, 0x61, 0xab, 0xe8, 0x71, 0xaa, 0xd9, 0xc7, 0xd7, 0x68, 0xee, 0xb6, 0x3f,
-	0x5c, 0x99, 0x23, 0x1d, 0x92, 0x3d, 0x38, 0x0b, 0x46, 0x2c, 0x89, 0xa0, 0x2f, 0x99, 0x02, 0xb4,
-	0xd7, 0xb5, 0xe9, 0xbd, 0xa2, 0xe9, 0x93, 0x9c, 0xf3, 0x99, 0x82, 0xd7, 0x93, 0x74, 0x0c, 0xdd,
-	0x5a, 0xe6, 0xfa, 0xe5, 0x47, 0x83, 0x16, 0x24, 0xf4, 0x2b, 0xb0, 0x34, 0x43, 0xfa, 0x8c, 0x54,
-	0x62, 0x8e, 0xd8, 0x0f, 0xc4, 0x24, 0x51, 0x20, 0xd1, 0xde, 0xd0, 0x31, 0xf5, 0x62, 0xcc, 0x73,
-	0x8e, 0x78, 0x62, 0xa8, 0xfc, 0xec, 0xdd, 0x78, 0x31, 0x42, 0xfa, 0x89, 0x34, 0x59, 0x14, 0xc9,
-	0xec, 0x05, 0xd0, 0xff, 0xed, 0xf6, 0x7e, 0x2a, 0x61, 0x2a, 0xb2, 0x37, 0x6c, 0x6a, 0x73, 0xb7,
-	0x68, 0x7e, 0x7c, 0xb5, 0xb9, 0x7c, 0x71, 0xcf, 0xac, 0xe5, 0x69, 0x75, 0xf6, 0x07, 0x06, 0xa9,
-	0x22, 0xf5, 0xdb, 0xe2, 0x4d, 0xf6, 0x96, 0xce, 0x3e, 0xf8, 0xc7, 0xec, 0x37, 0x8b, 0xe0, 0x1a,
-	0xbb, 0x0d, 0x40, 0xfa, 0x92, 0x6c, 0xa6, 0x8c, 0x4b, 0xb4, 0xb7, 0x9b, 0xeb, 0xed, 0x9d, 0xee,
-	0x51, 0xb6, 0xf0, 0xfd, 0xb2, 0xd1, 0x89, 0xb8, 0x1a, 0x4d, 0x06, 0x6e, 0x20, 0x62, 0xef, 0x85,
-	0xce, 0x3b, 0x19, 0x31, 0x9e, 0x78, 0x79, 0x6b, 0xcf, 0xbc, 0x40, 0xc4, 0xb1, 0x48, 0x3c, 0x86,
-	0x08, 0xca, 0xed, 0x31, 0x2e, 0x7d, 0xe3, 0x43, 0x8f, 0xc8, 0xb6, 0x84, 0x0f, 0x4c, 0x86, 0x68,
-	0xff, 0xa7, 0x0f, 0xbe, 0x53, 0x3c, 0xd8, 0x37, 0x40, 0x7e, 0xde, 0x15, 0xdf, 0x1a, 0x92, 0xea,
-	0x6a, 0xbf, 0xe8, 0x7d, 0xb2, 0x97, 0xf7, 0x93, 0x85, 0xa1, 0x04, 0x34, 0xfd, 0xde, 0xf1, 0x2b,
-	0x66, 0x7a, 0x6c, 0x86, 0xf4, 0x80, 0xec, 0x4f, 0xd9, 0x98, 0x87, 0x4c, 0x89, 0x05, 0xb9, 0xa6,
-	0xc9, 0xea, 0xb5, 0x90, 0xc3, 0xad, 0xf7, 0xa4, 0xbc, 0xd4, 0x85, 0x9b, 0x77, 0xad, 0x9b, 0x77,
-	0xe9, 0x5d, 0xb2, 0xbb, 0x5c, 0x37, 0x9d, 0xb1, 0xe1, 0x97, 0x97, 0x8a, 0xd4, 0x3d, 0x3d, 0x9f,
-	0x39, 0xd6, 0xc5, 0xcc, 0xb1, 0x7e, 0xce, 0x1c, 0xeb, 0xf3, 0xdc, 0x29, 0x5d, 0xcc, 0x9d, 0xd2,
-	0xb7, 0xb9, 0x53, 0x7a, 0xf7, 0xe0, 0x6f, 0xdf, 0x6a, 0xfe, 0x6f, 0xa0, 0x3e, 0xa6, 0x80, 0x83,
-	0x2d, 0xfd, 0x53, 0x7f, 0xf8, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x56, 0x94, 0xe7, 0x7b, 0x73, 0x04,
-	0x00, 0x00,
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0xfe, 0x49, 0xbf, 0x4e, 0xda, 0x7e, 0xed, 0x88, 0x85, 0x89, 0x88, 0x13, 0x82,
+	0x90, 0x2a, 0x15, 0xd9, 0x4a, 0x91, 0x90, 0xba, 0x6c, 0x0a, 0x85, 0x0d, 0xa5, 0x72, 0x11, 0x48,
+	0x48, 0xc8, 0x9a, 0xd8, 0x13, 0x67, 0xa4, 0xd8, 0x63, 0xcd, 0x9d, 0x84, 0xb2, 0xe0, 0x1d, 0x78,
+	0x06, 0x96, 0x3c, 0x49, 0x97, 0x5d, 0x22, 0x16, 0x05, 0x35, 0x2f, 0x82, 0x3c, 0x33, 0x69, 0x4c,
+	0x9c, 0x02, 0xbb, 0xe8, 0x9e, 0xdf, 0x3d, 0xe7, 0x58, 0xb9, 0x36, 0x72, 0x52, 0xd6, 0x63, 0x62,
+	0xe4, 0x71, 0x41, 0xc2, 0x21, 0xf5, 0xc6, 0x1d, 0x2f, 0xa6, 0x29, 0x05, 0x06, 0x6e, 0x26, 0xb8,
+	0xe4, 0x78, 0x5b, 0xeb, 0xae, 0xd6, 0xdd, 0x71, 0xa7, 0x7e, 0x27, 0xe6, 0x31, 0x57, 0xa2, 0x97,
+	0xff, 0xd2, 0x5c, 0xbd, 0x51, 0xf2, 0x31, 0x1b, 0x5a, 0xbe, 0x57, 0x92, 0x41, 0x12, 0x39, 0x55,
+	0x9d, 0x90, 0x43, 0xc2, 0xc1, 0xeb, 0x11, 0xc8, 0xb5, 0x1e, 0x95, 0xa4, 0xe3, 0x85, 0x9c, 0xa5,
+	0x5a, 0x6f, 0x7f, 0xa9, 0xa2, 0x8d, 0xe7, 0xba, 0xd6, 0x59, 0xbe, 0x86, 0x9f, 0xa0, 0x6a, 0x46,
+	0x04, 0x49, 0xc0, 0xb6, 0x5a, 0xd6, 0x6e, 0x6d, 0xdf, 0x76, 0xe7, 0x6b, 0xba, 0xa7, 0x4a, 0xef,
+	0xae, 0x5c, 0x5c, 0x35, 0x2b, 0xbe, 0xa1, 0xf1, 0x5b, 0x84, 0xfb, 0x94, 0x46, 0x54, 0x04, 0x11,
+	0x1d, 0xd2, 0x98, 0x48, 0xc6, 0x53, 0xb0, 0x97, 0x5a, 0xcb, 0xbb, 0xb5, 0xfd, 0x76, 0xd9, 0xe3,
+	0x58, 0xb1, 0x4f, 0x6f, 0x50, 0xe3, 0xb6, 0xd3, 0x9f, 0x9b, 0x03, 0xee, 0xa3, 0x2d, 0x7a, 0x1e,
+	0x0e, 0x48, 0x1a, 0xd3, 0x40, 0x10, 0x49, 0xc1, 0x5e, 0x56, 0xa6, 0x0f, 0xca, 0xa6, 0xcf, 0x0c,
+	0xe7, 0x13, 0x49, 0x5f, 0x8f, 0xb2, 0x21, 0xed, 0xd6, 0x73, 0xd7, 0xaf, 0x3f, 0x9a, 0xb8, 0x24,
+	0x81, 0xbf, 0x49, 0x0b, 0x33, 0xc0, 0x2f, 0xd0, 0x66, 0xc2, 0x00, 0x82, 0x90, 0x8f, 0x52, 0x49,
+	0x05, 0xd8, 0x2b, 0x2a, 0xa6, 0x51, 0x8e, 0x79, 0xc9, 0x00, 0x8e, 0x34, 0x65, 0x6a, 0x6f, 0x24,
+	0xb3, 0x11, 0xe0, 0x4f, 0xa8, 0x45, 0xe2, 0x58, 0xe4, 0x4f, 0x40, 0x83, 0xdf, 0xba, 0x07, 0x99,
+	0xa0, 0x63, 0x9e, 0x3f, 0xc3, 0xaa, 0x32, 0x77, 0xcb, 0xe6, 0x87, 0xd3, 0xcd, 0x62, 0xe3, 0x53,
+	0xbd, 0x66, 0xd2, 0x1a, 0xe4, 0x0f, 0x0c, 0x60, 0x89, 0x1a, 0xb7, 0xc5, 0xeb, 0xec, 0xaa, 0xca,
+	0xde, 0xfb, 0xc7, 0xec, 0x37, 0xb3, 0xe0, 0x3a, 0xb9, 0x0d, 0x00, 0xfc, 0x0a, 0xad, 0x66, 0x84,
+	0x09, 0xb0, 0xd7, 0x5a, 0xcb, 0xbb, 0xeb, 0xdd, 0x83, 0x7c, 0xe1, 0xfb, 0x55, 0xb3, 0x13, 0x33,
+	0x39, 0x18, 0xf5, 0xdc, 0x90, 0x27, 0xde, 0x89, 0xca, 0x3b, 0x1a, 0x10, 0x96, 0x7a, 0xe6, 0x68,
+	0xcf, 0xbd, 0x90, 0x27, 0x09, 0x4f, 0x3d, 0x02, 0x40, 0xa5, 0x7b, 0x4a, 0x98, 0xf0, 0xb5, 0x0f,
+	0x3e, 0x40, 0x6b, 0x82, 0x7e, 0x20, 0x22, 0x02, 0xfb, 0x3f, 0x55, 0xf8, 0x6e, 0xb9, 0xb0, 0xaf,
+	0x01, 0x53, 0x6f, 0xca, 0xe3, 0x13, 0xf4, 0x7f, 0x26, 0x58, 0x48, 0x03, 0x48, 0x49, 0x06, 0x03,
+	0x2e, 0xc1, 0x5e, 0x57, 0x16, 0xcd, 0x05, 0xc7, 0x9c, 0x83, 0x67, 0x86, 0x33, 0x46, 0x5b, 0x59,
+	0x71, 0x08, 0xed, 0x3e, 0xda, 0x9e, 0xbf, 0x57, 0xfc, 0x10, 0x6d, 0x99, 0x7b, 0x27, 0x51, 0x24,
+	0x28, 0xe8, 0xf7, 0x65, 0xdd, 0xdf, 0xd4, 0xd3, 0x43, 0x3d, 0xc4, 0x7b, 0x68, 0x67, 0x4c, 0x86,
+	0x2c, 0x22, 0x92, 0xcf, 0xc8, 0x25, 0x45, 0x6e, 0xdf, 0x08, 0x06, 0x6e, 0xbf, 0x47, 0xb5, 0xc2,
+	0x6d, 0x2d, 0xde, 0xb5, 0x16, 0xef, 0xe2, 0xfb, 0x68, 0xa3, 0x78, 0xbe, 0x2a, 0x63, 0xc5, 0xaf,
+	0x15, 0x0e, 0xb3, 0x7b, 0x7c, 0x71, 0xed, 0x58, 0x97, 0xd7, 0x8e, 0xf5, 0xf3, 0xda, 0xb1, 0x3e,
+	0x4f, 0x9c, 0xca, 0xe5, 0xc4, 0xa9, 0x7c, 0x9b, 0x38, 0x95, 0x77, 0x8f, 0xfe, 0xf6, 0x2f, 0x99,
+	0x8f, 0x8b, 0xfc, 0x98, 0x51, 0xe8, 0x55, 0xd5, 0xa7, 0xe3, 0xf1, 0xaf, 0x01, 0x00, 0x52, 0xce,
+	0xe4, 0x25, 0xe1, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -295,6 +308,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriceSnapshots) > 0 {
+		for iNdEx := len(m.PriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Rewards) > 0 {
 		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -539,6 +566,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PriceSnapshots) > 0 {
+		for _, e := range m.PriceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -883,6 +916,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSnapshots = append(m.PriceSnapshots, PriceSnapshot{})
+			if err := m.PriceSnapshots[len(m.PriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
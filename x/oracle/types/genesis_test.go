@@ -3,10 +3,14 @@ package types_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
@@ -16,6 +20,45 @@ func TestGenesisValidation(t *testing.T) {
 
 	genState.Params.VotePeriod = 0
 	require.Error(t, types.ValidateGenesis(genState))
+
+	pair := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	genState = types.DefaultGenesisState()
+	genState.ExchangeRates = types.ExchangeRateTuples{{Pair: pair, ExchangeRate: sdk.NewDec(1)}}
+	genState.PriceSnapshots = []types.PriceSnapshot{{Pair: pair, Price: sdk.NewDec(1), TimestampMs: 1}}
+	require.NoError(t, types.ValidateGenesis(genState))
+
+	genState.ExchangeRates = append(genState.ExchangeRates, types.ExchangeRateTuple{Pair: pair, ExchangeRate: sdk.NewDec(2)})
+	require.Error(t, types.ValidateGenesis(genState), "duplicate exchange rate")
+
+	genState.ExchangeRates = types.ExchangeRateTuples{{Pair: pair, ExchangeRate: sdk.ZeroDec()}}
+	require.Error(t, types.ValidateGenesis(genState), "non-positive exchange rate")
+
+	genState.ExchangeRates = nil
+	genState.PriceSnapshots = append(genState.PriceSnapshots, types.PriceSnapshot{Pair: pair, Price: sdk.NewDec(2), TimestampMs: 1})
+	require.Error(t, types.ValidateGenesis(genState), "duplicate price snapshot")
+
+	genState.PriceSnapshots = []types.PriceSnapshot{{Pair: "invalid", Price: sdk.NewDec(1), TimestampMs: 1}}
+	require.Error(t, types.ValidateGenesis(genState), "invalid price snapshot pair")
+
+	notWhitelisted := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	genState.PriceSnapshots = []types.PriceSnapshot{{Pair: notWhitelisted, Price: sdk.NewDec(1), TimestampMs: 1}}
+	require.ErrorContains(t, types.ValidateGenesis(genState), "not whitelisted")
+
+	genState.PriceSnapshots = nil
+	genState.ExchangeRates = types.ExchangeRateTuples{{Pair: notWhitelisted, ExchangeRate: sdk.NewDec(1)}}
+	require.ErrorContains(t, types.ValidateGenesis(genState), "not whitelisted")
+}
+
+func TestValidatePriceSnapshotTimes(t *testing.T) {
+	genesisTime := time.Date(2023, time.September, 15, 12, 0, 0, 500_000, time.UTC)
+	genState := types.DefaultGenesisState()
+	genState.PriceSnapshots = []types.PriceSnapshot{{
+		Pair:        asset.Registry.Pair(denoms.BTC, denoms.USD),
+		Price:       sdk.NewDec(1),
+		TimestampMs: genesisTime.UnixMilli(),
+	}}
+	require.NoError(t, genState.ValidatePriceSnapshotTimes(genesisTime))
+	require.ErrorContains(t, genState.ValidatePriceSnapshotTimes(genesisTime.Add(-time.Millisecond)), "after genesis time")
 }
 
 func TestGetGenesisStateFromAppState(t *testing.T) {
@@ -24,5 +67,11 @@ func TestGetGenesisStateFromAppState(t *testing.T) {
 
 	defaultGenesisState := types.DefaultGenesisState()
 	appState[types.ModuleName] = cdc.MustMarshalJSON(defaultGenesisState)
-	require.Equal(t, *defaultGenesisState, *types.GetGenesisStateFromAppState(cdc, appState))
+	genesisState := types.GetGenesisStateFromAppState(cdc, appState)
+
+	// Empty repeated params decode from JSON as empty slices rather than nil,
+	// so the params are compared with their proto equality.
+	require.True(t, defaultGenesisState.Params.Equal(genesisState.Params))
+	genesisState.Params = defaultGenesisState.Params
+	require.Equal(t, *defaultGenesisState, *genesisState)
 }
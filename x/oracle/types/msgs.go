@@ -1,11 +1,15 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
 )
 
 // ensure Msg interface compliance at compile time
@@ -14,6 +18,7 @@ var (
 	_ sdk.Msg = &MsgAggregateExchangeRatePrevote{}
 	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
 	_ sdk.Msg = &MsgEditOracleParams{}
+	_ sdk.Msg = &MsgUpdateParams{}
 )
 
 // oracle message types
@@ -22,6 +27,7 @@ const (
 	TypeMsgAggregateExchangeRatePrevote = "aggregate_exchange_rate_prevote"
 	TypeMsgAggregateExchangeRateVote    = "aggregate_exchange_rate_vote"
 	TypeMsgEditOracleParams             = "edit_oracle_params"
+	TypeMsgUpdateParams                 = "update_params"
 )
 
 //-------------------------------------------------
@@ -220,3 +226,48 @@ func (m MsgEditOracleParams) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// ------------------------ MsgUpdateParams ------------------------
+
+func (m MsgUpdateParams) Route() string { return RouterKey }
+func (m MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+
+// ValidateBasic checks the authority address and the params, including the
+// pair whitelist, which must be non-empty and free of duplicates.
+func (m MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+	if err := m.Params.Validate(); err != nil {
+		return err
+	}
+	return ValidateWhitelist(m.Params.Whitelist)
+}
+
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateWhitelist checks that the whitelist has at least one pair, that
+// every pair is valid and that no pair is listed twice.
+func ValidateWhitelist(whitelist []asset.Pair) error {
+	if len(whitelist) == 0 {
+		return fmt.Errorf("oracle parameter Whitelist must not be empty")
+	}
+	seenPairs := make(map[asset.Pair]bool)
+	for _, pair := range whitelist {
+		if err := pair.Validate(); err != nil {
+			return fmt.Errorf("oracle parameter Whitelist Pair invalid format: %w", err)
+		}
+		if seenPairs[pair] {
+			return fmt.Errorf("oracle parameter Whitelist has duplicate pair %s", pair)
+		}
+		seenPairs[pair] = true
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 
@@ -121,3 +122,36 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgUpdateParams(t *testing.T) {
+	authority := sdk.AccAddress([]byte("addr1_______________")).String()
+	btcUsd := asset.Registry.Pair(denoms.BTC, denoms.USD)
+
+	emptyWhitelist := types.DefaultParams()
+	emptyWhitelist.Whitelist = []asset.Pair{}
+	duplicatePairs := types.DefaultParams()
+	duplicatePairs.Whitelist = []asset.Pair{btcUsd, btcUsd}
+	invalidParams := types.DefaultParams()
+	invalidParams.VotePeriod = 0
+
+	tests := []struct {
+		authority  string
+		params     types.Params
+		expectPass bool
+	}{
+		{authority, types.DefaultParams(), true},
+		{"", types.DefaultParams(), false},
+		{authority, emptyWhitelist, false},
+		{authority, duplicatePairs, false},
+		{authority, invalidParams, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.MsgUpdateParams{Authority: tc.authority, Params: tc.params}
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
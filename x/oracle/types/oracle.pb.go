@@ -30,6 +30,43 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QuorumFallback is the behavior applied to a pair's exchange rate when a vote
+// period ends without a passing ballot for that pair.
+type QuorumFallback int32
+
+const (
+	// FREEZE keeps the last exchange rate until it is older than
+	// expiration_blocks. Its staleness is tracked by DatedPrice.created_block.
+	QuorumFallback_FREEZE QuorumFallback = 0
+	// INVALIDATE deletes the exchange rate as soon as a vote
+	// period fails to reach quorum.
+	QuorumFallback_INVALIDATE QuorumFallback = 1
+	// TWAP replaces the exchange rate with the TWAP of the price
+	// snapshots over twap_lookback_window. The created_block of the last
+	// voted price is kept, so the rate still expires after expiration_blocks.
+	QuorumFallback_TWAP QuorumFallback = 2
+)
+
+var QuorumFallback_name = map[int32]string{
+	0: "FREEZE",
+	1: "INVALIDATE",
+	2: "TWAP",
+}
+
+var QuorumFallback_value = map[string]int32{
+	"FREEZE":     0,
+	"INVALIDATE": 1,
+	"TWAP":       2,
+}
+
+func (x QuorumFallback) String() string {
+	return proto.EnumName(QuorumFallback_name, int32(x))
+}
+
+func (QuorumFallback) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{0}
+}
+
 // Params defines the module parameters for the x/oracle module.
 type Params struct {
 	// VotePeriod defines the number of blocks during which voting takes place.
@@ -68,6 +105,36 @@ type Params struct {
 	// The validator fee ratio that is given to validators every epoch.
 	ValidatorFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=validator_fee_ratio,json=validatorFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_fee_ratio" yaml:"validator_fee_ratio"`
 	ExpirationBlocks  uint64                                 `protobuf:"varint,11,opt,name=expiration_blocks,json=expirationBlocks,proto3" json:"expiration_blocks,omitempty" yaml:"expiration_blocks"`
+	// QuorumFallbacks sets, per pair, what happens to the exchange rate when a
+	// vote period ends without a passing ballot. Pairs that are not listed use
+	// FREEZE.
+	QuorumFallbacks []PairQuorumFallback `protobuf:"bytes,12,rep,name=quorum_fallbacks,json=quorumFallbacks,proto3" json:"quorum_fallbacks" yaml:"quorum_fallbacks"`
+	// Amount of time price snapshots are kept for. Older snapshots are pruned
+	// when a new price is set for their pair. Zero disables pruning.
+	SnapshotRetentionWindow time.Duration `protobuf:"bytes,13,opt,name=snapshot_retention_window,json=snapshotRetentionWindow,proto3,stdduration" json:"snapshot_retention_window,omitempty" yaml:"snapshot_retention_window"`
+	// EmaSmoothings sets, per pair, the smoothing factor of the exponential
+	// moving average of the exchange rate. Pairs that are not listed use
+	// DefaultEmaSmoothing.
+	EmaSmoothings []PairEmaSmoothing `protobuf:"bytes,14,rep,name=ema_smoothings,json=emaSmoothings,proto3" json:"ema_smoothings" yaml:"ema_smoothings"`
+	// The maximum relative change between two consecutive prices of a pair. A
+	// larger change marks the pair's price as suspect for suspect_blocks, during
+	// which the exchange rate and TWAP of the pair are not served. Zero
+	// disables the check.
+	MaxPriceChangeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=max_price_change_ratio,json=maxPriceChangeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_change_ratio" yaml:"max_price_change_ratio"`
+	// Number of blocks a pair's price stays suspect after a price change larger
+	// than max_price_change_ratio. Must be positive when max_price_change_ratio
+	// is.
+	SuspectBlocks uint64 `protobuf:"varint,16,opt,name=suspect_blocks,json=suspectBlocks,proto3" json:"suspect_blocks,omitempty" yaml:"suspect_blocks"`
+	// Per-pair weights of validators in the median that sets the exchange rate
+	// of the pair, replacing their stake. Validators that are not listed for a
+	// pair with weights are left out of its median. Pairs that are not listed
+	// use the stake weighted median. Votes are rewarded or counted as misses
+	// against this median. Vote thresholds and reward weights always use stake.
+	VoterWeights []PairVoterWeights `protobuf:"bytes,17,rep,name=voter_weights,json=voterWeights,proto3" json:"voter_weights" yaml:"voter_weights"`
+	// Maximum number of price snapshots older than the snapshot retention window
+	// that are pruned in a single EndBlock, across all pairs. Zero disables
+	// EndBlock pruning.
+	MaxSnapshotPrunesPerBlock uint64 `protobuf:"varint,18,opt,name=max_snapshot_prunes_per_block,json=maxSnapshotPrunesPerBlock,proto3" json:"max_snapshot_prunes_per_block,omitempty" yaml:"max_snapshot_prunes_per_block"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -137,6 +204,235 @@ func (m *Params) GetExpirationBlocks() uint64 {
 	return 0
 }
 
+func (m *Params) GetQuorumFallbacks() []PairQuorumFallback {
+	if m != nil {
+		return m.QuorumFallbacks
+	}
+	return nil
+}
+
+func (m *Params) GetSnapshotRetentionWindow() time.Duration {
+	if m != nil {
+		return m.SnapshotRetentionWindow
+	}
+	return 0
+}
+
+func (m *Params) GetEmaSmoothings() []PairEmaSmoothing {
+	if m != nil {
+		return m.EmaSmoothings
+	}
+	return nil
+}
+
+func (m *Params) GetSuspectBlocks() uint64 {
+	if m != nil {
+		return m.SuspectBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetVoterWeights() []PairVoterWeights {
+	if m != nil {
+		return m.VoterWeights
+	}
+	return nil
+}
+
+func (m *Params) GetMaxSnapshotPrunesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxSnapshotPrunesPerBlock
+	}
+	return 0
+}
+
+// PairVoterWeights assigns explicit median weights to the voters of a pair.
+type PairVoterWeights struct {
+	Pair    github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	Weights []VoterWeight                                     `protobuf:"bytes,2,rep,name=weights,proto3" json:"weights" yaml:"weights"`
+}
+
+func (m *PairVoterWeights) Reset()         { *m = PairVoterWeights{} }
+func (m *PairVoterWeights) String() string { return proto.CompactTextString(m) }
+func (*PairVoterWeights) ProtoMessage()    {}
+func (*PairVoterWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{1}
+}
+func (m *PairVoterWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairVoterWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairVoterWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairVoterWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairVoterWeights.Merge(m, src)
+}
+func (m *PairVoterWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairVoterWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairVoterWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairVoterWeights proto.InternalMessageInfo
+
+func (m *PairVoterWeights) GetWeights() []VoterWeight {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+// VoterWeight is the median weight of a validator.
+type VoterWeight struct {
+	// validator is the Bech32 operator address of the validator
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+	Weight    uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty" yaml:"weight"`
+}
+
+func (m *VoterWeight) Reset()         { *m = VoterWeight{} }
+func (m *VoterWeight) String() string { return proto.CompactTextString(m) }
+func (*VoterWeight) ProtoMessage()    {}
+func (*VoterWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{2}
+}
+func (m *VoterWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoterWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoterWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoterWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoterWeight.Merge(m, src)
+}
+func (m *VoterWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoterWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoterWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoterWeight proto.InternalMessageInfo
+
+func (m *VoterWeight) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *VoterWeight) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// PairEmaSmoothing assigns an EMA smoothing factor to a pair.
+type PairEmaSmoothing struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	// Weight in (0, 1] given to each new exchange rate. Higher values make
+	// the EMA follow the exchange rate more closely.
+	Smoothing github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=smoothing,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"smoothing" yaml:"smoothing"`
+}
+
+func (m *PairEmaSmoothing) Reset()         { *m = PairEmaSmoothing{} }
+func (m *PairEmaSmoothing) String() string { return proto.CompactTextString(m) }
+func (*PairEmaSmoothing) ProtoMessage()    {}
+func (*PairEmaSmoothing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{3}
+}
+func (m *PairEmaSmoothing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairEmaSmoothing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairEmaSmoothing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairEmaSmoothing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairEmaSmoothing.Merge(m, src)
+}
+func (m *PairEmaSmoothing) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairEmaSmoothing) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairEmaSmoothing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairEmaSmoothing proto.InternalMessageInfo
+
+// PairQuorumFallback assigns a QuorumFallback to a pair.
+type PairQuorumFallback struct {
+	Pair     github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	Fallback QuorumFallback                                    `protobuf:"varint,2,opt,name=fallback,proto3,enum=nibiru.oracle.v1.QuorumFallback" json:"fallback,omitempty" yaml:"fallback"`
+}
+
+func (m *PairQuorumFallback) Reset()         { *m = PairQuorumFallback{} }
+func (m *PairQuorumFallback) String() string { return proto.CompactTextString(m) }
+func (*PairQuorumFallback) ProtoMessage()    {}
+func (*PairQuorumFallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{4}
+}
+func (m *PairQuorumFallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairQuorumFallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairQuorumFallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairQuorumFallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairQuorumFallback.Merge(m, src)
+}
+func (m *PairQuorumFallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairQuorumFallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairQuorumFallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairQuorumFallback proto.InternalMessageInfo
+
+func (m *PairQuorumFallback) GetFallback() QuorumFallback {
+	if m != nil {
+		return m.Fallback
+	}
+	return QuorumFallback_FREEZE
+}
+
 // Struct for aggregate prevoting on the ExchangeRateVote.
 // The purpose of aggregate prevote is to hide vote exchange rates with hash
 // which is formatted as hex string in
@@ -150,7 +446,7 @@ type AggregateExchangeRatePrevote struct {
 func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
 func (*AggregateExchangeRatePrevote) ProtoMessage() {}
 func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{1}
+	return fileDescriptor_43d45df86ea09ed4, []int{5}
 }
 func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -189,7 +485,7 @@ type AggregateExchangeRateVote struct {
 func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
 func (*AggregateExchangeRateVote) ProtoMessage() {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{2}
+	return fileDescriptor_43d45df86ea09ed4, []int{6}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,7 +523,7 @@ type ExchangeRateTuple struct {
 func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
 func (*ExchangeRateTuple) ProtoMessage() {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{3}
+	return fileDescriptor_43d45df86ea09ed4, []int{7}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -265,7 +561,7 @@ func (m *DatedPrice) Reset()         { *m = DatedPrice{} }
 func (m *DatedPrice) String() string { return proto.CompactTextString(m) }
 func (*DatedPrice) ProtoMessage()    {}
 func (*DatedPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{4}
+	return fileDescriptor_43d45df86ea09ed4, []int{8}
 }
 func (m *DatedPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -301,6 +597,80 @@ func (m *DatedPrice) GetCreatedBlock() uint64 {
 	return 0
 }
 
+// VoterPairStats tracks how a validator's votes for a pair compare with the
+// pair's tallied price. The counters and deviation statistics cover the current
+// slash window and are reset with the miss counters.
+type VoterPairStats struct {
+	// block at which the last vote of the validator for the pair was tallied
+	LastVoteBlock uint64 `protobuf:"varint,1,opt,name=last_vote_block,json=lastVoteBlock,proto3" json:"last_vote_block,omitempty"`
+	// block time, in milliseconds, at which the last vote was tallied
+	LastVoteTimeMs int64 `protobuf:"varint,2,opt,name=last_vote_time_ms,json=lastVoteTimeMs,proto3" json:"last_vote_time_ms,omitempty"`
+	// exchange rate of the last vote
+	LastExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=last_exchange_rate,json=lastExchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_exchange_rate"`
+	// relative deviation of the last vote from the tallied price
+	LastDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=last_deviation,json=lastDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_deviation"`
+	// number of tallied votes in the current slash window
+	VoteCount uint64 `protobuf:"varint,5,opt,name=vote_count,json=voteCount,proto3" json:"vote_count,omitempty"`
+	// mean relative deviation of the votes in the current slash window
+	MeanDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=mean_deviation,json=meanDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mean_deviation"`
+	// largest relative deviation of the votes in the current slash window
+	MaxDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_deviation,json=maxDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_deviation"`
+}
+
+func (m *VoterPairStats) Reset()         { *m = VoterPairStats{} }
+func (m *VoterPairStats) String() string { return proto.CompactTextString(m) }
+func (*VoterPairStats) ProtoMessage()    {}
+func (*VoterPairStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{9}
+}
+func (m *VoterPairStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoterPairStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoterPairStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoterPairStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoterPairStats.Merge(m, src)
+}
+func (m *VoterPairStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoterPairStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoterPairStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoterPairStats proto.InternalMessageInfo
+
+func (m *VoterPairStats) GetLastVoteBlock() uint64 {
+	if m != nil {
+		return m.LastVoteBlock
+	}
+	return 0
+}
+
+func (m *VoterPairStats) GetLastVoteTimeMs() int64 {
+	if m != nil {
+		return m.LastVoteTimeMs
+	}
+	return 0
+}
+
+func (m *VoterPairStats) GetVoteCount() uint64 {
+	if m != nil {
+		return m.VoteCount
+	}
+	return 0
+}
+
 // Rewards defines a credit object towards validators
 // which provide prices faithfully for different pairs.
 type Rewards struct {
@@ -317,7 +687,7 @@ func (m *Rewards) Reset()         { *m = Rewards{} }
 func (m *Rewards) String() string { return proto.CompactTextString(m) }
 func (*Rewards) ProtoMessage()    {}
 func (*Rewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{5}
+	return fileDescriptor_43d45df86ea09ed4, []int{10}
 }
 func (m *Rewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,80 +738,123 @@ func (m *Rewards) GetCoins() []types.Coin {
 }
 
 func init() {
+	proto.RegisterEnum("nibiru.oracle.v1.QuorumFallback", QuorumFallback_name, QuorumFallback_value)
 	proto.RegisterType((*Params)(nil), "nibiru.oracle.v1.Params")
+	proto.RegisterType((*PairVoterWeights)(nil), "nibiru.oracle.v1.PairVoterWeights")
+	proto.RegisterType((*VoterWeight)(nil), "nibiru.oracle.v1.VoterWeight")
+	proto.RegisterType((*PairEmaSmoothing)(nil), "nibiru.oracle.v1.PairEmaSmoothing")
+	proto.RegisterType((*PairQuorumFallback)(nil), "nibiru.oracle.v1.PairQuorumFallback")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "nibiru.oracle.v1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "nibiru.oracle.v1.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "nibiru.oracle.v1.ExchangeRateTuple")
 	proto.RegisterType((*DatedPrice)(nil), "nibiru.oracle.v1.DatedPrice")
+	proto.RegisterType((*VoterPairStats)(nil), "nibiru.oracle.v1.VoterPairStats")
 	proto.RegisterType((*Rewards)(nil), "nibiru.oracle.v1.Rewards")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/oracle.proto", fileDescriptor_43d45df86ea09ed4) }
 
 var fileDescriptor_43d45df86ea09ed4 = []byte{
-	// 979 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe6, 0x57, 0xe3, 0xb1, 0x53, 0x92, 0x69, 0x0a, 0x9b, 0x50, 0xbc, 0x61, 0x2a, 0x55,
-	0x39, 0x94, 0x5d, 0xa5, 0x80, 0x10, 0x91, 0x38, 0xb0, 0x0d, 0x81, 0x48, 0x05, 0x59, 0xa3, 0x0a,
-	0x24, 0x84, 0x64, 0xcd, 0xee, 0x4e, 0xd6, 0x23, 0xef, 0xee, 0x58, 0x33, 0xeb, 0x38, 0x91, 0x10,
-	0x67, 0x8e, 0x3d, 0xa1, 0x1e, 0x73, 0xe6, 0x8e, 0xc4, 0x9f, 0xd0, 0x1b, 0x3d, 0xa2, 0x1e, 0xb6,
-	0x28, 0xe1, 0x80, 0x10, 0x27, 0xff, 0x05, 0x68, 0x66, 0xc7, 0xf1, 0x26, 0xb6, 0x04, 0x01, 0xf5,
-	0x64, 0xbf, 0xf7, 0xcd, 0x7c, 0xef, 0x7b, 0x3f, 0x66, 0x66, 0xc1, 0x5b, 0x19, 0x0b, 0x98, 0x18,
-	0x78, 0x5c, 0x90, 0x30, 0xa1, 0xde, 0xd1, 0x8e, 0xf9, 0xe7, 0xf6, 0x05, 0xcf, 0x39, 0x5c, 0x2d,
-	0x61, 0xd7, 0x38, 0x8f, 0x76, 0x36, 0xd7, 0x63, 0x1e, 0x73, 0x0d, 0x7a, 0xea, 0x5f, 0xb9, 0x6e,
-	0xb3, 0x15, 0x73, 0x1e, 0x27, 0xd4, 0xd3, 0x56, 0x30, 0x38, 0xf4, 0xa2, 0x81, 0x20, 0x39, 0xe3,
-	0xd9, 0x18, 0x0f, 0xb9, 0x4c, 0xb9, 0xf4, 0x02, 0x22, 0x55, 0x90, 0x80, 0xe6, 0x64, 0xc7, 0x0b,
-	0x39, 0x33, 0x38, 0xfa, 0x65, 0x19, 0x2c, 0xb5, 0x89, 0x20, 0xa9, 0x84, 0x1f, 0x80, 0xc6, 0x11,
-	0xcf, 0x69, 0xa7, 0x4f, 0x05, 0xe3, 0x91, 0x6d, 0x6d, 0x59, 0xdb, 0x0b, 0xfe, 0xeb, 0xa3, 0xc2,
-	0x81, 0x27, 0x24, 0x4d, 0x76, 0x51, 0x05, 0x44, 0x18, 0x28, 0xab, 0xad, 0x0d, 0x98, 0x81, 0x9b,
-	0x1a, 0xcb, 0xbb, 0x82, 0xca, 0x2e, 0x4f, 0x22, 0x7b, 0x6e, 0xcb, 0xda, 0xae, 0xfb, 0x9f, 0x3e,
-	0x2b, 0x9c, 0xda, 0x8b, 0xc2, 0xb9, 0x17, 0xb3, 0xbc, 0x3b, 0x08, 0xdc, 0x90, 0xa7, 0x9e, 0x91,
-	0x53, 0xfe, 0xbc, 0x23, 0xa3, 0x9e, 0x97, 0x9f, 0xf4, 0xa9, 0x74, 0xf7, 0x68, 0x38, 0x2a, 0x9c,
-	0xdb, 0x95, 0x48, 0x17, 0x6c, 0x08, 0xaf, 0x28, 0xc7, 0xe3, 0xb1, 0x0d, 0x29, 0x68, 0x08, 0x3a,
-	0x24, 0x22, 0xea, 0x04, 0x24, 0x8b, 0xec, 0x79, 0x1d, 0x6c, 0xef, 0xda, 0xc1, 0x4c, 0x5a, 0x15,
-	0x2a, 0x84, 0x41, 0x69, 0xf9, 0x24, 0x8b, 0x60, 0x0c, 0xea, 0xc3, 0x2e, 0xcb, 0x69, 0xc2, 0x64,
-	0x6e, 0x2f, 0x6c, 0xcd, 0x6f, 0xd7, 0xfd, 0x83, 0x17, 0x85, 0xb3, 0x53, 0x09, 0xf0, 0x85, 0x6e,
-	0xd2, 0xc3, 0x2e, 0x61, 0x99, 0x67, 0xfa, 0x79, 0xec, 0x85, 0x3c, 0x4d, 0x79, 0xe6, 0x11, 0x29,
-	0x69, 0xee, 0xb6, 0x09, 0x13, 0xa3, 0xc2, 0x59, 0x2d, 0x63, 0x5d, 0xf0, 0x21, 0x3c, 0xe1, 0x56,
-	0xf5, 0x93, 0x09, 0x91, 0xdd, 0xce, 0xa1, 0x20, 0xa1, 0xea, 0x9d, 0xbd, 0xf8, 0xff, 0xea, 0x77,
-	0x99, 0x0d, 0xe1, 0x15, 0xed, 0xd8, 0x37, 0x36, 0xdc, 0x05, 0xcd, 0x72, 0xc5, 0x90, 0x65, 0x11,
-	0x1f, 0xda, 0x4b, 0xba, 0xd3, 0x6f, 0x8c, 0x0a, 0xe7, 0x56, 0x75, 0x7f, 0x89, 0x22, 0xdc, 0xd0,
-	0xe6, 0x57, 0xda, 0x82, 0xdf, 0x81, 0xf5, 0x94, 0x65, 0x9d, 0x23, 0x92, 0xb0, 0x48, 0x0d, 0xc3,
-	0x98, 0xe3, 0x86, 0x56, 0xfc, 0xf9, 0xb5, 0x15, 0xbf, 0x59, 0x46, 0x9c, 0xc5, 0x89, 0xf0, 0x5a,
-	0xca, 0xb2, 0x2f, 0x95, 0xb7, 0x4d, 0x85, 0x89, 0xff, 0x83, 0x05, 0xd6, 0xf3, 0x21, 0xe9, 0x77,
-	0x12, 0xce, 0x7b, 0x01, 0x09, 0x7b, 0x63, 0x01, 0xcb, 0x5b, 0xd6, 0x76, 0xe3, 0xc1, 0x86, 0x5b,
-	0x9e, 0x07, 0x77, 0x7c, 0x1e, 0xdc, 0x3d, 0x73, 0x1e, 0xfc, 0x03, 0xa5, 0xed, 0xcf, 0xc2, 0x69,
-	0xcd, 0xda, 0x7e, 0x9f, 0xa7, 0x2c, 0xa7, 0x69, 0x3f, 0x3f, 0x99, 0x68, 0x9a, 0xb5, 0x0e, 0x3d,
-	0x7d, 0xe9, 0x58, 0x18, 0x2a, 0xe8, 0x91, 0x41, 0x8c, 0xb0, 0xf7, 0x00, 0xd0, 0x49, 0xf0, 0x9c,
-	0x0a, 0x69, 0xd7, 0x75, 0x49, 0x6f, 0x8f, 0x0a, 0x67, 0xad, 0x92, 0xa0, 0xc6, 0x10, 0xae, 0xab,
-	0xb4, 0xf4, 0x7f, 0xf8, 0x2d, 0xb8, 0xa5, 0xd3, 0x26, 0x39, 0x17, 0x9d, 0x43, 0x4a, 0x3b, 0x5a,
-	0xac, 0x0d, 0x74, 0x35, 0x1f, 0x5d, 0xbb, 0x9a, 0x9b, 0xe6, 0xfc, 0x4c, 0x53, 0x22, 0xbc, 0x76,
-	0xe1, 0xdd, 0xa7, 0x14, 0x2b, 0x1f, 0x3c, 0x00, 0x6b, 0xf4, 0xb8, 0xcf, 0xca, 0x02, 0x75, 0x82,
-	0x84, 0x87, 0x3d, 0x69, 0x37, 0xb4, 0xf4, 0x3b, 0xa3, 0xc2, 0xb1, 0x4b, 0xb6, 0xa9, 0x25, 0x08,
-	0xaf, 0x4e, 0x7c, 0xbe, 0x76, 0xed, 0x2e, 0x3f, 0x3d, 0x75, 0x6a, 0x7f, 0x9c, 0x3a, 0x16, 0xfa,
-	0xc9, 0x02, 0x77, 0x3e, 0x8e, 0x63, 0x41, 0x63, 0x92, 0xd3, 0x4f, 0x8e, 0xc3, 0x2e, 0xc9, 0x62,
-	0x15, 0x8f, 0xb6, 0x05, 0x55, 0x15, 0x80, 0x77, 0xc1, 0x42, 0x97, 0xc8, 0xae, 0xbe, 0x60, 0xea,
-	0xfe, 0x6b, 0xa3, 0xc2, 0x69, 0x94, 0x81, 0x94, 0x17, 0x61, 0x0d, 0xc2, 0x7b, 0x60, 0x51, 0x97,
-	0xcb, 0x5c, 0x25, 0xab, 0xa3, 0xc2, 0x69, 0x4e, 0x2e, 0x07, 0x81, 0x70, 0x09, 0xeb, 0x59, 0x1e,
-	0x04, 0x29, 0xcb, 0x4b, 0x6d, 0xfa, 0x32, 0xb8, 0x3c, 0xcb, 0x15, 0x54, 0xcd, 0xb2, 0x36, 0xb5,
-	0xe8, 0xdd, 0xe6, 0xf7, 0xa7, 0x4e, 0xcd, 0xe8, 0xae, 0xa1, 0xdf, 0x2d, 0xb0, 0x31, 0x53, 0xb7,
-	0x6a, 0x15, 0x7c, 0x62, 0x81, 0x75, 0x6a, 0x9c, 0xaa, 0xa2, 0xb4, 0x93, 0x0f, 0xfa, 0x09, 0x95,
-	0xb6, 0xb5, 0x35, 0xbf, 0xdd, 0x78, 0x70, 0xd7, 0xbd, 0x7a, 0x5f, 0xbb, 0x55, 0x8a, 0xc7, 0x6a,
-	0xad, 0xff, 0xa1, 0xea, 0xe7, 0x64, 0xbe, 0x66, 0xd1, 0xa1, 0x1f, 0x5f, 0x3a, 0x70, 0x6a, 0xa7,
-	0xc4, 0x90, 0x4e, 0xf9, 0xfe, 0x6d, 0x89, 0xae, 0xa4, 0xf9, 0x97, 0x05, 0xd6, 0xa6, 0x02, 0xc0,
-	0x6f, 0xc0, 0x42, 0x9f, 0x30, 0x61, 0x7a, 0xf2, 0x99, 0x19, 0xbc, 0xff, 0x74, 0xd5, 0x99, 0x66,
-	0x2a, 0x3a, 0x84, 0x35, 0x2b, 0xec, 0x81, 0x95, 0x4b, 0xc9, 0x1a, 0xc5, 0xfb, 0xd7, 0x9e, 0xef,
-	0xf5, 0x19, 0x95, 0x43, 0xb8, 0x59, 0x2d, 0xce, 0x95, 0x74, 0x7f, 0xb6, 0x00, 0xd8, 0x23, 0x39,
-	0x8d, 0xda, 0x82, 0x85, 0x74, 0x5a, 0x89, 0xf5, 0xea, 0x94, 0xc0, 0x8f, 0xc0, 0x4a, 0x28, 0xa8,
-	0x0a, 0x6e, 0x86, 0x73, 0x4e, 0x0f, 0xa7, 0x3d, 0xd9, 0x7e, 0x09, 0x46, 0xb8, 0x69, 0x6c, 0x3d,
-	0x9e, 0x48, 0x82, 0x1b, 0x58, 0xbf, 0x46, 0x12, 0xde, 0x04, 0x73, 0xcc, 0xbc, 0xc8, 0x78, 0x8e,
-	0x45, 0xf0, 0x6d, 0xd0, 0xac, 0xbc, 0xc6, 0xb2, 0x24, 0xc6, 0x8d, 0xc9, 0x9b, 0x2c, 0xe1, 0xfb,
-	0x60, 0x51, 0x3d, 0xf3, 0xd2, 0x9e, 0xd7, 0x03, 0xba, 0xe1, 0x96, 0x89, 0xb8, 0xea, 0x43, 0xc0,
-	0x35, 0x1f, 0x02, 0xee, 0x43, 0xce, 0x32, 0x7f, 0x41, 0x25, 0x8f, 0xcb, 0xd5, 0xfe, 0xfe, 0xb3,
-	0xb3, 0x96, 0xf5, 0xfc, 0xac, 0x65, 0xfd, 0x76, 0xd6, 0xb2, 0x9e, 0x9c, 0xb7, 0x6a, 0xcf, 0xcf,
-	0x5b, 0xb5, 0x5f, 0xcf, 0x5b, 0xb5, 0xaf, 0xef, 0xff, 0xd3, 0x30, 0x98, 0x2f, 0x19, 0x5d, 0xa5,
-	0x60, 0x49, 0x5f, 0xc0, 0xef, 0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x73, 0x48, 0x19, 0x08, 0xe7,
-	0x08, 0x00, 0x00,
+	// 1583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x6f, 0x1b, 0xc9,
+	0x11, 0xe6, 0x48, 0xb4, 0x24, 0x36, 0x1f, 0x22, 0x5b, 0xb2, 0x3d, 0xb2, 0x2d, 0x8e, 0xd2, 0x36,
+	0x0c, 0x39, 0x70, 0x48, 0x48, 0x79, 0x21, 0x02, 0x02, 0x44, 0xa3, 0x47, 0x22, 0xc0, 0x0f, 0xba,
+	0xa5, 0xd8, 0x80, 0x11, 0x60, 0xd2, 0x24, 0x5b, 0xe4, 0x44, 0x9c, 0x19, 0x7a, 0x7a, 0x28, 0xd1,
+	0x40, 0x90, 0x53, 0x0e, 0x39, 0xfa, 0x14, 0xf8, 0xe8, 0xb3, 0xef, 0x01, 0xf2, 0x0f, 0xd6, 0xc0,
+	0x5e, 0x7c, 0x34, 0x7c, 0xa0, 0x17, 0xd6, 0x1e, 0x16, 0x8b, 0x3d, 0x11, 0xfb, 0x03, 0x16, 0x5d,
+	0xd3, 0x43, 0x0e, 0x45, 0x79, 0xbd, 0xd2, 0x42, 0x27, 0xb1, 0xab, 0x6a, 0xbe, 0xaa, 0xfa, 0xba,
+	0xaa, 0xba, 0x5b, 0x68, 0xd1, 0xb5, 0xab, 0xb6, 0xdf, 0x29, 0x7b, 0x3e, 0xab, 0xb5, 0x78, 0xf9,
+	0x70, 0x45, 0xfd, 0x2a, 0xb5, 0x7d, 0x2f, 0xf0, 0x70, 0x3e, 0x54, 0x97, 0x94, 0xf0, 0x70, 0xe5,
+	0xda, 0x7c, 0xc3, 0x6b, 0x78, 0xa0, 0x2c, 0xcb, 0x5f, 0xa1, 0xdd, 0xb5, 0x62, 0xc3, 0xf3, 0x1a,
+	0x2d, 0x5e, 0x86, 0x55, 0xb5, 0xb3, 0x5f, 0xae, 0x77, 0x7c, 0x16, 0xd8, 0x9e, 0x1b, 0xe9, 0x6b,
+	0x9e, 0x70, 0x3c, 0x51, 0xae, 0x32, 0x21, 0x9d, 0x54, 0x79, 0xc0, 0x56, 0xca, 0x35, 0xcf, 0x56,
+	0x7a, 0xf2, 0x7d, 0x0e, 0x4d, 0x55, 0x98, 0xcf, 0x1c, 0x81, 0x7f, 0x8f, 0xd2, 0x87, 0x5e, 0xc0,
+	0xad, 0x36, 0xf7, 0x6d, 0xaf, 0xae, 0x6b, 0x4b, 0xda, 0x72, 0xd2, 0xbc, 0xd2, 0xef, 0x19, 0xf8,
+	0x39, 0x73, 0x5a, 0x6b, 0x24, 0xa6, 0x24, 0x14, 0xc9, 0x55, 0x05, 0x16, 0xd8, 0x45, 0x39, 0xd0,
+	0x05, 0x4d, 0x9f, 0x8b, 0xa6, 0xd7, 0xaa, 0xeb, 0x13, 0x4b, 0xda, 0x72, 0xca, 0xfc, 0xf3, 0x9b,
+	0x9e, 0x91, 0x78, 0xdf, 0x33, 0x6e, 0x37, 0xec, 0xa0, 0xd9, 0xa9, 0x96, 0x6a, 0x9e, 0x53, 0x56,
+	0xe1, 0x84, 0x7f, 0x7e, 0x25, 0xea, 0x07, 0xe5, 0xe0, 0x79, 0x9b, 0x8b, 0xd2, 0x26, 0xaf, 0xf5,
+	0x7b, 0xc6, 0xe5, 0x98, 0xa7, 0x01, 0x1a, 0xa1, 0x59, 0x29, 0xd8, 0x8b, 0xd6, 0x98, 0xa3, 0xb4,
+	0xcf, 0x8f, 0x98, 0x5f, 0xb7, 0xaa, 0xcc, 0xad, 0xeb, 0x93, 0xe0, 0x6c, 0xf3, 0xcc, 0xce, 0x54,
+	0x5a, 0x31, 0x28, 0x42, 0x51, 0xb8, 0x32, 0x99, 0x5b, 0xc7, 0x0d, 0x94, 0x3a, 0x6a, 0xda, 0x01,
+	0x6f, 0xd9, 0x22, 0xd0, 0x93, 0x4b, 0x93, 0xcb, 0x29, 0x73, 0xe7, 0x7d, 0xcf, 0x58, 0x89, 0x39,
+	0x78, 0x00, 0x9b, 0xb4, 0xd1, 0x64, 0xb6, 0x5b, 0x56, 0xfb, 0xd9, 0x2d, 0xd7, 0x3c, 0xc7, 0xf1,
+	0xdc, 0x32, 0x13, 0x82, 0x07, 0xa5, 0x0a, 0xb3, 0xfd, 0x7e, 0xcf, 0xc8, 0x87, 0xbe, 0x06, 0x78,
+	0x84, 0x0e, 0xb1, 0x25, 0x7f, 0xa2, 0xc5, 0x44, 0xd3, 0xda, 0xf7, 0x59, 0x4d, 0xee, 0x9d, 0x7e,
+	0xe9, 0xe7, 0xf1, 0x37, 0x8a, 0x46, 0x68, 0x16, 0x04, 0xdb, 0x6a, 0x8d, 0xd7, 0x50, 0x26, 0xb4,
+	0x38, 0xb2, 0xdd, 0xba, 0x77, 0xa4, 0x4f, 0xc1, 0x4e, 0x5f, 0xed, 0xf7, 0x8c, 0xb9, 0xf8, 0xf7,
+	0xa1, 0x96, 0xd0, 0x34, 0x2c, 0x9f, 0xc0, 0x0a, 0xff, 0x0b, 0xcd, 0x3b, 0xb6, 0x6b, 0x1d, 0xb2,
+	0x96, 0x5d, 0x97, 0xc5, 0x10, 0x61, 0x4c, 0x43, 0xc4, 0xf7, 0xcf, 0x1c, 0xf1, 0xf5, 0xd0, 0xe3,
+	0x69, 0x98, 0x84, 0x16, 0x1c, 0xdb, 0x7d, 0x2c, 0xa5, 0x15, 0xee, 0x2b, 0xff, 0xff, 0xd5, 0xd0,
+	0x7c, 0x70, 0xc4, 0xda, 0x56, 0xcb, 0xf3, 0x0e, 0xaa, 0xac, 0x76, 0x10, 0x05, 0x30, 0xb3, 0xa4,
+	0x2d, 0xa7, 0x57, 0x17, 0x4a, 0x61, 0x3f, 0x94, 0xa2, 0x7e, 0x28, 0x6d, 0xaa, 0x7e, 0x30, 0x77,
+	0x64, 0x6c, 0xdf, 0xf6, 0x8c, 0xe2, 0x69, 0x9f, 0xdf, 0xf5, 0x1c, 0x3b, 0xe0, 0x4e, 0x3b, 0x78,
+	0x3e, 0x8c, 0xe9, 0x34, 0x3b, 0xf2, 0xf2, 0x83, 0xa1, 0x51, 0x2c, 0x55, 0xf7, 0x94, 0x46, 0x05,
+	0xf6, 0x1b, 0x84, 0x20, 0x09, 0x2f, 0xe0, 0xbe, 0xd0, 0x53, 0x40, 0xe9, 0xe5, 0x7e, 0xcf, 0x28,
+	0xc4, 0x12, 0x04, 0x1d, 0xa1, 0x29, 0x99, 0x16, 0xfc, 0xc6, 0xff, 0x44, 0x73, 0x90, 0x36, 0x0b,
+	0x3c, 0xdf, 0xda, 0xe7, 0xdc, 0x82, 0x60, 0x75, 0x04, 0x6c, 0xde, 0x3b, 0x33, 0x9b, 0xd7, 0x54,
+	0xff, 0x8c, 0x43, 0x12, 0x5a, 0x18, 0x48, 0xb7, 0x39, 0xa7, 0x52, 0x86, 0x77, 0x50, 0x81, 0x77,
+	0xdb, 0x76, 0x48, 0x90, 0x55, 0x6d, 0x79, 0xb5, 0x03, 0xa1, 0xa7, 0x21, 0xf4, 0x1b, 0xfd, 0x9e,
+	0xa1, 0x87, 0x68, 0x63, 0x26, 0x84, 0xe6, 0x87, 0x32, 0x13, 0x44, 0xb8, 0x8d, 0xf2, 0xcf, 0x3a,
+	0x9e, 0xdf, 0x71, 0xac, 0x7d, 0xd6, 0x6a, 0x49, 0x5e, 0x84, 0x9e, 0x59, 0x9a, 0x5c, 0x4e, 0xaf,
+	0xde, 0x2a, 0x9d, 0x1c, 0x65, 0xd0, 0x14, 0x8f, 0xc0, 0x7a, 0x5b, 0x19, 0x9b, 0x86, 0xcc, 0xb5,
+	0xdf, 0x33, 0xae, 0x86, 0x3e, 0x4f, 0x62, 0x11, 0x3a, 0xfb, 0x6c, 0xe4, 0x03, 0x81, 0x5f, 0x6b,
+	0x68, 0x41, 0xb8, 0xac, 0x2d, 0x9a, 0x5e, 0x60, 0xf9, 0x3c, 0xe0, 0x2e, 0x84, 0xa8, 0xca, 0x21,
+	0xfb, 0xb9, 0x72, 0xd8, 0x55, 0xe5, 0x70, 0xf3, 0x93, 0x18, 0x23, 0x35, 0xb1, 0xa4, 0x3a, 0xe3,
+	0x53, 0xc6, 0x61, 0x61, 0x5c, 0x8d, 0xf4, 0x34, 0x52, 0xab, 0xea, 0x68, 0xa2, 0x1c, 0x77, 0x98,
+	0x25, 0x1c, 0xcf, 0x0b, 0x9a, 0xb6, 0xdb, 0x10, 0x7a, 0x0e, 0xc8, 0x21, 0xa7, 0x93, 0xb3, 0xe5,
+	0xb0, 0xdd, 0xc8, 0xd4, 0x5c, 0x54, 0xd4, 0xa8, 0xe6, 0x1e, 0xc5, 0x21, 0x34, 0xcb, 0x63, 0xc6,
+	0x02, 0xff, 0x5b, 0x43, 0x57, 0x1c, 0xd6, 0xb5, 0xda, 0xbe, 0x5d, 0xe3, 0x56, 0xad, 0xc9, 0xdc,
+	0x46, 0x54, 0x55, 0xb3, 0x50, 0x55, 0x0f, 0xcf, 0x5c, 0x55, 0x8b, 0xaa, 0x84, 0x4f, 0x45, 0x25,
+	0x74, 0xce, 0x61, 0xdd, 0x8a, 0x94, 0x6f, 0x80, 0x38, 0x2c, 0xad, 0x3f, 0xa1, 0x9c, 0xe8, 0x88,
+	0x36, 0xaf, 0x05, 0x51, 0x5d, 0xe5, 0xa1, 0xae, 0x16, 0x62, 0x53, 0x6a, 0x44, 0x2f, 0xa7, 0x54,
+	0x28, 0x50, 0x15, 0xc5, 0x11, 0x8c, 0x7d, 0xdf, 0x3a, 0xe2, 0x76, 0xa3, 0x19, 0x08, 0xbd, 0xf0,
+	0x63, 0x8c, 0x41, 0x3f, 0x3d, 0x09, 0x2d, 0xcd, 0x1b, 0x8a, 0xb1, 0xf9, 0xe1, 0x71, 0x32, 0x80,
+	0x21, 0x34, 0x73, 0x18, 0xb3, 0xc5, 0xff, 0x40, 0x8b, 0x32, 0xb1, 0xc1, 0xc6, 0xb6, 0xfd, 0x8e,
+	0xcb, 0x05, 0x8c, 0x21, 0x08, 0x4c, 0xc7, 0x10, 0xf7, 0x72, 0xbf, 0x67, 0xdc, 0x1a, 0xf2, 0xf0,
+	0x49, 0x73, 0x42, 0x17, 0x1c, 0xd6, 0xdd, 0x55, 0xea, 0x0a, 0x68, 0x2b, 0xdc, 0x87, 0x9c, 0xd6,
+	0x66, 0x5e, 0xbe, 0x32, 0x12, 0xdf, 0xbc, 0x32, 0x34, 0xf2, 0x85, 0x86, 0xf2, 0x27, 0xc3, 0xc6,
+	0x7f, 0x43, 0xc9, 0x36, 0xb3, 0x7d, 0x38, 0x79, 0x53, 0xe6, 0x5f, 0xd4, 0x3e, 0x9d, 0xeb, 0xbc,
+	0x49, 0x87, 0xa1, 0x4a, 0x38, 0x42, 0x01, 0x15, 0x3f, 0x44, 0xd3, 0x11, 0x93, 0x13, 0xc0, 0xe4,
+	0xe2, 0x38, 0x93, 0xb1, 0x70, 0xcc, 0x2b, 0x8a, 0xc4, 0x9c, 0x3a, 0xba, 0x22, 0xfa, 0x22, 0x94,
+	0xb5, 0x24, 0x64, 0xe2, 0xa3, 0x74, 0xec, 0x2b, 0xbc, 0x8a, 0x52, 0x83, 0x39, 0xa3, 0x12, 0x99,
+	0x1f, 0x9e, 0x7f, 0x03, 0x15, 0xa1, 0x43, 0x33, 0x7c, 0x07, 0x4d, 0x85, 0x98, 0x70, 0x6f, 0x48,
+	0x9a, 0x85, 0x7e, 0xcf, 0xc8, 0xc6, 0xbd, 0x12, 0xaa, 0x0c, 0x94, 0xcf, 0x77, 0x8a, 0xbd, 0x78,
+	0x9b, 0x5c, 0x30, 0x7b, 0x7f, 0x47, 0xa9, 0x41, 0xd3, 0xa9, 0xeb, 0x8d, 0x79, 0xe6, 0x46, 0x52,
+	0x2c, 0x0c, 0x80, 0x08, 0x1d, 0x82, 0xaa, 0xd4, 0xbe, 0xd4, 0x10, 0x1e, 0x1f, 0x8f, 0x17, 0x9c,
+	0xdc, 0x23, 0x34, 0x13, 0x4d, 0x5a, 0xc8, 0x2d, 0xb7, 0xba, 0x34, 0x5e, 0x1b, 0x27, 0x06, 0xf6,
+	0x5c, 0xbf, 0x67, 0xcc, 0x86, 0x50, 0xd1, 0xb7, 0x84, 0x0e, 0x60, 0x54, 0x36, 0xff, 0xd3, 0xd0,
+	0x8d, 0xf5, 0x46, 0xc3, 0xe7, 0x0d, 0x16, 0xf0, 0xad, 0x6e, 0x2d, 0x1a, 0x10, 0xbc, 0xe2, 0x73,
+	0xd9, 0x85, 0xf8, 0x26, 0x4a, 0x36, 0x99, 0x68, 0xaa, 0xbc, 0x66, 0x87, 0xe1, 0x49, 0x29, 0xa1,
+	0xa0, 0xc4, 0xb7, 0xd1, 0x25, 0x68, 0x59, 0xc5, 0x7b, 0xbe, 0xdf, 0x33, 0x32, 0xb1, 0xce, 0x26,
+	0x34, 0x54, 0xc3, 0xbd, 0xa6, 0x53, 0x75, 0x6c, 0x35, 0x52, 0xf4, 0xc9, 0xb1, 0x7b, 0x4d, 0x4c,
+	0x2b, 0xef, 0x35, 0xb0, 0x0c, 0x5b, 0x33, 0xf3, 0x9f, 0x57, 0x46, 0x42, 0xb5, 0x67, 0x82, 0x7c,
+	0xad, 0xa1, 0x85, 0x53, 0xe3, 0x96, 0xa5, 0x8e, 0x5f, 0x68, 0x68, 0x9e, 0x77, 0x87, 0x43, 0x90,
+	0x5b, 0x41, 0xa7, 0xdd, 0xe2, 0x42, 0xd7, 0xa0, 0xaf, 0x6e, 0x8e, 0x73, 0x17, 0x87, 0xd8, 0x93,
+	0xb6, 0xe6, 0x1f, 0x54, 0x77, 0x5d, 0x8f, 0xce, 0xd8, 0x71, 0x38, 0xf2, 0xfa, 0x83, 0x81, 0xc7,
+	0xbe, 0x14, 0x14, 0xf3, 0x31, 0xd9, 0x4f, 0xa5, 0xe8, 0x44, 0x9a, 0xdf, 0x69, 0xa8, 0x30, 0xe6,
+	0xe0, 0x82, 0x6b, 0xed, 0x00, 0x65, 0x47, 0x92, 0x55, 0x11, 0x6f, 0x9f, 0xb9, 0x99, 0xe6, 0x4f,
+	0x61, 0x8e, 0xd0, 0x4c, 0x9c, 0x9c, 0x13, 0xe9, 0xfe, 0x5f, 0x43, 0x68, 0x93, 0x05, 0xbc, 0x0e,
+	0xa7, 0xd5, 0x78, 0x24, 0xda, 0xc5, 0x45, 0x82, 0xff, 0x88, 0xb2, 0x35, 0x9f, 0x4b, 0xe7, 0xaa,
+	0x38, 0xc3, 0x51, 0xa7, 0x0f, 0x3f, 0x1f, 0x51, 0x13, 0x9a, 0x51, 0x6b, 0x28, 0x4f, 0x72, 0x3c,
+	0x89, 0x72, 0x30, 0x66, 0x25, 0xb1, 0xbb, 0x01, 0x0b, 0xe4, 0x96, 0xcf, 0xb6, 0x98, 0x08, 0xe0,
+	0x56, 0xa9, 0x30, 0xe1, 0xc9, 0x46, 0xb3, 0x52, 0x2c, 0x8d, 0xe1, 0x53, 0x7c, 0x07, 0x15, 0x86,
+	0x76, 0x81, 0xed, 0x70, 0xcb, 0x11, 0xe0, 0x7d, 0x92, 0xe6, 0x22, 0xcb, 0x3d, 0xdb, 0xe1, 0xf7,
+	0xe5, 0x01, 0x84, 0xc1, 0x74, 0x94, 0x96, 0xf0, 0x7d, 0x55, 0x3a, 0x1b, 0x2d, 0x34, 0x2f, 0x91,
+	0xe2, 0xc5, 0x85, 0xff, 0x8a, 0xc0, 0x9f, 0x55, 0xe7, 0x87, 0x36, 0xdc, 0xc1, 0xf4, 0xe4, 0xb9,
+	0x90, 0x21, 0xbf, 0xcd, 0x08, 0x04, 0x2f, 0x22, 0x78, 0x8b, 0x5a, 0x35, 0xaf, 0xe3, 0x06, 0xf0,
+	0x72, 0x4a, 0xd2, 0x94, 0x94, 0x6c, 0x48, 0x81, 0xf4, 0xea, 0x70, 0xe6, 0xc6, 0xbc, 0x4e, 0x9d,
+	0xcf, 0xab, 0x44, 0x19, 0x7a, 0xdd, 0x45, 0x59, 0x79, 0x0f, 0x18, 0xa2, 0x4e, 0x9f, 0x0b, 0x35,
+	0xe3, 0xb0, 0xee, 0x00, 0x94, 0x08, 0x34, 0x4d, 0xe1, 0xfd, 0x29, 0x70, 0x0e, 0x4d, 0xd8, 0xea,
+	0x0d, 0x4e, 0x27, 0xec, 0x3a, 0xfe, 0x05, 0xca, 0xc4, 0xde, 0xdf, 0xe1, 0x06, 0x26, 0x69, 0x7a,
+	0xf8, 0x0a, 0x17, 0xf8, 0xb7, 0xe8, 0x92, 0x7c, 0xd8, 0x0b, 0x7d, 0x12, 0xc6, 0xd0, 0x42, 0x29,
+	0xf4, 0x58, 0x92, 0x4f, 0xff, 0x92, 0x7a, 0xfa, 0x97, 0x36, 0x3c, 0xdb, 0x35, 0x93, 0x32, 0x4a,
+	0x1a, 0x5a, 0xff, 0xf2, 0x77, 0x28, 0x77, 0xe2, 0xb0, 0x41, 0x68, 0x6a, 0x9b, 0x6e, 0x6d, 0x3d,
+	0xdd, 0xca, 0x27, 0x70, 0x0e, 0xa1, 0x9d, 0x07, 0x8f, 0xd7, 0xef, 0xed, 0x6c, 0xae, 0xef, 0x6d,
+	0xe5, 0x35, 0x3c, 0x83, 0x92, 0x7b, 0x4f, 0xd6, 0x2b, 0xf9, 0x09, 0x73, 0xfb, 0xcd, 0xc7, 0xa2,
+	0xf6, 0xf6, 0x63, 0x51, 0xfb, 0xea, 0x63, 0x51, 0x7b, 0x71, 0x5c, 0x4c, 0xbc, 0x3d, 0x2e, 0x26,
+	0xde, 0x1d, 0x17, 0x13, 0x4f, 0xef, 0x7e, 0x6e, 0x54, 0xa8, 0xff, 0x79, 0x00, 0x0d, 0xd5, 0x29,
+	0xb8, 0x9b, 0xff, 0xfa, 0x87, 0x01, 0x00, 0x66, 0xa9, 0x1b, 0xe2, 0x11, 0x11, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -501,78 +914,303 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ExpirationBlocks != that1.ExpirationBlocks {
 		return false
 	}
-	return true
-}
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExpirationBlocks != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExpirationBlocks))
-		i--
-		dAtA[i] = 0x58
+	if len(this.QuorumFallbacks) != len(that1.QuorumFallbacks) {
+		return false
 	}
-	{
-		size := m.ValidatorFeeRatio.Size()
-		i -= size
-		if _, err := m.ValidatorFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	for i := range this.QuorumFallbacks {
+		if !this.QuorumFallbacks[i].Equal(&that1.QuorumFallbacks[i]) {
+			return false
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x52
-	if m.MinVoters != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
-		i--
-		dAtA[i] = 0x48
+	if this.SnapshotRetentionWindow != that1.SnapshotRetentionWindow {
+		return false
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapLookbackWindow):])
-	if err1 != nil {
-		return 0, err1
+	if len(this.EmaSmoothings) != len(that1.EmaSmoothings) {
+		return false
 	}
-	i -= n1
-	i = encodeVarintOracle(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.MinValidPerWindow.Size()
-		i -= size
-		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	for i := range this.EmaSmoothings {
+		if !this.EmaSmoothings[i].Equal(&that1.EmaSmoothings[i]) {
+			return false
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x3a
-	if m.SlashWindow != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.SlashWindow))
-		i--
-		dAtA[i] = 0x30
+	if !this.MaxPriceChangeRatio.Equal(that1.MaxPriceChangeRatio) {
+		return false
 	}
-	{
-		size := m.SlashFraction.Size()
-		i -= size
-		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	if this.SuspectBlocks != that1.SuspectBlocks {
+		return false
+	}
+	if len(this.VoterWeights) != len(that1.VoterWeights) {
+		return false
+	}
+	for i := range this.VoterWeights {
+		if !this.VoterWeights[i].Equal(&that1.VoterWeights[i]) {
+			return false
+		}
+	}
+	if this.MaxSnapshotPrunesPerBlock != that1.MaxSnapshotPrunesPerBlock {
+		return false
+	}
+	return true
+}
+func (this *PairVoterWeights) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PairVoterWeights)
+	if !ok {
+		that2, ok := that.(PairVoterWeights)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pair.Equal(that1.Pair) {
+		return false
+	}
+	if len(this.Weights) != len(that1.Weights) {
+		return false
+	}
+	for i := range this.Weights {
+		if !this.Weights[i].Equal(&that1.Weights[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *VoterWeight) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VoterWeight)
+	if !ok {
+		that2, ok := that.(VoterWeight)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	if this.Weight != that1.Weight {
+		return false
+	}
+	return true
+}
+func (this *PairEmaSmoothing) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PairEmaSmoothing)
+	if !ok {
+		that2, ok := that.(PairEmaSmoothing)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pair.Equal(that1.Pair) {
+		return false
+	}
+	if !this.Smoothing.Equal(that1.Smoothing) {
+		return false
+	}
+	return true
+}
+func (this *PairQuorumFallback) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PairQuorumFallback)
+	if !ok {
+		that2, ok := that.(PairQuorumFallback)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pair.Equal(that1.Pair) {
+		return false
+	}
+	if this.Fallback != that1.Fallback {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSnapshotPrunesPerBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxSnapshotPrunesPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.VoterWeights) > 0 {
+		for iNdEx := len(m.VoterWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoterWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.SuspectBlocks != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SuspectBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size := m.MaxPriceChangeRatio.Size()
+		i -= size
+		if _, err := m.MaxPriceChangeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.EmaSmoothings) > 0 {
+		for iNdEx := len(m.EmaSmoothings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EmaSmoothings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SnapshotRetentionWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SnapshotRetentionWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x6a
+	if len(m.QuorumFallbacks) > 0 {
+		for iNdEx := len(m.QuorumFallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuorumFallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ExpirationBlocks != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExpirationBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.ValidatorFeeRatio.Size()
+		i -= size
+		if _, err := m.ValidatorFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x48
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapLookbackWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MinValidPerWindow.Size()
+		i -= size
+		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.SlashWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SlashWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x2a
@@ -618,7 +1256,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
+func (m *PairVoterWeights) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -628,39 +1266,44 @@ func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
+func (m *PairVoterWeights) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PairVoterWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SubmitBlock != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.SubmitBlock))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Weights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *AggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
+func (m *VoterWeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -670,7 +1313,165 @@ func (m *AggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AggregateExchangeRateVote) MarshalTo(dAtA []byte) (int, error) {
+func (m *VoterWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoterWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairEmaSmoothing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairEmaSmoothing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairEmaSmoothing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Smoothing.Size()
+		i -= size
+		if _, err := m.Smoothing.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PairQuorumFallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairQuorumFallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairQuorumFallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fallback != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Fallback))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmitBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SubmitBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateExchangeRateVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -785,6 +1586,84 @@ func (m *DatedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoterPairStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoterPairStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoterPairStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxDeviation.Size()
+		i -= size
+		if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MeanDeviation.Size()
+		i -= size
+		if _, err := m.MeanDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.VoteCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VoteCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.LastDeviation.Size()
+		i -= size
+		if _, err := m.LastDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LastExchangeRate.Size()
+		i -= size
+		if _, err := m.LastExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LastVoteTimeMs != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastVoteTimeMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastVoteBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastVoteBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Rewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -879,49 +1758,71 @@ func (m *Params) Size() (n int) {
 	if m.ExpirationBlocks != 0 {
 		n += 1 + sovOracle(uint64(m.ExpirationBlocks))
 	}
+	if len(m.QuorumFallbacks) > 0 {
+		for _, e := range m.QuorumFallbacks {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SnapshotRetentionWindow)
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.EmaSmoothings) > 0 {
+		for _, e := range m.EmaSmoothings {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.MaxPriceChangeRatio.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SuspectBlocks != 0 {
+		n += 2 + sovOracle(uint64(m.SuspectBlocks))
+	}
+	if len(m.VoterWeights) > 0 {
+		for _, e := range m.VoterWeights {
+			l = e.Size()
+			n += 2 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.MaxSnapshotPrunesPerBlock != 0 {
+		n += 2 + sovOracle(uint64(m.MaxSnapshotPrunesPerBlock))
+	}
 	return n
 }
 
-func (m *AggregateExchangeRatePrevote) Size() (n int) {
+func (m *PairVoterWeights) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	if m.SubmitBlock != 0 {
-		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	l = m.Pair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *AggregateExchangeRateVote) Size() (n int) {
+func (m *VoterWeight) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ExchangeRateTuples) > 0 {
-		for _, e := range m.ExchangeRateTuples {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
-	}
-	l = len(m.Voter)
+	l = len(m.Validator)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	if m.Weight != 0 {
+		n += 1 + sovOracle(uint64(m.Weight))
+	}
 	return n
 }
 
-func (m *ExchangeRateTuple) Size() (n int) {
+func (m *PairEmaSmoothing) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -929,36 +1830,128 @@ func (m *ExchangeRateTuple) Size() (n int) {
 	_ = l
 	l = m.Pair.Size()
 	n += 1 + l + sovOracle(uint64(l))
-	l = m.ExchangeRate.Size()
+	l = m.Smoothing.Size()
 	n += 1 + l + sovOracle(uint64(l))
 	return n
 }
 
-func (m *DatedPrice) Size() (n int) {
+func (m *PairQuorumFallback) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ExchangeRate.Size()
+	l = m.Pair.Size()
 	n += 1 + l + sovOracle(uint64(l))
-	if m.CreatedBlock != 0 {
-		n += 1 + sovOracle(uint64(m.CreatedBlock))
+	if m.Fallback != 0 {
+		n += 1 + sovOracle(uint64(m.Fallback))
 	}
 	return n
 }
 
-func (m *Rewards) Size() (n int) {
+func (m *AggregateExchangeRatePrevote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovOracle(uint64(m.Id))
-	}
-	if m.VotePeriods != 0 {
-		n += 1 + sovOracle(uint64(m.VotePeriods))
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.SubmitBlock != 0 {
+		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRateTuples) > 0 {
+		for _, e := range m.ExchangeRateTuples {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeRateTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *DatedPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.CreatedBlock != 0 {
+		n += 1 + sovOracle(uint64(m.CreatedBlock))
+	}
+	return n
+}
+
+func (m *VoterPairStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastVoteBlock != 0 {
+		n += 1 + sovOracle(uint64(m.LastVoteBlock))
+	}
+	if m.LastVoteTimeMs != 0 {
+		n += 1 + sovOracle(uint64(m.LastVoteTimeMs))
+	}
+	l = m.LastExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.LastDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.VoteCount != 0 {
+		n += 1 + sovOracle(uint64(m.VoteCount))
+	}
+	l = m.MeanDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MaxDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *Rewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOracle(uint64(m.Id))
+	}
+	if m.VotePeriods != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriods))
 	}
 	if len(m.Coins) > 0 {
 		for _, e := range m.Coins {
@@ -966,16 +1959,588 @@ func (m *Rewards) Size() (n int) {
 			n += 1 + l + sovOracle(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_NibiruChain_nibiru_x_common_asset.Pair
+			m.Whitelist = append(m.Whitelist, v)
+			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+			}
+			m.MinVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlocks", wireType)
+			}
+			m.ExpirationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumFallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuorumFallbacks = append(m.QuorumFallbacks, PairQuorumFallback{})
+			if err := m.QuorumFallbacks[len(m.QuorumFallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetentionWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SnapshotRetentionWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaSmoothings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmaSmoothings = append(m.EmaSmoothings, PairEmaSmoothing{})
+			if err := m.EmaSmoothings[len(m.EmaSmoothings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChangeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceChangeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspectBlocks", wireType)
+			}
+			m.SuspectBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuspectBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterWeights = append(m.VoterWeights, PairVoterWeights{})
+			if err := m.VoterWeights[len(m.VoterWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSnapshotPrunesPerBlock", wireType)
+			}
+			m.MaxSnapshotPrunesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSnapshotPrunesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PairVoterWeights) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -998,34 +2563,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PairVoterWeights: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PairVoterWeights: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
-			}
-			m.VotePeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VotePeriod |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1053,15 +2599,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1071,65 +2617,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Weights = append(m.Weights, VoterWeight{})
+			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoterWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_NibiruChain_nibiru_x_common_asset.Pair
-			m.Whitelist = append(m.Whitelist, v)
-			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoterWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoterWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1157,15 +2717,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			m.SlashWindow = 0
+			m.Weight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1175,14 +2733,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashWindow |= uint64(b&0x7F) << shift
+				m.Weight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairEmaSmoothing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairEmaSmoothing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairEmaSmoothing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1210,15 +2818,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Smoothing", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1228,47 +2836,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Smoothing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MinVoters = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinVoters |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairQuorumFallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
 			}
-		case 10:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairQuorumFallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairQuorumFallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1296,15 +2936,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
 			}
-			m.ExpirationBlocks = 0
+			m.Fallback = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1314,7 +2954,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpirationBlocks |= uint64(b&0x7F) << shift
+				m.Fallback |= QuorumFallback(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1810,6 +3450,249 @@ func (m *DatedPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoterPairStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoterPairStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoterPairStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVoteBlock", wireType)
+			}
+			m.LastVoteBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastVoteBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVoteTimeMs", wireType)
+			}
+			m.LastVoteTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastVoteTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCount", wireType)
+			}
+			m.VoteCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeanDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MeanDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Rewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math"
 	time "time"
 
 	"gopkg.in/yaml.v2"
@@ -33,6 +34,9 @@ const (
 	DefaultSlashWindow      = 3600 // 2 hours
 	DefaultMinVoters        = 4    // minimum of 4 voters for a pair to become valid
 	DefaultExpirationBlocks = 900  // 30 minutes
+	DefaultSuspectBlocks    = 30   // 1 minute
+
+	DefaultMaxSnapshotPrunesPerBlock = 100
 )
 
 // Default parameter values
@@ -52,26 +56,34 @@ var (
 		// asset.Registry.Pair(denoms.SOL, denoms.USD),
 		// asset.Registry.Pair(denoms.ADA, denoms.USD),
 	}
-	DefaultSlashFraction      = sdk.NewDecWithPrec(5, 3)        // 0.5%
-	DefaultMinValidPerWindow  = sdk.NewDecWithPrec(69, 2)       // 69%
-	DefaultTwapLookbackWindow = time.Duration(15 * time.Minute) // 15 minutes
-	DefaultValidatorFeeRatio  = sdk.NewDecWithPrec(5, 2)        // 0.05%
+	DefaultSlashFraction           = sdk.NewDecWithPrec(5, 3)        // 0.5%
+	DefaultMinValidPerWindow       = sdk.NewDecWithPrec(69, 2)       // 69%
+	DefaultTwapLookbackWindow      = time.Duration(15 * time.Minute) // 15 minutes
+	DefaultValidatorFeeRatio       = sdk.NewDecWithPrec(5, 2)        // 0.05%
+	DefaultSnapshotRetentionWindow = 7 * 24 * time.Hour              // 7 days
+	DefaultEmaSmoothing            = sdk.NewDecWithPrec(1, 1)        // 10%
+	DefaultMaxPriceChangeRatio     = sdk.ZeroDec()                   // disabled
 )
 
 // DefaultParams creates default oracle module parameters
 func DefaultParams() Params {
 	return Params{
-		VotePeriod:         DefaultVotePeriod,
-		VoteThreshold:      DefaultVoteThreshold,
-		MinVoters:          DefaultMinVoters,
-		ExpirationBlocks:   DefaultExpirationBlocks,
-		RewardBand:         DefaultRewardBand,
-		Whitelist:          DefaultWhitelist,
-		SlashFraction:      DefaultSlashFraction,
-		SlashWindow:        DefaultSlashWindow,
-		MinValidPerWindow:  DefaultMinValidPerWindow,
-		TwapLookbackWindow: DefaultTwapLookbackWindow,
-		ValidatorFeeRatio:  DefaultValidatorFeeRatio,
+		VotePeriod:              DefaultVotePeriod,
+		VoteThreshold:           DefaultVoteThreshold,
+		MinVoters:               DefaultMinVoters,
+		ExpirationBlocks:        DefaultExpirationBlocks,
+		RewardBand:              DefaultRewardBand,
+		Whitelist:               DefaultWhitelist,
+		SlashFraction:           DefaultSlashFraction,
+		SlashWindow:             DefaultSlashWindow,
+		MinValidPerWindow:       DefaultMinValidPerWindow,
+		TwapLookbackWindow:      DefaultTwapLookbackWindow,
+		ValidatorFeeRatio:       DefaultValidatorFeeRatio,
+		SnapshotRetentionWindow: DefaultSnapshotRetentionWindow,
+		MaxPriceChangeRatio:     DefaultMaxPriceChangeRatio,
+		SuspectBlocks:           DefaultSuspectBlocks,
+
+		MaxSnapshotPrunesPerBlock: DefaultMaxSnapshotPrunesPerBlock,
 	}
 }
 
@@ -115,10 +127,129 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter ValidatorFeeRatio must be between [0, 1]")
 	}
 
+	if p.SnapshotRetentionWindow < 0 {
+		return fmt.Errorf("oracle parameter SnapshotRetentionWindow must not be negative")
+	}
+
+	if p.SnapshotRetentionWindow != 0 && p.SnapshotRetentionWindow < p.TwapLookbackWindow {
+		return fmt.Errorf("oracle parameter SnapshotRetentionWindow must be zero or at least TwapLookbackWindow")
+	}
+
+	if !p.MaxPriceChangeRatio.IsNil() && p.MaxPriceChangeRatio.IsNegative() {
+		return fmt.Errorf("oracle parameter MaxPriceChangeRatio must not be negative")
+	}
+
+	if !p.MaxPriceChangeRatio.IsNil() && p.MaxPriceChangeRatio.IsPositive() && p.SuspectBlocks == 0 {
+		return fmt.Errorf("oracle parameter SuspectBlocks must be positive when MaxPriceChangeRatio is set")
+	}
+
 	for _, pair := range p.Whitelist {
 		if err := pair.Validate(); err != nil {
 			return fmt.Errorf("oracle parameter Whitelist Pair invalid format: %w", err)
 		}
 	}
+
+	seenPairs := make(map[asset.Pair]bool)
+	for _, qf := range p.QuorumFallbacks {
+		if err := qf.Pair.Validate(); err != nil {
+			return fmt.Errorf("oracle parameter QuorumFallbacks Pair invalid format: %w", err)
+		}
+		if seenPairs[qf.Pair] {
+			return fmt.Errorf("oracle parameter QuorumFallbacks has duplicate pair %s", qf.Pair)
+		}
+		seenPairs[qf.Pair] = true
+		if _, ok := QuorumFallback_name[int32(qf.Fallback)]; !ok {
+			return fmt.Errorf("oracle parameter QuorumFallbacks has unknown fallback %d for pair %s", qf.Fallback, qf.Pair)
+		}
+	}
+
+	seenPairs = make(map[asset.Pair]bool)
+	for _, es := range p.EmaSmoothings {
+		if err := es.Pair.Validate(); err != nil {
+			return fmt.Errorf("oracle parameter EmaSmoothings Pair invalid format: %w", err)
+		}
+		if seenPairs[es.Pair] {
+			return fmt.Errorf("oracle parameter EmaSmoothings has duplicate pair %s", es.Pair)
+		}
+		seenPairs[es.Pair] = true
+		if es.Smoothing.IsNil() || !es.Smoothing.IsPositive() || es.Smoothing.GT(sdk.OneDec()) {
+			return fmt.Errorf("oracle parameter EmaSmoothings must be in (0, 1] for pair %s", es.Pair)
+		}
+	}
+
+	seenPairs = make(map[asset.Pair]bool)
+	for _, vw := range p.VoterWeights {
+		if err := vw.Validate(); err != nil {
+			return fmt.Errorf("oracle parameter VoterWeights invalid: %w", err)
+		}
+		if seenPairs[vw.Pair] {
+			return fmt.Errorf("oracle parameter VoterWeights has duplicate pair %s", vw.Pair)
+		}
+		seenPairs[vw.Pair] = true
+	}
+	return nil
+}
+
+// QuorumFallbackFor returns the QuorumFallback configured for the given pair,
+// or FREEZE if the pair has none.
+func (p Params) QuorumFallbackFor(pair asset.Pair) QuorumFallback {
+	for _, qf := range p.QuorumFallbacks {
+		if qf.Pair == pair {
+			return qf.Fallback
+		}
+	}
+	return QuorumFallback_FREEZE
+}
+
+// EmaSmoothingFor returns the EMA smoothing factor configured for the given
+// pair, or DefaultEmaSmoothing if the pair has none.
+func (p Params) EmaSmoothingFor(pair asset.Pair) sdk.Dec {
+	for _, es := range p.EmaSmoothings {
+		if es.Pair == pair {
+			return es.Smoothing
+		}
+	}
+	return DefaultEmaSmoothing
+}
+
+// VoterWeightsFor returns the voter weights configured for the given pair, or
+// nil if the pair uses the stake weighted median.
+func (p Params) VoterWeightsFor(pair asset.Pair) []VoterWeight {
+	for _, vw := range p.VoterWeights {
+		if vw.Pair == pair {
+			return vw.Weights
+		}
+	}
+	return nil
+}
+
+// Validate checks that the pair is valid and that the weights belong to
+// distinct validators and add up to a positive total.
+func (vw PairVoterWeights) Validate() error {
+	if err := vw.Pair.Validate(); err != nil {
+		return err
+	}
+	if len(vw.Weights) == 0 {
+		return fmt.Errorf("no weights for pair %s", vw.Pair)
+	}
+
+	seenValidators := make(map[string]bool)
+	totalWeight := sdk.ZeroInt()
+	for _, w := range vw.Weights {
+		if _, err := sdk.ValAddressFromBech32(w.Validator); err != nil {
+			return fmt.Errorf("invalid validator %s for pair %s: %w", w.Validator, vw.Pair, err)
+		}
+		if seenValidators[w.Validator] {
+			return fmt.Errorf("duplicate validator %s for pair %s", w.Validator, vw.Pair)
+		}
+		seenValidators[w.Validator] = true
+		totalWeight = totalWeight.Add(sdk.NewIntFromUint64(w.Weight))
+	}
+	if !totalWeight.IsPositive() {
+		return fmt.Errorf("weights of pair %s must add up to a positive total", vw.Pair)
+	}
+	if !totalWeight.IsInt64() {
+		return fmt.Errorf("weights of pair %s add up to more than %d", vw.Pair, int64(math.MaxInt64))
+	}
 	return nil
 }
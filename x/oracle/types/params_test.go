@@ -2,10 +2,13 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
@@ -53,6 +56,88 @@ func TestParamsEqual(t *testing.T) {
 	err = p6.Validate()
 	require.Error(t, err)
 
+	// duplicate quorum fallback pair
+	p14 := types.DefaultParams()
+	p14.QuorumFallbacks = []types.PairQuorumFallback{
+		{Pair: asset.Registry.Pair(denoms.BTC, denoms.USD), Fallback: types.QuorumFallback_TWAP},
+		{Pair: asset.Registry.Pair(denoms.BTC, denoms.USD), Fallback: types.QuorumFallback_INVALIDATE},
+	}
+	err = p14.Validate()
+	require.Error(t, err)
+
+	// unknown quorum fallback
+	p15 := types.DefaultParams()
+	p15.QuorumFallbacks = []types.PairQuorumFallback{
+		{Pair: asset.Registry.Pair(denoms.BTC, denoms.USD), Fallback: types.QuorumFallback(69)},
+	}
+	err = p15.Validate()
+	require.Error(t, err)
+
+	// valid quorum fallbacks
+	p16 := types.DefaultParams()
+	p16.QuorumFallbacks = []types.PairQuorumFallback{
+		{Pair: asset.Registry.Pair(denoms.BTC, denoms.USD), Fallback: types.QuorumFallback_TWAP},
+	}
+	require.NoError(t, p16.Validate())
+	require.Equal(t, types.QuorumFallback_TWAP, p16.QuorumFallbackFor(asset.Registry.Pair(denoms.BTC, denoms.USD)))
+	require.Equal(t, types.QuorumFallback_FREEZE, p16.QuorumFallbackFor(asset.Registry.Pair(denoms.ETH, denoms.USD)))
+
+	// snapshot retention window shorter than the twap lookback window
+	p17 := types.DefaultParams()
+	p17.SnapshotRetentionWindow = p17.TwapLookbackWindow - time.Second
+	require.Error(t, p17.Validate())
+
+	// zero snapshot retention window disables pruning
+	p18 := types.DefaultParams()
+	p18.SnapshotRetentionWindow = 0
+	require.NoError(t, p18.Validate())
+
+	// ema smoothing out of range
+	p19 := types.DefaultParams()
+	p19.EmaSmoothings = []types.PairEmaSmoothing{
+		{Pair: asset.Registry.Pair(denoms.BTC, denoms.USD), Smoothing: sdk.NewDecWithPrec(11, 1)},
+	}
+	require.Error(t, p19.Validate())
+	p19.EmaSmoothings[0].Smoothing = sdk.ZeroDec()
+	require.Error(t, p19.Validate())
+
+	// valid ema smoothings
+	p20 := types.DefaultParams()
+	p20.EmaSmoothings = []types.PairEmaSmoothing{
+		{Pair: asset.Registry.Pair(denoms.BTC, denoms.USD), Smoothing: sdk.NewDecWithPrec(5, 1)},
+	}
+	require.NoError(t, p20.Validate())
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), p20.EmaSmoothingFor(asset.Registry.Pair(denoms.BTC, denoms.USD)))
+	require.Equal(t, types.DefaultEmaSmoothing, p20.EmaSmoothingFor(asset.Registry.Pair(denoms.ETH, denoms.USD)))
+
+	// negative max price change ratio
+	p21 := types.DefaultParams()
+	p21.MaxPriceChangeRatio = sdk.NewDecWithPrec(-1, 1)
+	require.Error(t, p21.Validate())
+	p21.MaxPriceChangeRatio = sdk.NewDecWithPrec(1, 1)
+	require.NoError(t, p21.Validate())
+	p21.SuspectBlocks = 0
+	require.Error(t, p21.Validate(), "a price change check needs suspect blocks")
+	p21.MaxPriceChangeRatio = sdk.ZeroDec()
+	require.NoError(t, p21.Validate())
+
+	// voter weights
+	valAddr := sdk.ValAddress([]byte("val1________________")).String()
+	p22 := types.DefaultParams()
+	p22.VoterWeights = []types.PairVoterWeights{{
+		Pair:    asset.Registry.Pair(denoms.BTC, denoms.USD),
+		Weights: []types.VoterWeight{{Validator: valAddr, Weight: 0}},
+	}}
+	require.Error(t, p22.Validate(), "weights must add up to a positive total")
+	p22.VoterWeights[0].Weights = append(p22.VoterWeights[0].Weights, types.VoterWeight{Validator: valAddr, Weight: 1})
+	require.Error(t, p22.Validate(), "duplicate validator")
+	p22.VoterWeights[0].Weights = []types.VoterWeight{{Validator: "invalid", Weight: 1}}
+	require.Error(t, p22.Validate(), "invalid validator")
+	p22.VoterWeights[0].Weights = []types.VoterWeight{{Validator: valAddr, Weight: 1}}
+	require.NoError(t, p22.Validate())
+	require.Len(t, p22.VoterWeightsFor(asset.Registry.Pair(denoms.BTC, denoms.USD)), 1)
+	require.Nil(t, p22.VoterWeightsFor(asset.Registry.Pair(denoms.ETH, denoms.USD)))
+
 	// empty name
 	p10 := types.DefaultParams()
 	p10.Whitelist[0] = ""
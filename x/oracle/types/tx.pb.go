@@ -6,7 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -296,6 +298,17 @@ type MsgEditOracleParams struct {
 	MinVoters          *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=min_voters,json=minVoters,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_voters,omitempty"`
 	// VoteThreshold: [cosmossdk.io/math.LegacyDec] TODO:
 	ValidatorFeeRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=validator_fee_ratio,json=validatorFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_fee_ratio,omitempty"`
+	// quorum_fallbacks: replaces the per-pair quorum fallbacks when non-empty.
+	QuorumFallbacks         []PairQuorumFallback                    `protobuf:"bytes,12,rep,name=quorum_fallbacks,json=quorumFallbacks,proto3" json:"quorum_fallbacks"`
+	SnapshotRetentionWindow *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=snapshot_retention_window,json=snapshotRetentionWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"snapshot_retention_window,omitempty"`
+	// ema_smoothings: replaces the per-pair EMA smoothing factors when
+	// non-empty.
+	EmaSmoothings       []PairEmaSmoothing                      `protobuf:"bytes,14,rep,name=ema_smoothings,json=emaSmoothings,proto3" json:"ema_smoothings"`
+	MaxPriceChangeRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=max_price_change_ratio,json=maxPriceChangeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_change_ratio,omitempty"`
+	SuspectBlocks       *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,16,opt,name=suspect_blocks,json=suspectBlocks,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"suspect_blocks,omitempty"`
+	// voter_weights: replaces the per-pair voter weights when non-empty.
+	VoterWeights              []PairVoterWeights                      `protobuf:"bytes,17,rep,name=voter_weights,json=voterWeights,proto3" json:"voter_weights"`
+	MaxSnapshotPrunesPerBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,18,opt,name=max_snapshot_prunes_per_block,json=maxSnapshotPrunesPerBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_snapshot_prunes_per_block,omitempty"`
 }
 
 func (m *MsgEditOracleParams) Reset()         { *m = MsgEditOracleParams{} }
@@ -345,6 +358,27 @@ func (m *MsgEditOracleParams) GetWhitelist() []string {
 	return nil
 }
 
+func (m *MsgEditOracleParams) GetQuorumFallbacks() []PairQuorumFallback {
+	if m != nil {
+		return m.QuorumFallbacks
+	}
+	return nil
+}
+
+func (m *MsgEditOracleParams) GetEmaSmoothings() []PairEmaSmoothing {
+	if m != nil {
+		return m.EmaSmoothings
+	}
+	return nil
+}
+
+func (m *MsgEditOracleParams) GetVoterWeights() []PairVoterWeights {
+	if m != nil {
+		return m.VoterWeights
+	}
+	return nil
+}
+
 // MsgEditOracleParamsResponse defines the Msg/EditOracleParams response
 // type.
 type MsgEditOracleParamsResponse struct {
@@ -391,6 +425,102 @@ func (m *MsgEditOracleParamsResponse) GetNewParams() *Params {
 	return nil
 }
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/oracle parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{8}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{9}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAggregateExchangeRatePrevote)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRatePrevote")
 	proto.RegisterType((*MsgAggregateExchangeRatePrevoteResponse)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRatePrevoteResponse")
@@ -400,70 +530,92 @@ func init() {
 	proto.RegisterType((*MsgDelegateFeedConsentResponse)(nil), "nibiru.oracle.v1.MsgDelegateFeedConsentResponse")
 	proto.RegisterType((*MsgEditOracleParams)(nil), "nibiru.oracle.v1.MsgEditOracleParams")
 	proto.RegisterType((*MsgEditOracleParamsResponse)(nil), "nibiru.oracle.v1.MsgEditOracleParamsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "nibiru.oracle.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "nibiru.oracle.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/tx.proto", fileDescriptor_11e362c65eb610f4) }
 
 var fileDescriptor_11e362c65eb610f4 = []byte{
-	// 915 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x41, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0xd7, 0x4d, 0xba, 0x64, 0x67, 0x49, 0x9b, 0x7a, 0xd3, 0xc8, 0xd9, 0x06, 0x3b, 0xb8,
-	0x10, 0x12, 0x89, 0xb5, 0x49, 0x90, 0x40, 0xf4, 0x04, 0x69, 0x1b, 0x09, 0x89, 0xa5, 0xc1, 0x82,
-	0x20, 0x71, 0xc0, 0xcc, 0xae, 0x5f, 0x6c, 0x2b, 0x5e, 0x8f, 0x35, 0x33, 0xcd, 0xa6, 0x57, 0xc4,
-	0x01, 0x6e, 0x48, 0x3d, 0x71, 0xcb, 0x07, 0x40, 0xe2, 0x6b, 0xf4, 0x58, 0x89, 0x0b, 0xe2, 0xb0,
-	0x42, 0x09, 0x42, 0x9c, 0x38, 0xec, 0x27, 0x40, 0x33, 0x1e, 0xbb, 0xdb, 0xcd, 0xb6, 0xcd, 0xee,
-	0x29, 0xce, 0xbc, 0xff, 0xfc, 0xde, 0xff, 0x3d, 0x7b, 0xde, 0x2c, 0x5a, 0x4d, 0xe3, 0x4e, 0x4c,
-	0x1f, 0xba, 0x84, 0xe2, 0x6e, 0x02, 0xee, 0xf1, 0xb6, 0xcb, 0x4f, 0x9c, 0x8c, 0x12, 0x4e, 0xf4,
-	0xa5, 0x3c, 0xe4, 0xe4, 0x21, 0xe7, 0x78, 0xbb, 0xb9, 0x1c, 0x92, 0x90, 0xc8, 0xa0, 0x2b, 0x9e,
-	0x72, 0x5d, 0x73, 0x2d, 0x24, 0x24, 0x4c, 0xc0, 0xc5, 0x59, 0xec, 0xe2, 0x34, 0x25, 0x1c, 0xf3,
-	0x98, 0xa4, 0x4c, 0x45, 0xdf, 0xb8, 0x90, 0x40, 0xf1, 0x64, 0xd8, 0xfe, 0x4d, 0x43, 0x56, 0x9b,
-	0x85, 0x9f, 0x84, 0x21, 0x85, 0x10, 0x73, 0xb8, 0x7f, 0xd2, 0x8d, 0x70, 0x1a, 0x82, 0x87, 0x39,
-	0xec, 0x53, 0x38, 0x26, 0x1c, 0xf4, 0xdb, 0x68, 0x3e, 0xc2, 0x2c, 0x32, 0xb4, 0x75, 0x6d, 0xb3,
-	0xb6, 0x7b, 0x7d, 0x38, 0xb0, 0xea, 0x8f, 0x70, 0x2f, 0xb9, 0x63, 0x8b, 0x55, 0xdb, 0x93, 0x41,
-	0x7d, 0x0b, 0x55, 0x0f, 0x01, 0x02, 0xa0, 0xc6, 0x15, 0x29, 0xbb, 0x31, 0x1c, 0x58, 0x8b, 0xb9,
-	0x2c, 0x5f, 0xb7, 0x3d, 0x25, 0xd0, 0x77, 0x50, 0xed, 0x18, 0x27, 0x71, 0x80, 0x39, 0xa1, 0xc6,
-	0x9c, 0x54, 0x2f, 0x0f, 0x07, 0xd6, 0x52, 0xae, 0x2e, 0x43, 0xb6, 0xf7, 0x4c, 0x76, 0x67, 0xe1,
-	0xc7, 0x53, 0xab, 0xf2, 0xef, 0xa9, 0x55, 0xb1, 0xb7, 0xd0, 0x3b, 0xaf, 0x30, 0xec, 0x01, 0xcb,
-	0x48, 0xca, 0xc0, 0xfe, 0x4f, 0x43, 0x6b, 0x2f, 0xd2, 0x1e, 0xa8, 0xca, 0x18, 0x4e, 0xf8, 0xc5,
-	0xca, 0xc4, 0xaa, 0xed, 0xc9, 0xa0, 0xfe, 0x31, 0xba, 0x06, 0x6a, 0xa3, 0x4f, 0x31, 0x07, 0xa6,
-	0x2a, 0x5c, 0x1d, 0x0e, 0xac, 0x9b, 0xb9, 0xfc, 0xf9, 0xb8, 0xed, 0x2d, 0xc2, 0x48, 0x26, 0x36,
-	0xd2, 0x9b, 0xb9, 0xa9, 0x7a, 0x33, 0x3f, 0x6d, 0x6f, 0x36, 0xd0, 0x5b, 0x2f, 0xab, 0xb7, 0x6c,
-	0xcc, 0x0f, 0x1a, 0x5a, 0x69, 0xb3, 0xf0, 0x1e, 0x24, 0x52, 0xb7, 0x07, 0x10, 0xdc, 0x15, 0x81,
-	0x94, 0xeb, 0x2e, 0x5a, 0x20, 0x19, 0x50, 0x99, 0x3f, 0x6f, 0x4b, 0x63, 0x38, 0xb0, 0xae, 0xe7,
-	0xf9, 0x8b, 0x88, 0xed, 0x95, 0x22, 0xb1, 0x21, 0x50, 0x1c, 0xd5, 0x98, 0x91, 0x0d, 0x45, 0xc4,
-	0xf6, 0x4a, 0xd1, 0x88, 0xdd, 0x75, 0x64, 0x4e, 0x76, 0x51, 0x1a, 0xfd, 0xa7, 0x8a, 0x1a, 0x6d,
-	0x16, 0xde, 0x0f, 0x62, 0xfe, 0x40, 0x7e, 0xb6, 0xfb, 0x98, 0xe2, 0x1e, 0xd3, 0x57, 0x50, 0x95,
-	0x41, 0x2a, 0x3a, 0x2a, 0x3d, 0x7a, 0xea, 0x3f, 0xfd, 0x01, 0xaa, 0x8b, 0x2f, 0xc0, 0xcf, 0x80,
-	0xc6, 0x24, 0x50, 0x7e, 0x9c, 0x27, 0x03, 0x4b, 0xfb, 0x73, 0x60, 0x6d, 0x84, 0x31, 0x8f, 0x1e,
-	0x76, 0x9c, 0x2e, 0xe9, 0xb9, 0x5d, 0xc2, 0x7a, 0x84, 0xa9, 0x3f, 0x2d, 0x16, 0x1c, 0xb9, 0xfc,
-	0x51, 0x06, 0xcc, 0xf9, 0x34, 0xe5, 0x1e, 0x12, 0x88, 0x7d, 0x49, 0xd0, 0xbf, 0x42, 0xd7, 0x24,
-	0x90, 0x47, 0x14, 0x58, 0x44, 0x92, 0x40, 0xbd, 0xc2, 0x69, 0x98, 0xf7, 0xa0, 0xeb, 0x2d, 0x0a,
-	0xca, 0x97, 0x05, 0x44, 0xf8, 0xa4, 0xd0, 0xc7, 0x34, 0xf0, 0x3b, 0x38, 0x0d, 0xd4, 0x8b, 0x9e,
-	0x96, 0x89, 0x72, 0xc4, 0x2e, 0x4e, 0x03, 0xdd, 0x46, 0xb5, 0x7e, 0x14, 0x73, 0x48, 0x62, 0xc6,
-	0x8d, 0xab, 0xeb, 0x73, 0x9b, 0xb5, 0xdd, 0x79, 0x81, 0xf3, 0x9e, 0x2d, 0x8b, 0x5a, 0x58, 0x82,
-	0x59, 0xe4, 0x1f, 0x52, 0xdc, 0x15, 0x33, 0xc2, 0xa8, 0xce, 0x56, 0x8b, 0xa4, 0xec, 0x29, 0x88,
-	0xfe, 0x05, 0x7a, 0x3d, 0xc7, 0xf6, 0xe3, 0x34, 0x20, 0x7d, 0xe3, 0xb5, 0x99, 0x9a, 0x5e, 0x97,
-	0x8c, 0xaf, 0x25, 0x42, 0xf7, 0xd1, 0x72, 0x2f, 0x4e, 0x7d, 0xf9, 0x89, 0x8b, 0x77, 0x59, 0xa0,
-	0x17, 0x66, 0xf2, 0x7b, 0xa3, 0x17, 0xa7, 0x07, 0x02, 0xb5, 0x0f, 0x54, 0x25, 0xf8, 0x0e, 0x2d,
-	0xf3, 0x3e, 0xce, 0xfc, 0x84, 0x90, 0xa3, 0x0e, 0xee, 0x1e, 0x15, 0x09, 0x6a, 0x33, 0x79, 0xd7,
-	0x05, 0xeb, 0x33, 0x85, 0x52, 0x19, 0xda, 0x08, 0xc9, 0x12, 0x08, 0x07, 0xca, 0x0c, 0x34, 0x13,
-	0xb7, 0x26, 0x8c, 0x4b, 0x80, 0xfe, 0x2d, 0x6a, 0x94, 0x07, 0xde, 0x3f, 0x04, 0x39, 0x69, 0x62,
-	0x62, 0xd4, 0x67, 0x6b, 0x48, 0x89, 0xda, 0x03, 0x31, 0x1c, 0x62, 0x62, 0x1f, 0xa0, 0x5b, 0x13,
-	0xce, 0x59, 0x71, 0x0e, 0xf5, 0x0f, 0x11, 0x4a, 0xa1, 0xef, 0x67, 0x72, 0x55, 0x9e, 0xb9, 0xfa,
-	0x8e, 0xe1, 0x8c, 0x5f, 0x50, 0x8e, 0xda, 0x55, 0x4b, 0xa1, 0x9f, 0x3f, 0xee, 0xfc, 0x74, 0x15,
-	0xcd, 0xb5, 0x59, 0xa8, 0xff, 0xaa, 0xa1, 0xb5, 0x97, 0x5e, 0x32, 0xdb, 0x17, 0x69, 0xaf, 0x18,
-	0xf3, 0xcd, 0x8f, 0xa6, 0xde, 0x52, 0xce, 0x15, 0xf3, 0xfb, 0xdf, 0xff, 0x7e, 0x7c, 0xc5, 0xb0,
-	0x57, 0xdc, 0xe7, 0xaf, 0xc7, 0x4c, 0xb9, 0x39, 0xd5, 0xd0, 0xea, 0x8b, 0xaf, 0x0d, 0xe7, 0xf2,
-	0x89, 0x85, 0xbe, 0xf9, 0xc1, 0x74, 0xfa, 0xd2, 0xe5, 0x2d, 0xe9, 0xf2, 0xa6, 0xdd, 0x18, 0x73,
-	0x29, 0x2d, 0xfe, 0xa2, 0xa1, 0xc6, 0xa4, 0x01, 0xbe, 0x39, 0x31, 0xd9, 0x04, 0x65, 0xf3, 0xbd,
-	0xcb, 0x2a, 0x4b, 0x43, 0x1b, 0xd2, 0xd0, 0xba, 0x6d, 0x8e, 0x19, 0xca, 0x2f, 0xaf, 0x56, 0x31,
-	0xe2, 0xf5, 0xc7, 0x1a, 0x5a, 0xba, 0x30, 0xb3, 0xdf, 0x9e, 0x98, 0x6e, 0x5c, 0xd6, 0x6c, 0x5d,
-	0x4a, 0x56, 0x5a, 0xda, 0x92, 0x96, 0x6e, 0xdb, 0x6f, 0x8e, 0x59, 0x82, 0x20, 0xe6, 0xad, 0xfc,
-	0xb9, 0x95, 0x7f, 0xb6, 0xbb, 0x7b, 0x4f, 0xce, 0x4c, 0xed, 0xe9, 0x99, 0xa9, 0xfd, 0x75, 0x66,
-	0x6a, 0x3f, 0x9f, 0x9b, 0x95, 0xa7, 0xe7, 0x66, 0xe5, 0x8f, 0x73, 0xb3, 0xf2, 0xcd, 0xbb, 0x23,
-	0x07, 0xe7, 0x73, 0x89, 0xb9, 0x1b, 0xe1, 0x38, 0x2d, 0x90, 0x27, 0x05, 0x54, 0x1e, 0xa1, 0x4e,
-	0x55, 0xfe, 0x74, 0x7a, 0xff, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x67, 0xca, 0xa5, 0x9a, 0xbc,
-	0x09, 0x00, 0x00,
+	// 1236 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x41, 0x6f, 0x13, 0x47,
+	0x14, 0xce, 0x26, 0x21, 0xc5, 0xe3, 0xc4, 0x09, 0xe3, 0x10, 0xd6, 0x06, 0xec, 0xb0, 0x50, 0x9a,
+	0x54, 0xb5, 0x5d, 0x52, 0x89, 0xaa, 0x9c, 0x4a, 0x80, 0x48, 0x95, 0xea, 0x62, 0x96, 0x02, 0x52,
+	0x55, 0x75, 0x3b, 0xf6, 0x4e, 0x76, 0xa7, 0xec, 0xee, 0x2c, 0x33, 0xe3, 0xd8, 0x5c, 0x51, 0x0f,
+	0x3d, 0xb6, 0xe2, 0xd4, 0x1b, 0x3f, 0xa0, 0x52, 0x7b, 0xe8, 0x8f, 0xe0, 0x88, 0xda, 0x4b, 0xd5,
+	0x83, 0x55, 0x41, 0x25, 0x7a, 0xea, 0x21, 0x97, 0x5e, 0xab, 0x99, 0x9d, 0xdd, 0x38, 0x8e, 0x09,
+	0xc4, 0xa7, 0xec, 0xbe, 0xf7, 0xcd, 0xf7, 0xbe, 0xf7, 0x79, 0x76, 0xe6, 0x05, 0x94, 0x22, 0xd2,
+	0x26, 0xac, 0xdb, 0xa0, 0x0c, 0x75, 0x02, 0xdc, 0xd8, 0xb9, 0xd4, 0x10, 0xfd, 0x7a, 0xcc, 0xa8,
+	0xa0, 0x70, 0x29, 0x49, 0xd5, 0x93, 0x54, 0x7d, 0xe7, 0x52, 0xf9, 0x54, 0x87, 0xf2, 0x90, 0xf2,
+	0x46, 0xc8, 0x3d, 0x89, 0x0c, 0xb9, 0x97, 0x40, 0xcb, 0xa5, 0x24, 0xe1, 0xa8, 0xb7, 0x46, 0xf2,
+	0xa2, 0x53, 0xcb, 0x1e, 0xf5, 0x68, 0x12, 0x97, 0x4f, 0x3a, 0x7a, 0xc6, 0xa3, 0xd4, 0x0b, 0x70,
+	0x03, 0xc5, 0xa4, 0x81, 0xa2, 0x88, 0x0a, 0x24, 0x08, 0x8d, 0xd2, 0x35, 0x67, 0x0f, 0x88, 0xd2,
+	0x1a, 0x54, 0xda, 0xfa, 0xd9, 0x00, 0xd5, 0x26, 0xf7, 0xae, 0x7a, 0x1e, 0xc3, 0x1e, 0x12, 0xf8,
+	0x46, 0xbf, 0xe3, 0xa3, 0xc8, 0xc3, 0x36, 0x12, 0xb8, 0xc5, 0xf0, 0x0e, 0x15, 0x18, 0x9e, 0x07,
+	0xb3, 0x3e, 0xe2, 0xbe, 0x69, 0xac, 0x1a, 0x6b, 0xb9, 0xcd, 0xc5, 0xdd, 0x41, 0x35, 0xff, 0x10,
+	0x85, 0xc1, 0x15, 0x4b, 0x46, 0x2d, 0x5b, 0x25, 0xe1, 0x3a, 0x98, 0xdb, 0xc6, 0xd8, 0xc5, 0xcc,
+	0x9c, 0x56, 0xb0, 0x13, 0xbb, 0x83, 0xea, 0x42, 0x02, 0x4b, 0xe2, 0x96, 0xad, 0x01, 0x70, 0x03,
+	0xe4, 0x76, 0x50, 0x40, 0x5c, 0x24, 0x28, 0x33, 0x67, 0x14, 0x7a, 0x79, 0x77, 0x50, 0x5d, 0x4a,
+	0xd0, 0x59, 0xca, 0xb2, 0xf7, 0x60, 0x57, 0x8e, 0x7f, 0xf7, 0xa4, 0x3a, 0xf5, 0xcf, 0x93, 0xea,
+	0x94, 0xb5, 0x0e, 0xde, 0x79, 0x8d, 0x60, 0x1b, 0xf3, 0x98, 0x46, 0x1c, 0x5b, 0xff, 0x1a, 0xe0,
+	0xcc, 0xab, 0xb0, 0x77, 0x75, 0x67, 0x1c, 0x05, 0xe2, 0x60, 0x67, 0x32, 0x6a, 0xd9, 0x2a, 0x09,
+	0x3f, 0x06, 0x05, 0xac, 0x17, 0x3a, 0x0c, 0x09, 0xcc, 0x75, 0x87, 0xa5, 0xdd, 0x41, 0xf5, 0x64,
+	0x02, 0xdf, 0x9f, 0xb7, 0xec, 0x05, 0x3c, 0x54, 0x89, 0x0f, 0x79, 0x33, 0x73, 0x24, 0x6f, 0x66,
+	0x8f, 0xea, 0xcd, 0x45, 0x70, 0xe1, 0xb0, 0x7e, 0x33, 0x63, 0xbe, 0x35, 0xc0, 0x4a, 0x93, 0x7b,
+	0xd7, 0x71, 0xa0, 0x70, 0x5b, 0x18, 0xbb, 0xd7, 0x64, 0x22, 0x12, 0xb0, 0x01, 0x8e, 0xd3, 0x18,
+	0x33, 0x55, 0x3f, 0xb1, 0xa5, 0xb8, 0x3b, 0xa8, 0x2e, 0x26, 0xf5, 0xd3, 0x8c, 0x65, 0x67, 0x20,
+	0xb9, 0xc0, 0xd5, 0x3c, 0xe6, 0xf4, 0xe8, 0x82, 0x34, 0x63, 0xd9, 0x19, 0x68, 0x48, 0xee, 0x2a,
+	0xa8, 0x8c, 0x57, 0x91, 0x09, 0xfd, 0x2f, 0x0f, 0x8a, 0x4d, 0xee, 0xdd, 0x70, 0x89, 0xb8, 0xa9,
+	0xb6, 0x6d, 0x0b, 0x31, 0x14, 0x72, 0xb8, 0x02, 0xe6, 0x38, 0x8e, 0x5c, 0xac, 0x35, 0xda, 0xfa,
+	0x0d, 0xde, 0x04, 0x79, 0xb9, 0x03, 0x9c, 0x18, 0x33, 0x42, 0x5d, 0xad, 0xa7, 0xfe, 0x74, 0x50,
+	0x35, 0xfe, 0x1c, 0x54, 0x2f, 0x7a, 0x44, 0xf8, 0xdd, 0x76, 0xbd, 0x43, 0x43, 0xfd, 0x5d, 0xe9,
+	0x3f, 0x35, 0xee, 0xde, 0x6f, 0x88, 0x87, 0x31, 0xe6, 0xf5, 0x4f, 0x22, 0x61, 0x03, 0x49, 0xd1,
+	0x52, 0x0c, 0xf0, 0x0e, 0x28, 0x28, 0x42, 0xe1, 0x33, 0xcc, 0x7d, 0x1a, 0xb8, 0xe6, 0xcc, 0x91,
+	0x39, 0xaf, 0xe3, 0x8e, 0xbd, 0x20, 0x59, 0x3e, 0x4f, 0x49, 0xa4, 0x4e, 0x86, 0x7b, 0x88, 0xb9,
+	0x4e, 0x1b, 0x45, 0xae, 0x39, 0x3b, 0x11, 0x27, 0x48, 0x28, 0x36, 0x51, 0xe4, 0x42, 0x0b, 0xe4,
+	0x7a, 0x3e, 0x11, 0x38, 0x20, 0x5c, 0x98, 0xc7, 0x56, 0x67, 0xd6, 0x72, 0x9b, 0xb3, 0x92, 0xce,
+	0xde, 0x0b, 0xcb, 0x5e, 0x78, 0x80, 0xb8, 0xef, 0x6c, 0x33, 0xd4, 0x91, 0x67, 0x84, 0x39, 0x37,
+	0x59, 0x2f, 0x8a, 0x65, 0x4b, 0x93, 0xc0, 0x5b, 0x60, 0x3e, 0xa1, 0xed, 0x91, 0xc8, 0xa5, 0x3d,
+	0xf3, 0xad, 0x89, 0x4c, 0xcf, 0x2b, 0x8e, 0x7b, 0x8a, 0x02, 0x3a, 0x60, 0x39, 0x24, 0x91, 0xa3,
+	0xb6, 0xb8, 0xfc, 0x2d, 0x53, 0xea, 0xe3, 0x13, 0xe9, 0x3d, 0x11, 0x92, 0xe8, 0xae, 0xa4, 0x6a,
+	0x61, 0xa6, 0x0b, 0x7c, 0x0d, 0x96, 0x45, 0x0f, 0xc5, 0x4e, 0x40, 0xe9, 0xfd, 0x36, 0xea, 0xdc,
+	0x4f, 0x0b, 0xe4, 0x26, 0xd2, 0x0e, 0x25, 0xd7, 0xa7, 0x9a, 0x4a, 0x57, 0x68, 0x02, 0xa0, 0x5a,
+	0xa0, 0x02, 0x33, 0x6e, 0x82, 0x89, 0x78, 0x73, 0x52, 0xb8, 0x22, 0x80, 0x5f, 0x81, 0x62, 0xf6,
+	0xc1, 0x3b, 0xdb, 0x58, 0x9d, 0x34, 0x84, 0x9a, 0xf9, 0xc9, 0x0c, 0xc9, 0xa8, 0xb6, 0xb0, 0x3c,
+	0x1c, 0x08, 0x85, 0x77, 0xc0, 0xd2, 0x83, 0x2e, 0x65, 0xdd, 0xd0, 0xd9, 0x46, 0x41, 0x20, 0xfb,
+	0xe0, 0xe6, 0xfc, 0xea, 0xcc, 0x5a, 0x7e, 0xe3, 0x42, 0x7d, 0xf4, 0xee, 0xaa, 0xb7, 0x10, 0x61,
+	0xb7, 0x14, 0x7a, 0x4b, 0x83, 0xd5, 0x66, 0x9b, 0xb2, 0x17, 0x1f, 0xec, 0x8b, 0x72, 0xf8, 0x0d,
+	0x28, 0xf1, 0x08, 0xc5, 0xdc, 0xa7, 0xc2, 0x61, 0x58, 0xe0, 0x48, 0xee, 0x98, 0xd4, 0xec, 0x85,
+	0x89, 0x4c, 0x39, 0x95, 0x12, 0xda, 0x29, 0x9f, 0x76, 0xfc, 0x26, 0x28, 0xe0, 0x10, 0x39, 0x3c,
+	0xa4, 0x54, 0xf8, 0x24, 0xf2, 0xb8, 0x59, 0x50, 0x0d, 0x58, 0xe3, 0x1b, 0xb8, 0x11, 0xa2, 0xdb,
+	0x29, 0x54, 0xcb, 0x5f, 0xc0, 0x43, 0x31, 0x0e, 0x3b, 0x60, 0x25, 0x44, 0x7d, 0x27, 0x66, 0xa4,
+	0x83, 0x9d, 0xbd, 0x03, 0x9e, 0x50, 0x73, 0x71, 0x22, 0xdb, 0x8b, 0x21, 0xea, 0xb7, 0x24, 0xd9,
+	0xb5, 0xf4, 0x54, 0x56, 0xc6, 0x17, 0x78, 0x97, 0xc7, 0xb8, 0x23, 0x9c, 0x76, 0x40, 0xa5, 0xed,
+	0x4b, 0x13, 0xd9, 0xb2, 0xa0, 0x59, 0x36, 0x15, 0x09, 0x6c, 0x02, 0x75, 0xe2, 0x30, 0xa7, 0x87,
+	0x89, 0xe7, 0x0b, 0x6e, 0x9e, 0x38, 0xcc, 0x0b, 0xb5, 0xc9, 0xee, 0x25, 0x48, 0xed, 0xc5, 0xfc,
+	0xce, 0x50, 0x0c, 0xc6, 0xe0, 0xac, 0xb4, 0x22, 0xfb, 0x2d, 0x63, 0xd6, 0x8d, 0x30, 0x57, 0x9f,
+	0xa6, 0x52, 0x6d, 0xc2, 0x89, 0x44, 0x97, 0x42, 0xd4, 0xbf, 0xad, 0x39, 0x5b, 0x8a, 0xb2, 0x85,
+	0x99, 0xea, 0xc0, 0xba, 0x0b, 0x4e, 0x8f, 0x39, 0xf8, 0xd3, 0x8b, 0x01, 0x7e, 0x08, 0x40, 0x84,
+	0x7b, 0x4e, 0xac, 0xa2, 0xea, 0x12, 0xc8, 0x6f, 0x98, 0xe3, 0x9a, 0x53, 0xab, 0x72, 0x11, 0xee,
+	0x25, 0x8f, 0xd6, 0x0f, 0x06, 0x58, 0x6c, 0x72, 0xef, 0x4e, 0xec, 0xca, 0x81, 0x41, 0xc5, 0xe0,
+	0x65, 0x90, 0x43, 0x5d, 0xe1, 0x53, 0x46, 0xc4, 0x43, 0x7d, 0xe9, 0x99, 0xbf, 0xfd, 0x5a, 0x5b,
+	0xd6, 0xc3, 0xd7, 0x55, 0xd7, 0x65, 0x98, 0xf3, 0xdb, 0x82, 0x91, 0xc8, 0xb3, 0xf7, 0xa0, 0xf0,
+	0x32, 0x98, 0xd3, 0x02, 0xa6, 0x0f, 0x17, 0xa0, 0x3d, 0xd5, 0xe8, 0x2b, 0x85, 0x47, 0x2f, 0x7f,
+	0x79, 0x77, 0x8f, 0xc7, 0x2a, 0x81, 0x53, 0x23, 0x92, 0xd2, 0x3e, 0x37, 0x5e, 0x1e, 0x03, 0x33,
+	0x4d, 0xee, 0xc1, 0x9f, 0x0c, 0x70, 0xe6, 0xd0, 0x21, 0xed, 0xd2, 0xc1, 0xda, 0xaf, 0x19, 0x93,
+	0xca, 0x1f, 0x1d, 0x79, 0x49, 0x76, 0x2f, 0x57, 0x1e, 0xfd, 0xfe, 0xf7, 0xe3, 0x69, 0xd3, 0x5a,
+	0x69, 0xec, 0x1f, 0x2f, 0x63, 0xad, 0xe6, 0x89, 0x01, 0x4a, 0xaf, 0x1e, 0xbb, 0xea, 0x6f, 0x5e,
+	0x58, 0xe2, 0xcb, 0x97, 0x8f, 0x86, 0xcf, 0x54, 0x9e, 0x56, 0x2a, 0x4f, 0x5a, 0xc5, 0x11, 0x95,
+	0x4a, 0xe2, 0x8f, 0x06, 0x28, 0x8e, 0x1b, 0x80, 0xd6, 0xc6, 0x16, 0x1b, 0x83, 0x2c, 0xbf, 0xff,
+	0xa6, 0xc8, 0x4c, 0xd0, 0x45, 0x25, 0x68, 0xd5, 0xaa, 0x8c, 0x08, 0x4a, 0x86, 0xbf, 0x5a, 0x3a,
+	0x22, 0xc1, 0xc7, 0x06, 0x58, 0x3a, 0x30, 0xf3, 0xbc, 0x3d, 0xb6, 0xdc, 0x28, 0xac, 0x5c, 0x7b,
+	0x23, 0x58, 0x26, 0x69, 0x5d, 0x49, 0x3a, 0x6f, 0x9d, 0x1b, 0x91, 0x84, 0x5d, 0x22, 0x6a, 0xc9,
+	0x73, 0x2d, 0xd9, 0xb6, 0xf0, 0x4b, 0x30, 0xbf, 0xef, 0xb3, 0x39, 0x37, 0xb6, 0xd2, 0x30, 0xa4,
+	0xbc, 0xfe, 0x5a, 0x48, 0x2a, 0x64, 0x73, 0xeb, 0xe9, 0xf3, 0x8a, 0xf1, 0xec, 0x79, 0xc5, 0xf8,
+	0xeb, 0x79, 0xc5, 0xf8, 0xfe, 0x45, 0x65, 0xea, 0xd9, 0x8b, 0xca, 0xd4, 0x1f, 0x2f, 0x2a, 0x53,
+	0x5f, 0xbc, 0x37, 0x74, 0x9a, 0x7c, 0xa6, 0xe8, 0xae, 0xf9, 0x88, 0x44, 0xa9, 0xe0, 0x7e, 0x2a,
+	0x59, 0x9d, 0x2b, 0xed, 0x39, 0xf5, 0x8f, 0xcd, 0x07, 0xff, 0x0f, 0x00, 0xb7, 0x77, 0x4a, 0xe0,
+	0x8e, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -489,6 +641,8 @@ type MsgClient interface {
 	// See https://github.com/NibiruChain/pricefeeder.
 	DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error)
 	EditOracleParams(ctx context.Context, in *MsgEditOracleParams, opts ...grpc.CallOption) (*MsgEditOracleParamsResponse, error)
+	// UpdateParams replaces the params of the module through gov v1 type.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -535,6 +689,15 @@ func (c *msgClient) EditOracleParams(ctx context.Context, in *MsgEditOracleParam
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AggregateExchangeRatePrevote defines a method for submitting
@@ -548,6 +711,8 @@ type MsgServer interface {
 	// See https://github.com/NibiruChain/pricefeeder.
 	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
 	EditOracleParams(context.Context, *MsgEditOracleParams) (*MsgEditOracleParamsResponse, error)
+	// UpdateParams replaces the params of the module through gov v1 type.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -566,6 +731,9 @@ func (*UnimplementedMsgServer) DelegateFeedConsent(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) EditOracleParams(ctx context.Context, req *MsgEditOracleParams) (*MsgEditOracleParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditOracleParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -643,6 +811,24 @@ func _Msg_EditOracleParams_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.oracle.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -663,6 +849,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EditOracleParams",
 			Handler:    _Msg_EditOracleParams_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/oracle/v1/tx.proto",
@@ -889,6 +1079,102 @@ func (m *MsgEditOracleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSnapshotPrunesPerBlock != nil {
+		{
+			size := m.MaxSnapshotPrunesPerBlock.Size()
+			i -= size
+			if _, err := m.MaxSnapshotPrunesPerBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.VoterWeights) > 0 {
+		for iNdEx := len(m.VoterWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoterWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.SuspectBlocks != nil {
+		{
+			size := m.SuspectBlocks.Size()
+			i -= size
+			if _, err := m.SuspectBlocks.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.MaxPriceChangeRatio != nil {
+		{
+			size := m.MaxPriceChangeRatio.Size()
+			i -= size
+			if _, err := m.MaxPriceChangeRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.EmaSmoothings) > 0 {
+		for iNdEx := len(m.EmaSmoothings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EmaSmoothings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.SnapshotRetentionWindow != nil {
+		{
+			size := m.SnapshotRetentionWindow.Size()
+			i -= size
+			if _, err := m.SnapshotRetentionWindow.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.QuorumFallbacks) > 0 {
+		for iNdEx := len(m.QuorumFallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuorumFallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if m.ValidatorFeeRatio != nil {
 		{
 			size := m.ValidatorFeeRatio.Size()
@@ -1051,6 +1337,69 @@ func (m *MsgEditOracleParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1204,6 +1553,40 @@ func (m *MsgEditOracleParams) Size() (n int) {
 		l = m.ValidatorFeeRatio.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.QuorumFallbacks) > 0 {
+		for _, e := range m.QuorumFallbacks {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SnapshotRetentionWindow != nil {
+		l = m.SnapshotRetentionWindow.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.EmaSmoothings) > 0 {
+		for _, e := range m.EmaSmoothings {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MaxPriceChangeRatio != nil {
+		l = m.MaxPriceChangeRatio.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SuspectBlocks != nil {
+		l = m.SuspectBlocks.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if len(m.VoterWeights) > 0 {
+		for _, e := range m.VoterWeights {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MaxSnapshotPrunesPerBlock != nil {
+		l = m.MaxSnapshotPrunesPerBlock.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1220,6 +1603,30 @@ func (m *MsgEditOracleParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2231,29 +2638,275 @@ func (m *MsgEditOracleParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumFallbacks", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgEditOracleParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+			m.QuorumFallbacks = append(m.QuorumFallbacks, PairQuorumFallback{})
+			if err := m.QuorumFallbacks[len(m.QuorumFallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetentionWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.SnapshotRetentionWindow = &v
+			if err := m.SnapshotRetentionWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaSmoothings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmaSmoothings = append(m.EmaSmoothings, PairEmaSmoothing{})
+			if err := m.EmaSmoothings[len(m.EmaSmoothings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChangeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxPriceChangeRatio = &v
+			if err := m.MaxPriceChangeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspectBlocks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.SuspectBlocks = &v
+			if err := m.SuspectBlocks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterWeights = append(m.VoterWeights, PairVoterWeights{})
+			if err := m.VoterWeights[len(m.VoterWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSnapshotPrunesPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxSnapshotPrunesPerBlock = &v
+			if err := m.MaxSnapshotPrunesPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditOracleParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -2338,6 +2991,171 @@ func (m *MsgEditOracleParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -48,6 +48,16 @@ func (v AggregateExchangeRateVote) String() string {
 	return string(out)
 }
 
+// NewVoterPairStats creates a VoterPairStats instance with no votes
+func NewVoterPairStats() VoterPairStats {
+	return VoterPairStats{
+		LastExchangeRate: sdk.ZeroDec(),
+		LastDeviation:    sdk.ZeroDec(),
+		MeanDeviation:    sdk.ZeroDec(),
+		MaxDeviation:     sdk.ZeroDec(),
+	}
+}
+
 // NewExchangeRateTuple creates a ExchangeRateTuple instance
 func NewExchangeRateTuple(pair asset.Pair, exchangeRate sdk.Dec) ExchangeRateTuple {
 	return ExchangeRateTuple{
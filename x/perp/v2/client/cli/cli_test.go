@@ -1,7 +1,9 @@
 package cli_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"cosmossdk.io/errors"
@@ -760,3 +762,31 @@ func (s *IntegrationTestSuite) TestQueryCollateralDenom() {
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
+
+func (s *IntegrationTestSuite) TestHealth() {
+	val := s.network.Validators[0]
+
+	resp, err := http.Get(val.APIAddress + app.HealthRoute)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	var report app.HealthReport
+	s.Require().NoError(json.NewDecoder(resp.Body).Decode(&report))
+	s.Empty(report.Error)
+
+	// pairs with a price in genesis are not stale
+	for _, pair := range []asset.Pair{
+		asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+		asset.Registry.Pair(denoms.ETH, denoms.NUSD),
+	} {
+		s.NotContains(report.StalePairs, pair)
+	}
+
+	if report.CatchingUp || len(report.StalePairs) > 0 {
+		s.Equal(app.HealthStatusDegraded, report.Status)
+		s.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+	} else {
+		s.Equal(app.HealthStatusOK, report.Status)
+		s.Equal(http.StatusOK, resp.StatusCode)
+	}
+}
@@ -25,6 +25,7 @@ const (
 	FlagSqrtDepth              = "sqrt-depth"
 	FlagPriceMultiplier        = "price-multiplier"
 	FlagMaintenenceMarginRatio = "mmr"
+	FlagInitialMarginRatio     = "imr"
 	FlagMaxLeverage            = "max-leverage"
 	FlagMaxFundingrate         = "max-funding-rate"
 	FlagOraclePair             = "oracle-pair"
@@ -38,6 +39,7 @@ var addMarketGenesisFlags = map[string]struct {
 	FlagSqrtDepth:              {"", "sqrt k"},
 	FlagPriceMultiplier:        {"", "the peg multiplier for the pool"},
 	FlagMaintenenceMarginRatio: {"0.0625", "maintenance margin ratio"},
+	FlagInitialMarginRatio:     {"0.1", "initial margin ratio"},
 	FlagMaxLeverage:            {"10", "maximum leverage for opening a position"},
 	FlagMaxFundingrate:         {"0.01", "maximum funding rate for the market"},
 	FlagOraclePair:             {"", "oracle pair identifier of the form 'base:quote'. E.g., ueth:uusd"},
@@ -136,6 +138,9 @@ func newMarketFromFlags(flagSet *flag.FlagSet,
 	mmrAsString, err := flagSet.GetString(FlagMaintenenceMarginRatio)
 	flagErrors = append(flagErrors, err)
 
+	imrAsString, err := flagSet.GetString(FlagInitialMarginRatio)
+	flagErrors = append(flagErrors, err)
+
 	maxLeverageStr, err := flagSet.GetString(FlagMaxLeverage)
 	flagErrors = append(flagErrors, err)
 
@@ -166,6 +171,11 @@ func newMarketFromFlags(flagSet *flag.FlagSet,
 		return
 	}
 
+	initialMarginRatio, err := sdk.NewDecFromStr(imrAsString)
+	if err != nil {
+		return
+	}
+
 	maxLeverage, err := sdk.NewDecFromStr(maxLeverageStr)
 	if err != nil {
 		return
@@ -190,6 +200,7 @@ func newMarketFromFlags(flagSet *flag.FlagSet,
 		Pair:                            pair,
 		Enabled:                         true,
 		MaintenanceMarginRatio:          maintenanceMarginRatio,
+		InitialMarginRatio:              initialMarginRatio,
 		MaxLeverage:                     maxLeverage,
 		LatestCumulativePremiumFraction: sdk.ZeroDec(),
 		ExchangeFeeRatio:                sdk.MustNewDecFromStr("0.0010"),
@@ -201,6 +212,7 @@ func newMarketFromFlags(flagSet *flag.FlagSet,
 		TwapLookbackWindow:              time.Minute * 30,
 		PrepaidBadDebt:                  sdk.NewInt64Coin(pair.QuoteDenom(), 0),
 		OraclePair:                      oraclePair,
+		PriceFluctuationLimitRatio:      sdk.ZeroDec(),
 	}
 	if err := market.Validate(); err != nil {
 		return types.Market{}, types.AMM{}, err
@@ -19,6 +19,7 @@ func TestAddMarketGenesisCmd(t *testing.T) {
 		sqrtDepth       string
 		priceMultiplier string
 		maintainRatio   string
+		initialRatio    string
 		maxLeverage     string
 		maxFundingRate  string
 		oraclePair      string
@@ -112,6 +113,18 @@ func TestAddMarketGenesisCmd(t *testing.T) {
 			oraclePair:      "invalidPair",
 			expectError:     true,
 		},
+		{
+			name:            "initial margin ratio below maintenance margin ratio",
+			pairName:        "token0:token1",
+			sqrtDepth:       "100",
+			priceMultiplier: "1",
+			maintainRatio:   "0.1",
+			initialRatio:    "0.05",
+			maxLeverage:     "10",
+			maxFundingRate:  "10",
+			oraclePair:      "token0:token1",
+			expectError:     true,
+		},
 		{
 			name:            "valid market pair",
 			pairName:        "token0:token1",
@@ -130,7 +143,7 @@ func TestAddMarketGenesisCmd(t *testing.T) {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			cmd := cli.AddMarketGenesisCmd("home")
-			cmd.SetArgs([]string{
+			args := []string{
 				fmt.Sprintf("--%s=%s", cli.FlagPair, tc.pairName),
 				fmt.Sprintf("--%s=%s", cli.FlagSqrtDepth, tc.sqrtDepth),
 				fmt.Sprintf("--%s=%s", cli.FlagPriceMultiplier, tc.priceMultiplier),
@@ -139,7 +152,11 @@ func TestAddMarketGenesisCmd(t *testing.T) {
 				fmt.Sprintf("--%s=%s", cli.FlagMaxFundingrate, tc.maxFundingRate),
 				fmt.Sprintf("--%s=%s", cli.FlagOraclePair, tc.oraclePair),
 				fmt.Sprintf("--%s=home", flags.FlagHome),
-			})
+			}
+			if tc.initialRatio != "" {
+				args = append(args, fmt.Sprintf("--%s=%s", cli.FlagInitialMarginRatio, tc.initialRatio))
+			}
+			cmd.SetArgs(args)
 
 			if tc.expectError {
 				require.Error(t, cmd.ExecuteContext(ctx))
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -13,7 +14,13 @@ import (
 	types "github.com/NibiruChain/nibiru/x/perp/v2/types"
 )
 
-const FlagVersioned = "versioned"
+const (
+	FlagVersioned   = "versioned"
+	FlagRankBy      = "rank-by"
+	FlagTwapWindow  = "twap-window"
+	FlagQuoteAmount = "quote-amount"
+	FlagBaseAmount  = "base-amount"
+)
 
 // NewQueryCmd returns the cli query commands for this module
 func NewQueryCmd() *cobra.Command {
@@ -33,6 +40,11 @@ func NewQueryCmd() *cobra.Command {
 		CmdQueryModuleAccounts(),
 		CmdQueryMarkets(),
 		CmdQueryCollateral(),
+		CmdQueryTraderStats(),
+		CmdQueryLeaderboard(),
+		CmdQueryConditionalOrders(),
+		CmdQueryMarketPrice(),
+		CmdQuerySwapQuote(),
 	}
 	for _, cmd := range cmds {
 		moduleQueryCmd.AddCommand(cmd)
@@ -102,9 +114,15 @@ func CmdQueryPositions() *cobra.Command {
 				return fmt.Errorf("invalid trader address: %w", err)
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.QueryPositions(
 				cmd.Context(), &types.QueryPositionsRequest{
-					Trader: trader.String(),
+					Trader:     trader.String(),
+					Pagination: pageReq,
 				},
 			)
 			if err != nil {
@@ -116,6 +134,7 @@ func CmdQueryPositions() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "positions")
 
 	return cmd
 }
@@ -169,8 +188,14 @@ inactive ones.`),
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.QueryMarkets(cmd.Context(), &types.QueryMarketsRequest{
-				Versioned: versioned,
+				Versioned:  versioned,
+				Pagination: pageReq,
 			})
 			if err != nil {
 				return err
@@ -183,6 +208,7 @@ inactive ones.`),
 	cmd.Flags().Bool(FlagVersioned, false, "toggles whether to include inactive markets")
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "markets")
 
 	return cmd
 }
@@ -219,3 +245,247 @@ func CmdQueryCollateral() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdQueryTraderStats: Command for the "Query/QueryTraderStats" gRPC service
+// method.
+func CmdQueryTraderStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trader-stats [trader] [epoch]",
+		Short: "Query the statistics of a trader for a DnR epoch",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryTraderStats(cmd.Context(), &types.QueryTraderStatsRequest{
+				Trader: trader.String(),
+				Epoch:  epoch,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryLeaderboard: Command for the "Query/QueryLeaderboard" gRPC service
+// method.
+func CmdQueryLeaderboard() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leaderboard [epoch]",
+		Short: "Query the trader leaderboard of a DnR epoch",
+		Long: heredoc.Doc(`
+Query the traders of a DnR epoch ranked by trading volume. Use --rank-by
+to rank them by realized PnL instead.`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epoch, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			rankByStr, err := cmd.Flags().GetString(FlagRankBy)
+			if err != nil {
+				return err
+			}
+			rankBy, ok := types.LeaderboardRanking_value[rankByStr]
+			if !ok {
+				return fmt.Errorf("invalid ranking %s", rankByStr)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryLeaderboard(cmd.Context(), &types.QueryLeaderboardRequest{
+				Epoch:      epoch,
+				RankBy:     types.LeaderboardRanking(rankBy),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagRankBy, types.LeaderboardRanking_RANK_BY_VOLUME.String(),
+		"ranking of the traders, RANK_BY_VOLUME or RANK_BY_REALIZED_PNL")
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "leaderboard")
+
+	return cmd
+}
+
+// CmdQueryConditionalOrders: Command for the "Query/QueryConditionalOrders"
+// gRPC service method.
+func CmdQueryConditionalOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conditional-orders [trader]",
+		Short: "Query the open conditional orders of a trader",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryConditionalOrders(cmd.Context(), &types.QueryConditionalOrdersRequest{
+				Trader: trader.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryMarketPrice: Command for the "Query/QueryMarketPrice" gRPC service
+// method.
+func CmdQueryMarketPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-price [pair]",
+		Short: "Query the mark price, mark price TWAP and reserves of a market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			twapWindow, err := cmd.Flags().GetDuration(FlagTwapWindow)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryMarketPrice(cmd.Context(), &types.QueryMarketPriceRequest{
+				Pair:               pair,
+				TwapLookbackWindow: twapWindow,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(FlagTwapWindow, 0, "lookback window of the TWAP, defaults to the TWAP lookback window of the market")
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQuerySwapQuote: Command for the "Query/QuerySwapQuote" gRPC service
+// method.
+func CmdQuerySwapQuote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-quote [pair] [LONG|SHORT]",
+		Short: "Quote a swap against the reserves of a market",
+		Long: heredoc.Doc(`
+Quote the base assets exchanged for --quote-amount quote assets, or the quote
+assets exchanged for --base-amount base assets. Exactly one of the two flags
+must be set.`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			dir, ok := types.Direction_value[args[1]]
+			if !ok {
+				return fmt.Errorf("invalid direction %s", args[1])
+			}
+
+			req := &types.QuerySwapQuoteRequest{Pair: pair, Dir: types.Direction(dir)}
+			if req.QuoteAssetAmount, err = readOptionalDecFlag(cmd, FlagQuoteAmount); err != nil {
+				return err
+			}
+			if req.BaseAssetAmount, err = readOptionalDecFlag(cmd, FlagBaseAmount); err != nil {
+				return err
+			}
+
+			res, err := queryClient.QuerySwapQuote(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagQuoteAmount, "", "amount of quote assets to swap")
+	cmd.Flags().String(FlagBaseAmount, "", "amount of base assets to swap")
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// readOptionalDecFlag returns nil if the flag is not set.
+func readOptionalDecFlag(cmd *cobra.Command, flag string) (*sdk.Dec, error) {
+	str, err := cmd.Flags().GetString(flag)
+	if err != nil || str == "" {
+		return nil, err
+	}
+	dec, err := sdk.NewDecFromStr(str)
+	if err != nil {
+		return nil, err
+	}
+	return &dec, nil
+}
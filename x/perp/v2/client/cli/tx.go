@@ -34,6 +34,9 @@ func NewTxCmd() *cobra.Command {
 		PartialCloseCmd(),
 		MultiLiquidateCmd(),
 		DonateToEcosystemFundCmd(),
+		PlaceConditionalOrderCmd(),
+		CancelConditionalOrderCmd(),
+		ExecuteConditionalOrderCmd(),
 	)
 
 	return txCmd
@@ -398,3 +401,145 @@ func DonateToEcosystemFundCmd() *cobra.Command {
 
 	return cmd
 }
+
+/*
+PlaceConditionalOrderCmd is a CLI command that places a stop-loss or
+take-profit order on a position.
+*/
+func PlaceConditionalOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-conditional-order [pair] [above|below] [trigger-price] [size] [execution-fee]",
+		Short: "Places an order that reduces a position once the mark price crosses a trigger",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			Closes <size> base assets of the position once the mark price is at or
+			above, or at or below, <trigger-price>. A size of 0 closes the whole
+			position. <execution-fee> is an amount of collateral escrowed and paid
+			to whoever executes the order.
+
+			$ %s tx perp place-conditional-order ubtc:unusd below 25000 0 1000
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			var condition types.TriggerCondition
+			switch args[1] {
+			case "above":
+				condition = types.TriggerCondition_PRICE_AT_OR_ABOVE
+			case "below":
+				condition = types.TriggerCondition_PRICE_AT_OR_BELOW
+			default:
+				return fmt.Errorf("invalid trigger condition %s, expected above or below", args[1])
+			}
+
+			triggerPrice, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			size, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			executionFee, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("invalid execution fee: %s", args[4])
+			}
+
+			msg := &types.MsgPlaceConditionalOrder{
+				Sender:           clientCtx.GetFromAddress().String(),
+				Pair:             pair,
+				TriggerCondition: condition,
+				TriggerPrice:     triggerPrice,
+				Size_:            size,
+				ExecutionFee:     executionFee,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CancelConditionalOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-conditional-order [order-id]",
+		Short: "Cancels a conditional order and refunds its execution fee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelConditionalOrder{
+				Sender:  clientCtx.GetFromAddress().String(),
+				OrderId: orderID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func ExecuteConditionalOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-conditional-order [order-id]",
+		Short: "Executes a triggered conditional order and collects its execution fee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgExecuteConditionalOrder{
+				Sender:  clientCtx.GetFromAddress().String(),
+				OrderId: orderID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,146 @@
+package action
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/testutil/action"
+	perpkeeper "github.com/NibiruChain/nibiru/x/perp/v2/keeper"
+	"github.com/NibiruChain/nibiru/x/perp/v2/types"
+)
+
+// PlaceConditionalOrder
+
+type placeConditionalOrderAction struct {
+	msg         types.MsgPlaceConditionalOrder
+	wantOrderID uint64
+	expectedErr error
+}
+
+func (p placeConditionalOrderAction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	resp, err := perpkeeper.NewMsgServerImpl(app.PerpKeeperV2).PlaceConditionalOrder(
+		sdk.WrapSDKContext(ctx), &p.msg)
+
+	if p.expectedErr != nil {
+		if !errors.Is(err, p.expectedErr) {
+			return ctx, fmt.Errorf("expected error %s, got %s", p.expectedErr, err)
+		}
+		return ctx, nil
+	}
+	if err != nil {
+		return ctx, err
+	}
+	if resp.OrderId != p.wantOrderID {
+		return ctx, fmt.Errorf("expected order id %d, got %d", p.wantOrderID, resp.OrderId)
+	}
+	return ctx, nil
+}
+
+// PlaceConditionalOrder places a conditional order and checks the id it was
+// assigned.
+func PlaceConditionalOrder(
+	trader sdk.AccAddress,
+	pair asset.Pair,
+	condition types.TriggerCondition,
+	triggerPrice sdk.Dec,
+	size sdk.Dec,
+	executionFee sdkmath.Int,
+	wantOrderID uint64,
+) action.Action {
+	return placeConditionalOrderAction{
+		msg: types.MsgPlaceConditionalOrder{
+			Sender:           trader.String(),
+			Pair:             pair,
+			TriggerCondition: condition,
+			TriggerPrice:     triggerPrice,
+			Size_:            size,
+			ExecutionFee:     executionFee,
+		},
+		wantOrderID: wantOrderID,
+	}
+}
+
+func PlaceConditionalOrderFails(
+	trader sdk.AccAddress,
+	pair asset.Pair,
+	condition types.TriggerCondition,
+	triggerPrice sdk.Dec,
+	size sdk.Dec,
+	executionFee sdkmath.Int,
+	expectedErr error,
+) action.Action {
+	return placeConditionalOrderAction{
+		msg: types.MsgPlaceConditionalOrder{
+			Sender:           trader.String(),
+			Pair:             pair,
+			TriggerCondition: condition,
+			TriggerPrice:     triggerPrice,
+			Size_:            size,
+			ExecutionFee:     executionFee,
+		},
+		expectedErr: expectedErr,
+	}
+}
+
+// CancelConditionalOrder
+
+type cancelConditionalOrderAction struct {
+	trader      sdk.AccAddress
+	orderID     uint64
+	expectedErr error
+}
+
+func (c cancelConditionalOrderAction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	_, err := perpkeeper.NewMsgServerImpl(app.PerpKeeperV2).CancelConditionalOrder(
+		sdk.WrapSDKContext(ctx), &types.MsgCancelConditionalOrder{
+			Sender:  c.trader.String(),
+			OrderId: c.orderID,
+		})
+
+	if !errors.Is(err, c.expectedErr) {
+		return ctx, fmt.Errorf("expected error %v, got %v", c.expectedErr, err)
+	}
+	return ctx, nil
+}
+
+func CancelConditionalOrder(trader sdk.AccAddress, orderID uint64) action.Action {
+	return cancelConditionalOrderAction{trader: trader, orderID: orderID}
+}
+
+func CancelConditionalOrderFails(trader sdk.AccAddress, orderID uint64, expectedErr error) action.Action {
+	return cancelConditionalOrderAction{trader: trader, orderID: orderID, expectedErr: expectedErr}
+}
+
+// ExecuteConditionalOrder
+
+type executeConditionalOrderAction struct {
+	executor    sdk.AccAddress
+	orderID     uint64
+	expectedErr error
+}
+
+func (e executeConditionalOrderAction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	_, err := perpkeeper.NewMsgServerImpl(app.PerpKeeperV2).ExecuteConditionalOrder(
+		sdk.WrapSDKContext(ctx), &types.MsgExecuteConditionalOrder{
+			Sender:  e.executor.String(),
+			OrderId: e.orderID,
+		})
+
+	if !errors.Is(err, e.expectedErr) {
+		return ctx, fmt.Errorf("expected error %v, got %v", e.expectedErr, err)
+	}
+	return ctx, nil
+}
+
+func ExecuteConditionalOrder(executor sdk.AccAddress, orderID uint64) action.Action {
+	return executeConditionalOrderAction{executor: executor, orderID: orderID}
+}
+
+func ExecuteConditionalOrderFails(executor sdk.AccAddress, orderID uint64, expectedErr error) action.Action {
+	return executeConditionalOrderAction{executor: executor, orderID: orderID, expectedErr: expectedErr}
+}
@@ -123,6 +123,24 @@ func WithEnabled(enabled bool) MarketModifier {
 	}
 }
 
+func WithTradingSchedule(schedule types.TradingSchedule) MarketModifier {
+	return func(market *types.Market, amm *types.AMM) {
+		market.TradingSchedule = schedule
+	}
+}
+
+func WithInitialMarginRatio(ratio sdk.Dec) MarketModifier {
+	return func(market *types.Market, amm *types.AMM) {
+		market.InitialMarginRatio = ratio
+	}
+}
+
+func WithPriceFluctuationLimit(ratio sdk.Dec) MarketModifier {
+	return func(market *types.Market, amm *types.AMM) {
+		market.PriceFluctuationLimitRatio = ratio
+	}
+}
+
 type shiftPegMultiplier struct {
 	pair     asset.Pair
 	newValue sdk.Dec
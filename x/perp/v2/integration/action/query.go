@@ -154,6 +154,7 @@ func QueryPositionNotFound(pair asset.Pair, traderAddress sdk.AccAddress) action
 
 type queryMarkets struct {
 	versioned           bool
+	pagination          *sdkquery.PageRequest
 	allResponseCheckers []QueryMarketsChecker
 }
 
@@ -163,7 +164,8 @@ func (q queryMarkets) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, erro
 	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
 
 	resp, err := queryServer.QueryMarkets(sdk.WrapSDKContext(ctx), &types.QueryMarketsRequest{
-		Versioned: q.versioned,
+		Versioned:  q.versioned,
+		Pagination: q.pagination,
 	})
 	if err != nil {
 		return ctx, err
@@ -186,6 +188,17 @@ func QueryMarkets(versioned bool, responseCheckers ...QueryMarketsChecker) actio
 	}
 }
 
+// QueryMarketsPage queries a single page of markets
+func QueryMarketsPage(
+	versioned bool, pagination *sdkquery.PageRequest, responseCheckers ...QueryMarketsChecker,
+) action.Action {
+	return queryMarkets{
+		versioned:           versioned,
+		pagination:          pagination,
+		allResponseCheckers: responseCheckers,
+	}
+}
+
 type QueryMarketsChecker func(resp []types.AmmMarket) error
 
 func QueryMarkets_MarketsShouldContain(expectedMarket types.Market) QueryMarketsChecker {
@@ -350,3 +363,189 @@ func QueryCollateral(expectDenom string) action.Action {
 		wantDenom: expectDenom,
 	}
 }
+
+type queryTraderStats struct {
+	trader    sdk.AccAddress
+	epoch     uint64
+	wantStats types.TraderStats
+}
+
+func (q queryTraderStats) IsNotMandatory() {}
+
+func (q queryTraderStats) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+
+	resp, err := queryServer.QueryTraderStats(sdk.WrapSDKContext(ctx), &types.QueryTraderStatsRequest{
+		Trader: q.trader.String(),
+		Epoch:  q.epoch,
+	})
+	if err != nil {
+		return ctx, err
+	}
+
+	if resp.Stats.String() != q.wantStats.String() {
+		return ctx, fmt.Errorf("expected trader stats %s, got %s", q.wantStats.String(), resp.Stats.String())
+	}
+
+	return ctx, nil
+}
+
+// QueryTraderStats checks the statistics of a trader for an epoch.
+func QueryTraderStats(trader sdk.AccAddress, epoch uint64, wantStats types.TraderStats) action.Action {
+	return queryTraderStats{
+		trader:    trader,
+		epoch:     epoch,
+		wantStats: wantStats,
+	}
+}
+
+type queryLeaderboard struct {
+	epoch       uint64
+	rankBy      types.LeaderboardRanking
+	pagination  *sdkquery.PageRequest
+	wantTraders []sdk.AccAddress
+}
+
+func (q queryLeaderboard) IsNotMandatory() {}
+
+func (q queryLeaderboard) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+
+	resp, err := queryServer.QueryLeaderboard(sdk.WrapSDKContext(ctx), &types.QueryLeaderboardRequest{
+		Epoch:      q.epoch,
+		RankBy:     q.rankBy,
+		Pagination: q.pagination,
+	})
+	if err != nil {
+		return ctx, err
+	}
+
+	if len(resp.Entries) != len(q.wantTraders) {
+		return ctx, fmt.Errorf("expected %d leaderboard entries, got %d", len(q.wantTraders), len(resp.Entries))
+	}
+	for i, entry := range resp.Entries {
+		if entry.Trader != q.wantTraders[i].String() {
+			return ctx, fmt.Errorf("expected trader %s at index %d, got %s", q.wantTraders[i], i, entry.Trader)
+		}
+	}
+
+	return ctx, nil
+}
+
+// QueryLeaderboard checks that the given page of the leaderboard lists the
+// traders in the expected order.
+func QueryLeaderboard(
+	epoch uint64, rankBy types.LeaderboardRanking, pagination *sdkquery.PageRequest, wantTraders ...sdk.AccAddress,
+) action.Action {
+	return queryLeaderboard{
+		epoch:       epoch,
+		rankBy:      rankBy,
+		pagination:  pagination,
+		wantTraders: wantTraders,
+	}
+}
+
+type queryConditionalOrders struct {
+	trader      sdk.AccAddress
+	wantOrderID []uint64
+}
+
+func (q queryConditionalOrders) IsNotMandatory() {}
+
+func (q queryConditionalOrders) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+
+	resp, err := queryServer.QueryConditionalOrders(sdk.WrapSDKContext(ctx), &types.QueryConditionalOrdersRequest{
+		Trader: q.trader.String(),
+	})
+	if err != nil {
+		return ctx, err
+	}
+
+	if len(resp.Orders) != len(q.wantOrderID) {
+		return ctx, fmt.Errorf("expected %d conditional orders, got %d", len(q.wantOrderID), len(resp.Orders))
+	}
+	for i, order := range resp.Orders {
+		if order.Id != q.wantOrderID[i] {
+			return ctx, fmt.Errorf("expected order id %d at index %d, got %d", q.wantOrderID[i], i, order.Id)
+		}
+	}
+
+	return ctx, nil
+}
+
+// QueryConditionalOrders checks the ids of the open conditional orders of a
+// trader.
+func QueryConditionalOrders(trader sdk.AccAddress, wantOrderIDs ...uint64) action.Action {
+	return queryConditionalOrders{
+		trader:      trader,
+		wantOrderID: wantOrderIDs,
+	}
+}
+
+type queryMarketPrice struct {
+	req  types.QueryMarketPriceRequest
+	want types.QueryMarketPriceResponse
+}
+
+func (q queryMarketPrice) IsNotMandatory() {}
+
+func (q queryMarketPrice) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+
+	resp, err := queryServer.QueryMarketPrice(sdk.WrapSDKContext(ctx), &q.req)
+	if err != nil {
+		return ctx, err
+	}
+
+	if resp.String() != q.want.String() {
+		return ctx, fmt.Errorf("expected market price %s, got %s", q.want.String(), resp.String())
+	}
+
+	return ctx, nil
+}
+
+// QueryMarketPrice checks the mark price, mark price TWAP and reserves of a
+// market.
+func QueryMarketPrice(req types.QueryMarketPriceRequest, want types.QueryMarketPriceResponse) action.Action {
+	return queryMarketPrice{req: req, want: want}
+}
+
+type querySwapQuote struct {
+	req     types.QuerySwapQuoteRequest
+	want    types.QuerySwapQuoteResponse
+	wantErr bool
+}
+
+func (q querySwapQuote) IsNotMandatory() {}
+
+func (q querySwapQuote) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+
+	resp, err := queryServer.QuerySwapQuote(sdk.WrapSDKContext(ctx), &q.req)
+	if q.wantErr {
+		if err == nil {
+			return ctx, fmt.Errorf("expected swap quote to fail, got %s", resp.String())
+		}
+		return ctx, nil
+	}
+	if err != nil {
+		return ctx, err
+	}
+
+	if resp.String() != q.want.String() {
+		return ctx, fmt.Errorf("expected swap quote %s, got %s", q.want.String(), resp.String())
+	}
+
+	return ctx, nil
+}
+
+// QuerySwapQuote checks the quote of a swap against the reserves of a market.
+func QuerySwapQuote(req types.QuerySwapQuoteRequest, want types.QuerySwapQuoteResponse) action.Action {
+	return querySwapQuote{req: req, want: want}
+}
+
+// QuerySwapQuoteFail checks that the swap quote request fails.
+func QuerySwapQuoteFail(req types.QuerySwapQuoteRequest) action.Action {
+	return querySwapQuote{req: req, wantErr: true}
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/set"
+	"github.com/NibiruChain/nibiru/x/perp/v2/types"
+)
+
+// getBlockSummary returns the summary of the current block. The summary lives
+// in the transient store, which is cleared when the block is committed; a
+// summary of an earlier block is discarded all the same.
+func (k Keeper) getBlockSummary(ctx sdk.Context) types.EventBlockSummary {
+	summary, err := k.BlockSummary.Get(ctx)
+	if err == nil && summary.BlockHeight == ctx.BlockHeight() {
+		return summary
+	}
+	return types.EventBlockSummary{
+		BlockHeight:        ctx.BlockHeight(),
+		Volume:             sdkmath.ZeroInt(),
+		Fees:               sdkmath.ZeroInt(),
+		LiquidatedNotional: sdkmath.ZeroInt(),
+		BadDebt:            sdkmath.ZeroInt(),
+	}
+}
+
+// recordTradeInBlockSummary adds a position change from a trade to the
+// summary of the current block.
+func (k Keeper) recordTradeInBlockSummary(ctx sdk.Context, exchangedNotional sdk.Dec, fee sdkmath.Int) {
+	summary := k.getBlockSummary(ctx)
+	summary.NumTrades++
+	summary.Volume = summary.Volume.Add(exchangedNotional.Abs().TruncateInt())
+	summary.Fees = summary.Fees.Add(fee)
+	k.BlockSummary.Set(ctx, summary)
+}
+
+// recordLiquidationInBlockSummary adds a liquidation to the summary of the
+// current block.
+func (k Keeper) recordLiquidationInBlockSummary(ctx sdk.Context, exchangedNotional sdk.Dec, badDebt sdkmath.Int) {
+	summary := k.getBlockSummary(ctx)
+	summary.NumLiquidations++
+	summary.LiquidatedNotional = summary.LiquidatedNotional.Add(exchangedNotional.Abs().TruncateInt())
+	summary.BadDebt = summary.BadDebt.Add(badDebt)
+	k.BlockSummary.Set(ctx, summary)
+}
+
+// EmitBlockSummary emits the EventBlockSummary of the current block if any
+// position changed or any index price was updated in it.
+func (k Keeper) EmitBlockSummary(ctx sdk.Context) {
+	summary := k.getBlockSummary(ctx)
+	summary.UpdatedOraclePairs = k.updatedOraclePairs(ctx)
+	if summary.NumTrades == 0 && summary.NumLiquidations == 0 && len(summary.UpdatedOraclePairs) == 0 {
+		return
+	}
+	_ = ctx.EventManager().EmitTypedEvent(&summary)
+}
+
+// updatedOraclePairs returns the oracle pairs of the enabled markets whose
+// price was set by the oracle in the current block.
+func (k Keeper) updatedOraclePairs(ctx sdk.Context) (pairs []asset.Pair) {
+	seen := set.New[asset.Pair]()
+	for _, pair := range k.MarketLastVersion.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
+		market, err := k.GetMarket(ctx, pair)
+		if err != nil || !market.Enabled || seen.Has(market.OraclePair) {
+			continue
+		}
+		seen.Add(market.OraclePair)
+
+		height, err := k.OracleKeeper.GetExchangeRateBlock(ctx, market.OraclePair)
+		if err == nil && height == uint64(ctx.BlockHeight()) {
+			pairs = append(pairs, market.OraclePair)
+		}
+	}
+	return pairs
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	. "github.com/NibiruChain/nibiru/x/oracle/integration/action"
+	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/action"
+	"github.com/NibiruChain/nibiru/x/perp/v2/types"
+)
+
+type blockSummaryShouldBe struct {
+	expected *types.EventBlockSummary
+}
+
+func (b blockSummaryShouldBe) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error) {
+	emitCtx := ctx.WithEventManager(sdk.NewEventManager())
+	app.PerpKeeperV2.EmitBlockSummary(emitCtx)
+
+	var summaries []*types.EventBlockSummary
+	for _, event := range emitCtx.EventManager().Events() {
+		if event.Type != proto.MessageName(&types.EventBlockSummary{}) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event{Type: event.Type, Attributes: event.Attributes})
+		if err != nil {
+			return ctx, err
+		}
+		summaries = append(summaries, typedEvent.(*types.EventBlockSummary))
+	}
+
+	if b.expected == nil {
+		if len(summaries) != 0 {
+			return ctx, fmt.Errorf("expected no block summary, got %s", summaries[0])
+		}
+		return ctx, nil
+	}
+	if len(summaries) != 1 {
+		return ctx, fmt.Errorf("expected one block summary, got %d", len(summaries))
+	}
+	got := summaries[0]
+	if got.BlockHeight != b.expected.BlockHeight ||
+		got.NumTrades != b.expected.NumTrades ||
+		!got.Volume.Equal(b.expected.Volume) ||
+		!got.Fees.Equal(b.expected.Fees) ||
+		got.NumLiquidations != b.expected.NumLiquidations ||
+		!got.LiquidatedNotional.Equal(b.expected.LiquidatedNotional) ||
+		!got.BadDebt.Equal(b.expected.BadDebt) ||
+		!slices.Equal(got.UpdatedOraclePairs, b.expected.UpdatedOraclePairs) {
+		return ctx, fmt.Errorf("expected block summary %s, got %s", b.expected, got)
+	}
+	return ctx, nil
+}
+
+// BlockSummaryShouldBe checks the EventBlockSummary emitted for the current
+// block. A nil summary expects no event.
+func BlockSummaryShouldBe(expected *types.EventBlockSummary) Action {
+	return blockSummaryShouldBe{expected: expected}
+}
+
+func TestBlockSummary(t *testing.T) {
+	alice := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+	pairBtcNusd := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	pairEthNusd := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+
+	tests := TestCases{
+		TC("trades of the block are aggregated").
+			Given(
+				SetBlockNumber(1),
+				CreateCustomMarket(
+					pairBtcNusd,
+					WithEnabled(true),
+					WithPricePeg(sdk.OneDec()),
+					WithSqrtDepth(sdk.NewDec(100_000)),
+				),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(20_000)))),
+				FundModule(types.PerpFundModuleAccount, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(100_000_000)))),
+			).
+			When(
+				MarketOrder(alice, pairBtcNusd, types.Direction_LONG, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec()),
+				MarketOrder(alice, pairBtcNusd, types.Direction_SHORT, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec()),
+			).
+			Then(
+				BlockSummaryShouldBe(&types.EventBlockSummary{
+					BlockHeight:        1,
+					NumTrades:          2,
+					Volume:             sdk.NewInt(19_960),
+					Fees:               sdk.NewInt(40),
+					LiquidatedNotional: sdk.ZeroInt(),
+					BadDebt:            sdk.ZeroInt(),
+				}),
+				SetBlockNumber(2),
+				BlockSummaryShouldBe(nil),
+			),
+
+		TC("liquidations of the block are aggregated").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(time.Now()),
+				CreateCustomMarket(pairBtcNusd),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcNusd), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10600))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+			).
+			When(
+				MoveToNextBlock(),
+				MultiLiquidate(liquidator, false,
+					PairTraderTuple{Pair: pairBtcNusd, Trader: alice, Successful: true},
+				),
+			).
+			Then(
+				BlockSummaryShouldBe(&types.EventBlockSummary{
+					BlockHeight:        2,
+					Volume:             sdk.ZeroInt(),
+					Fees:               sdk.ZeroInt(),
+					NumLiquidations:    1,
+					LiquidatedNotional: sdk.NewInt(9_999),
+					BadDebt:            sdk.ZeroInt(),
+				}),
+			),
+
+		TC("index price updates of the block are listed").
+			Given(
+				SetBlockNumber(1),
+				CreateCustomMarket(pairBtcNusd, WithEnabled(true)),
+				CreateCustomMarket(pairEthNusd),
+			).
+			When(
+				MoveToNextBlock(),
+				SetOraclePrice(asset.Registry.Pair(denoms.BTC, denoms.USD), sdk.NewDec(20_000)),
+				SetOraclePrice(asset.Registry.Pair(denoms.ETH, denoms.USD), sdk.NewDec(2_000)),
+			).
+			Then(
+				BlockSummaryShouldBe(&types.EventBlockSummary{
+					BlockHeight:        2,
+					Volume:             sdk.ZeroInt(),
+					Fees:               sdk.ZeroInt(),
+					LiquidatedNotional: sdk.ZeroInt(),
+					BadDebt:            sdk.ZeroInt(),
+					UpdatedOraclePairs: []asset.Pair{asset.Registry.Pair(denoms.BTC, denoms.USD)},
+				}),
+				MoveToNextBlock(),
+				BlockSummaryShouldBe(nil),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tests...).Run()
+}
@@ -45,6 +45,10 @@ func (k Keeper) MarketOrder(
 		return nil, types.ErrMarketNotEnabled.Wrapf("market pair %s not enabled", pair)
 	}
 
+	if !market.TradingSchedule.IsOpen(ctx.BlockTime()) {
+		return nil, types.ErrMarketClosed.Wrapf("market pair %s", pair)
+	}
+
 	amm, err := k.GetAMM(ctx, pair)
 	if err != nil {
 		return nil, types.ErrPairNotFound.Wrapf("pair %s not found", pair)
@@ -104,18 +108,36 @@ func (k Keeper) MarketOrder(
 		}
 	}
 
+	// opening, increasing or flipping a position, as opposed to only reducing it
+	increasesPosition := isNewPosition || openSideMatchesPosition ||
+		(!positionResp.Position.Size_.IsZero() &&
+			positionResp.Position.Size_.IsPositive() != position.Size_.IsPositive())
+
 	// check bad debt
 	if !positionResp.Position.Size_.IsZero() {
 		if positionResp.BadDebt.IsPositive() {
 			return nil, types.ErrBadDebt.Wrapf("position has bad debt %s", positionResp.BadDebt)
 		}
 
-		err = k.checkMarginRatio(ctx, market, *updatedAMM, positionResp.Position)
+		// increasing a position needs the initial margin ratio, only reducing a
+		// position can go down to the maintenance one
+		minMarginRatio := market.MaintenanceMarginRatio
+		if increasesPosition {
+			minMarginRatio = market.InitialMarginRatio
+		}
+		err = k.checkMarginRatio(ctx, market, *updatedAMM, positionResp.Position, minMarginRatio)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	// the price fluctuation limit never keeps a trader from reducing risk
+	if increasesPosition {
+		if err = k.checkPriceFluctuationLimit(ctx, market, *updatedAMM); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = k.afterPositionUpdate(
 		ctx, market, traderAddr, *positionResp, types.ChangeReason_MarketOrder, transferredFee, position,
 	); err != nil {
@@ -377,7 +399,7 @@ func (k Keeper) decreasePosition(
 	}
 
 	if positionResp.Position.Size_.IsZero() {
-		err := k.Positions.Delete(ctx, collections.Join(collections.Join(currentPosition.Pair, amm.Version), trader))
+		err := k.DeletePosition(ctx, currentPosition.Pair, amm.Version, trader)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -592,6 +614,10 @@ func (k Keeper) afterPositionUpdate(
 		}
 	}
 
+	k.updateTraderStats(
+		ctx, traderAddr, positionResp.ExchangedNotionalValue, positionResp.RealizedPnl, transferredFee)
+	k.recordTradeInBlockSummary(ctx, positionResp.ExchangedNotionalValue, transferredFee)
+
 	_ = ctx.EventManager().EmitTypedEvents(
 		&types.PositionChangedEvent{
 			FinalPosition:     positionResp.Position,
@@ -611,8 +637,11 @@ func (k Keeper) afterPositionUpdate(
 	return nil
 }
 
-// checkMarginRatio checks if the margin ratio of the position is below the liquidation threshold.
-func (k Keeper) checkMarginRatio(ctx sdk.Context, market types.Market, amm types.AMM, position types.Position) (err error) {
+// checkMarginRatio checks if the margin ratio of the position is below the given
+// minimum, e.g. the maintenance margin ratio of the market.
+func (k Keeper) checkMarginRatio(
+	ctx sdk.Context, market types.Market, amm types.AMM, position types.Position, minMarginRatio sdk.Dec,
+) (err error) {
 	spotNotional, err := PositionNotionalSpot(amm, position)
 	if err != nil {
 		return
@@ -629,8 +658,8 @@ func (k Keeper) checkMarginRatio(ctx sdk.Context, market types.Market, amm types
 	}
 
 	marginRatio := MarginRatio(position, preferredPositionNotional, market.LatestCumulativePremiumFraction)
-	if marginRatio.LT(market.MaintenanceMarginRatio) {
-		return types.ErrMarginRatioTooLow.Wrapf("position margin ratio: %s, maintenance margin ratio: %s", marginRatio, market.MaintenanceMarginRatio)
+	if marginRatio.LT(minMarginRatio) {
+		return types.ErrMarginRatioTooLow.Wrapf("position margin ratio: %s, min margin ratio: %s", marginRatio, minMarginRatio)
 	}
 	return
 }
@@ -701,7 +730,9 @@ func (k Keeper) transferFee(
 }
 
 // ClosePosition closes a position entirely and transfers the remaining margin back to the user.
-// Errors if the position has bad debt.
+// Errors if the position has bad debt. Positions can be closed while the trading
+// schedule of the market is closed, since they can be liquidated then too. For
+// the same reason the price fluctuation limit of the market does not apply.
 //
 // args:
 //   - ctx: the cosmos-sdk context
@@ -856,6 +887,9 @@ func (k Keeper) closePositionEntirely(
 	return updatedAMM, resp, nil
 }
 
+// PartialClose reduces the size of a position by sizeAmt. Like ClosePosition,
+// it is allowed while the trading schedule of the market is closed and is not
+// subject to the price fluctuation limit.
 func (k Keeper) PartialClose(
 	ctx sdk.Context,
 	pair asset.Pair,
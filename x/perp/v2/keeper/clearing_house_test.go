@@ -2049,3 +2049,203 @@ func TestUpdateSwapInvariant(t *testing.T) {
 
 	NewTestSuite(t).WithTestCases(tc...).Run()
 }
+
+func TestInitialMarginRatio(t *testing.T) {
+	alice := testutil.AccAddress()
+	pairBtcNusd := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	startBlockTime := time.Now()
+
+	market := CreateCustomMarket(pairBtcNusd,
+		WithEnabled(true),
+		WithInitialMarginRatio(sdk.MustNewDecFromStr("0.2")),
+	)
+	// margin ratio of 0.15, between the maintenance and initial margin ratios
+	position := InsertPosition(
+		WithPair(pairBtcNusd),
+		WithTrader(alice),
+		WithMargin(sdk.NewDec(1_500)),
+		WithSize(sdk.NewDec(10_000)),
+		WithOpenNotional(sdk.NewDec(10_000)),
+	)
+
+	tc := TestCases{
+		TC("opening a position under the initial margin ratio fails").
+			Given(
+				market,
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(2_000)))),
+			).
+			When(
+				MoveToNextBlock(),
+			).
+			Then(
+				MarketOrderFails(alice, pairBtcNusd, types.Direction_LONG, sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroDec(),
+					types.ErrMarginRatioTooLow),
+				MarketOrderFails(alice, pairBtcNusd, types.Direction_SHORT, sdk.NewInt(1_000), sdk.NewDec(6), sdk.ZeroDec(),
+					types.ErrMarginRatioTooLow),
+				MarketOrder(alice, pairBtcNusd, types.Direction_LONG, sdk.NewInt(1_000), sdk.NewDec(4), sdk.ZeroDec()),
+				PositionShouldExist(alice, pairBtcNusd, 1),
+			),
+
+		TC("increasing a position under the initial margin ratio fails").
+			Given(
+				market,
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(2_000)))),
+				position,
+			).
+			When(
+				MoveToNextBlock(),
+			).
+			Then(
+				MarketOrderFails(alice, pairBtcNusd, types.Direction_LONG, sdk.NewInt(100), sdk.OneDec(), sdk.ZeroDec(),
+					types.ErrMarginRatioTooLow),
+			),
+
+		TC("reducing a position only needs the maintenance margin ratio").
+			Given(
+				market,
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(2_000)))),
+				position,
+			).
+			When(
+				MoveToNextBlock(),
+				MarketOrder(alice, pairBtcNusd, types.Direction_SHORT, sdk.NewInt(100), sdk.OneDec(), sdk.ZeroDec()),
+			).
+			Then(
+				PositionShouldExist(alice, pairBtcNusd, 1),
+			),
+
+		TC("flipping a position under the initial margin ratio fails").
+			Given(
+				market,
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(2_000)))),
+				position,
+			).
+			When(
+				MoveToNextBlock(),
+			).
+			Then(
+				MarketOrderFails(alice, pairBtcNusd, types.Direction_SHORT, sdk.NewInt(1_100), sdk.NewDec(10), sdk.ZeroDec(),
+					types.ErrMarginRatioTooLow),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestPriceFluctuationLimit(t *testing.T) {
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	pairBtcNusd := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	// Opening the position moves the mark price from 1 to 1.21, which is allowed
+	// because there is no snapshot from a previous block yet. Moving to the next
+	// block first aligns the block time used by the end blocker snapshots.
+	openPosition := []Action{
+		MoveToNextBlock(),
+		CreateCustomMarket(pairBtcNusd,
+			WithEnabled(true),
+			WithPricePeg(sdk.OneDec()),
+			WithSqrtDepth(sdk.NewDec(100_000)),
+			WithPriceFluctuationLimit(sdk.MustNewDecFromStr("0.1")),
+		),
+		FundAccount(alice, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(12_000)))),
+		MarketOrder(alice, pairBtcNusd, types.Direction_LONG, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec()),
+		MoveToNextBlock(),
+	}
+
+	tc := TestCases{
+		TC("market order moving the price over the limit fails").
+			Given(openPosition...).
+			When().
+			Then(
+				MarketOrderFails(alice, pairBtcNusd, types.Direction_LONG, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec(),
+					types.ErrOverFluctuationLimit),
+			),
+
+		TC("market order moving the price within the limit succeeds").
+			Given(openPosition...).
+			When(
+				MarketOrder(alice, pairBtcNusd, types.Direction_LONG, sdk.NewInt(1_000), sdk.OneDec(), sdk.ZeroDec()),
+			).
+			Then(
+				PositionShouldExist(alice, pairBtcNusd, 1),
+			),
+
+		TC("flipping the position over the limit fails").
+			Given(openPosition...).
+			When(
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(20_000)))),
+			).
+			Then(
+				MarketOrderFails(alice, pairBtcNusd, types.Direction_SHORT, sdk.NewInt(20_000), sdk.OneDec(), sdk.ZeroDec(),
+					types.ErrOverFluctuationLimit),
+			),
+
+		TC("reducing the position over the limit succeeds").
+			Given(openPosition...).
+			When(
+				MarketOrder(alice, pairBtcNusd, types.Direction_SHORT, sdk.NewInt(9_000), sdk.OneDec(), sdk.ZeroDec()),
+			).
+			Then(
+				PositionShouldExist(alice, pairBtcNusd, 1),
+			),
+
+		TC("partially closing the position over the limit succeeds").
+			Given(openPosition...).
+			When(
+				PartialClose(alice, pairBtcNusd, sdk.NewDec(8_000)),
+			).
+			Then(
+				PositionShouldExist(alice, pairBtcNusd, 1),
+			),
+
+		TC("closing the position over the limit succeeds").
+			Given(openPosition...).
+			When(
+				ClosePosition(alice, pairBtcNusd),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcNusd, 1),
+			),
+
+		TC("a conditional order closing the position over the limit executes").
+			Given(openPosition...).
+			When(
+				PlaceConditionalOrder(alice, pairBtcNusd, types.TriggerCondition_PRICE_AT_OR_ABOVE,
+					sdk.OneDec(), sdk.ZeroDec(), sdk.NewInt(100), 1),
+				ExecuteConditionalOrder(bob, 1),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcNusd, 1),
+			),
+
+		TC("zero limit disables the check").
+			Given(
+				MoveToNextBlock(),
+				CreateCustomMarket(pairBtcNusd,
+					WithEnabled(true),
+					WithPricePeg(sdk.OneDec()),
+					WithSqrtDepth(sdk.NewDec(100_000)),
+				),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(21_000)))),
+				MarketOrder(alice, pairBtcNusd, types.Direction_LONG, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec()),
+				MoveToNextBlock(),
+			).
+			When(
+				MarketOrder(alice, pairBtcNusd, types.Direction_LONG, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec()),
+			).
+			Then(
+				ClosePosition(alice, pairBtcNusd),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/perp/v2/types"
+)
+
+// PlaceConditionalOrder stores a conditional order on the trader's position in
+// the given market. The execution fee is escrowed in the perp module account
+// until the order is executed or cancelled.
+//
+// args:
+//   - ctx: cosmos-sdk context
+//   - trader: the owner of the position
+//   - pair: the market of the position
+//   - condition: whether the order triggers above or below the trigger price
+//   - triggerPrice: the mark price TWAP that triggers the order
+//   - size: unsigned base amount to close, zero closes the whole position
+//   - executionFee: collateral paid to the executor of the order
+//
+// ret:
+//   - orderID: the id of the new order
+//   - err: error
+func (k Keeper) PlaceConditionalOrder(
+	ctx sdk.Context,
+	trader sdk.AccAddress,
+	pair asset.Pair,
+	condition types.TriggerCondition,
+	triggerPrice sdk.Dec,
+	size sdk.Dec,
+	executionFee sdkmath.Int,
+) (orderID uint64, err error) {
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return 0, types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	if _, err = k.GetPosition(ctx, pair, market.Version, trader); err != nil {
+		return 0, err
+	}
+
+	if k.numConditionalOrders(ctx, trader) >= types.MaxConditionalOrdersPerTrader {
+		return 0, types.ErrTooManyConditionalOrders.Wrapf(
+			"max %d open orders", types.MaxConditionalOrdersPerTrader)
+	}
+
+	collateral, err := k.Collateral.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	fee := sdk.NewCoin(collateral, executionFee)
+	if fee.IsPositive() {
+		if err = k.BankKeeper.SendCoinsFromAccountToModule(
+			ctx, trader, types.ModuleName, sdk.NewCoins(fee),
+		); err != nil {
+			return 0, err
+		}
+	}
+
+	order := types.ConditionalOrder{
+		Id:               k.NextConditionalOrderID.Next(ctx),
+		Trader:           trader.String(),
+		Pair:             pair,
+		TriggerCondition: condition,
+		TriggerPrice:     triggerPrice,
+		Size_:            size,
+		ExecutionFee:     fee,
+	}
+	if err = order.Validate(); err != nil {
+		return 0, err
+	}
+	k.saveConditionalOrder(ctx, trader, order)
+
+	return order.Id, nil
+}
+
+// CancelConditionalOrder removes an order of the trader and refunds its
+// execution fee.
+func (k Keeper) CancelConditionalOrder(ctx sdk.Context, trader sdk.AccAddress, orderID uint64) error {
+	order, err := k.ConditionalOrders.Get(ctx, orderID)
+	if err != nil {
+		return types.ErrConditionalOrderNotFound.Wrapf("order id: %d", orderID)
+	}
+	if order.Trader != trader.String() {
+		return sdkerrors.ErrUnauthorized.Wrapf("order %d is not owned by %s", orderID, trader)
+	}
+
+	if _, err = k.payExecutionFee(ctx, trader, order); err != nil {
+		return err
+	}
+	k.deleteConditionalOrder(ctx, trader, orderID)
+
+	return nil
+}
+
+// ExecuteConditionalOrder reduces the position of a triggered order and pays
+// the execution fee to the executor. Orders trigger on the mark price TWAP
+// rather than the instantaneous mark price, which the executor could move
+// within a single tx. If the position the order applies to no longer exists,
+// the order is removed and the executor is paid for the cleanup.
+//
+// ret:
+//   - exchangedSize: the amount of base assets exchanged
+//   - executionFee: the fee paid to the executor
+//   - err: error
+func (k Keeper) ExecuteConditionalOrder(
+	ctx sdk.Context, executor sdk.AccAddress, orderID uint64,
+) (exchangedSize sdk.Dec, executionFee sdk.Coin, err error) {
+	order, err := k.ConditionalOrders.Get(ctx, orderID)
+	if err != nil {
+		return sdk.Dec{}, sdk.Coin{}, types.ErrConditionalOrderNotFound.Wrapf("order id: %d", orderID)
+	}
+	trader := sdk.MustAccAddressFromBech32(order.Trader)
+
+	market, err := k.GetMarket(ctx, order.Pair)
+	if err != nil {
+		return sdk.Dec{}, sdk.Coin{}, types.ErrPairNotFound.Wrapf("pair: %s", order.Pair)
+	}
+	markTwap, err := k.CalcTwap(
+		ctx, order.Pair, types.TwapCalcOption_SPOT, types.Direction_DIRECTION_UNSPECIFIED,
+		sdk.ZeroDec(), market.TwapLookbackWindow,
+	)
+	if err != nil {
+		return sdk.Dec{}, sdk.Coin{}, err
+	}
+
+	exchangedSize = sdk.ZeroDec()
+	position, err := k.GetPosition(ctx, order.Pair, market.Version, trader)
+	hasPosition := err == nil && !position.Size_.IsZero()
+	if hasPosition && !order.IsTriggered(markTwap) {
+		return sdk.Dec{}, sdk.Coin{}, types.ErrConditionalOrderNotTriggered.Wrapf(
+			"order id: %d, mark price twap: %s, trigger price: %s", orderID, markTwap, order.TriggerPrice)
+	}
+
+	// Remove the order before touching the position, so that closing the
+	// position does not refund its execution fee to the trader.
+	k.deleteConditionalOrder(ctx, trader, orderID)
+
+	if hasPosition {
+		var positionResp *types.PositionResp
+		if order.Size_.IsZero() || order.Size_.GTE(position.Size_.Abs()) {
+			positionResp, err = k.ClosePosition(ctx, order.Pair, trader)
+		} else {
+			positionResp, err = k.PartialClose(ctx, order.Pair, trader, order.Size_)
+		}
+		if err != nil {
+			return sdk.Dec{}, sdk.Coin{}, err
+		}
+		exchangedSize = positionResp.ExchangedPositionSize
+	}
+
+	executionFee, err = k.payExecutionFee(ctx, executor, order)
+	if err != nil {
+		return sdk.Dec{}, sdk.Coin{}, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.ConditionalOrderExecutedEvent{
+		Order:     order,
+		Executor:  executor.String(),
+		MarkPrice: markTwap,
+	})
+
+	return exchangedSize, executionFee, nil
+}
+
+// GetConditionalOrders returns the open conditional orders of a trader.
+func (k Keeper) GetConditionalOrders(ctx sdk.Context, trader sdk.AccAddress) []types.ConditionalOrder {
+	iter := k.TraderConditionalOrders.Iterate(ctx, collections.PairRange[sdk.AccAddress, uint64]{}.Prefix(trader))
+	defer iter.Close()
+
+	var orders []types.ConditionalOrder
+	for ; iter.Valid(); iter.Next() {
+		orders = append(orders, k.ConditionalOrders.GetOr(ctx, iter.Key().K2(), types.ConditionalOrder{}))
+	}
+	return orders
+}
+
+// cancelPositionConditionalOrders removes the orders of the trader on the pair
+// and refunds their execution fees. It is called when the position is deleted,
+// so that the orders of a closed position can not act on a later position.
+func (k Keeper) cancelPositionConditionalOrders(ctx sdk.Context, trader sdk.AccAddress, pair asset.Pair) error {
+	for _, order := range k.GetConditionalOrders(ctx, trader) {
+		if order.Pair != pair {
+			continue
+		}
+		if _, err := k.payExecutionFee(ctx, trader, order); err != nil {
+			return err
+		}
+		k.deleteConditionalOrder(ctx, trader, order.Id)
+	}
+	return nil
+}
+
+func (k Keeper) numConditionalOrders(ctx sdk.Context, trader sdk.AccAddress) int {
+	return len(k.TraderConditionalOrders.Iterate(
+		ctx, collections.PairRange[sdk.AccAddress, uint64]{}.Prefix(trader)).Keys())
+}
+
+func (k Keeper) saveConditionalOrder(ctx sdk.Context, trader sdk.AccAddress, order types.ConditionalOrder) {
+	k.ConditionalOrders.Insert(ctx, order.Id, order)
+	k.TraderConditionalOrders.Insert(ctx, collections.Join(trader, order.Id))
+}
+
+func (k Keeper) deleteConditionalOrder(ctx sdk.Context, trader sdk.AccAddress, orderID uint64) {
+	_ = k.ConditionalOrders.Delete(ctx, orderID)
+	k.TraderConditionalOrders.Delete(ctx, collections.Join(trader, orderID))
+}
+
+// payExecutionFee sends the escrowed execution fee of the order to the
+// recipient.
+func (k Keeper) payExecutionFee(
+	ctx sdk.Context, recipient sdk.AccAddress, order types.ConditionalOrder,
+) (sdk.Coin, error) {
+	if order.ExecutionFee.IsZero() {
+		return order.ExecutionFee, nil
+	}
+	return order.ExecutionFee, k.BankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.ModuleName, recipient, sdk.NewCoins(order.ExecutionFee))
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/assertion"
+	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/action"
+	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/assertion"
+	"github.com/NibiruChain/nibiru/x/perp/v2/types"
+)
+
+func TestConditionalOrders(t *testing.T) {
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	pairBtcNusd := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	above := types.TriggerCondition_PRICE_AT_OR_ABOVE
+	below := types.TriggerCondition_PRICE_AT_OR_BELOW
+
+	openLongPosition := func() []Action {
+		return []Action{
+			DnREpochIs(1),
+			CreateCustomMarket(
+				pairBtcNusd,
+				WithEnabled(true),
+				WithPricePeg(sdk.OneDec()),
+				WithSqrtDepth(sdk.NewDec(100_000)),
+			),
+			FundAccount(alice, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(11_000)))),
+			FundModule(types.PerpFundModuleAccount, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(100_000_000)))),
+			MarketOrder(alice, pairBtcNusd, types.Direction_LONG, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec()),
+		}
+	}
+
+	tests := TestCases{
+		TC("triggered order closes the position and pays the executor").
+			Given(openLongPosition()...).
+			When(
+				PlaceConditionalOrder(alice, pairBtcNusd, above, sdk.OneDec(), sdk.ZeroDec(), sdk.NewInt(100), 1),
+				BalanceEqual(alice, types.TestingCollateralDenomNUSD, sdk.NewInt(900)),
+				ExecuteConditionalOrder(bob, 1),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcNusd, 1),
+				BalanceEqual(bob, types.TestingCollateralDenomNUSD, sdk.NewInt(100)),
+				QueryConditionalOrders(alice),
+			),
+
+		TC("triggered order partially closes the position").
+			Given(openLongPosition()...).
+			When(
+				PlaceConditionalOrder(alice, pairBtcNusd, above, sdk.OneDec(), sdk.NewDec(1_000), sdk.NewInt(100), 1),
+				ExecuteConditionalOrder(bob, 1),
+			).
+			Then(
+				PositionShouldExist(alice, pairBtcNusd, 1),
+				BalanceEqual(bob, types.TestingCollateralDenomNUSD, sdk.NewInt(100)),
+				QueryConditionalOrders(alice),
+			),
+
+		TC("order cannot be executed before it is triggered").
+			Given(openLongPosition()...).
+			When(
+				PlaceConditionalOrder(alice, pairBtcNusd, below, sdk.MustNewDecFromStr("0.5"), sdk.ZeroDec(), sdk.NewInt(100), 1),
+				ExecuteConditionalOrderFails(bob, 1, types.ErrConditionalOrderNotTriggered),
+			).
+			Then(
+				PositionShouldExist(alice, pairBtcNusd, 1),
+				QueryConditionalOrders(alice, 1),
+			),
+
+		TC("trader cancels the order and recovers the execution fee").
+			Given(openLongPosition()...).
+			When(
+				PlaceConditionalOrder(alice, pairBtcNusd, below, sdk.MustNewDecFromStr("0.5"), sdk.ZeroDec(), sdk.NewInt(100), 1),
+				CancelConditionalOrderFails(bob, 1, sdkerrors.ErrUnauthorized),
+				CancelConditionalOrder(alice, 1),
+			).
+			Then(
+				BalanceEqual(alice, types.TestingCollateralDenomNUSD, sdk.NewInt(1_000)),
+				QueryConditionalOrders(alice),
+				CancelConditionalOrderFails(alice, 1, types.ErrConditionalOrderNotFound),
+				ExecuteConditionalOrderFails(bob, 1, types.ErrConditionalOrderNotFound),
+			),
+
+		TC("closing the position cancels its orders and refunds the execution fee").
+			Given(openLongPosition()...).
+			When(
+				PlaceConditionalOrder(alice, pairBtcNusd, below, sdk.MustNewDecFromStr("0.5"), sdk.ZeroDec(), sdk.NewInt(100), 1),
+				ClosePosition(alice, pairBtcNusd),
+			).
+			Then(
+				QueryConditionalOrders(alice),
+				ExecuteConditionalOrderFails(bob, 1, types.ErrConditionalOrderNotFound),
+				BalanceEqual(bob, types.TestingCollateralDenomNUSD, sdk.ZeroInt()),
+			),
+
+		TC("order of a closed position can not close a reopened position").
+			Given(openLongPosition()...).
+			When(
+				PlaceConditionalOrder(alice, pairBtcNusd, above, sdk.OneDec(), sdk.ZeroDec(), sdk.NewInt(100), 1),
+				ClosePosition(alice, pairBtcNusd),
+				MarketOrder(alice, pairBtcNusd, types.Direction_SHORT, sdk.NewInt(1_000), sdk.OneDec(), sdk.ZeroDec()),
+			).
+			Then(
+				ExecuteConditionalOrderFails(bob, 1, types.ErrConditionalOrderNotFound),
+				PositionShouldExist(alice, pairBtcNusd, 1),
+			),
+
+		TC("liquidating the position cancels its orders").
+			Given(
+				CreateCustomMarket(pairBtcNusd),
+				InsertPosition(WithTrader(alice), WithPair(pairBtcNusd), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10600))),
+				FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(100)))),
+				PlaceConditionalOrder(alice, pairBtcNusd, below, sdk.MustNewDecFromStr("0.5"), sdk.ZeroDec(), sdk.NewInt(100), 1),
+			).
+			When(
+				MoveToNextBlock(),
+				MultiLiquidate(bob, false,
+					PairTraderTuple{Pair: pairBtcNusd, Trader: alice, Successful: true},
+				),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcNusd, 1),
+				QueryConditionalOrders(alice),
+				BalanceEqual(alice, types.TestingCollateralDenomNUSD, sdk.NewInt(100)),
+			),
+
+		TC("orders require an open position").
+			Given(openLongPosition()...).
+			When(
+				PlaceConditionalOrderFails(bob, pairBtcNusd, above, sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroInt(), types.ErrPositionNotFound),
+			).
+			Then(
+				QueryConditionalOrders(bob),
+			),
+
+		TC("number of open orders per trader is bounded").
+			Given(openLongPosition()...).
+			When(
+				PlaceConditionalOrder(alice, pairBtcNusd, below, sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroInt(), 1),
+				PlaceConditionalOrder(alice, pairBtcNusd, below, sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroInt(), 2),
+				PlaceConditionalOrder(alice, pairBtcNusd, below, sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroInt(), 3),
+				PlaceConditionalOrder(alice, pairBtcNusd, below, sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroInt(), 4),
+				PlaceConditionalOrder(alice, pairBtcNusd, below, sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroInt(), 5),
+				PlaceConditionalOrder(alice, pairBtcNusd, below, sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroInt(), 6),
+				PlaceConditionalOrder(alice, pairBtcNusd, below, sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroInt(), 7),
+				PlaceConditionalOrder(alice, pairBtcNusd, below, sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroInt(), 8),
+				PlaceConditionalOrder(alice, pairBtcNusd, below, sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroInt(), 9),
+				PlaceConditionalOrder(alice, pairBtcNusd, below, sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroInt(), 10),
+			).
+			Then(
+				PlaceConditionalOrderFails(alice, pairBtcNusd, below, sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroInt(), types.ErrTooManyConditionalOrders),
+				QueryConditionalOrders(alice, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tests...).Run()
+}
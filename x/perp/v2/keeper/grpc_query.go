@@ -40,23 +40,41 @@ func (q queryServer) QueryPositions(
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := storeprefix.NewStore(ctx.KVStore(q.k.storeKey), NamespaceMarkets.Prefix())
 
-	markets := q.k.Markets.Iterate(ctx, collections.Range[collections.Pair[asset.Pair, uint64]]{}).Values()
+	pagination, err := common.ParsePaginationOrAll(req.Pagination)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
 
+	// Pages are taken over the markets, counting only the ones in which the
+	// trader has a position.
 	var positions []types.QueryPositionResponse
-	for _, market := range markets {
+	pageRes, err := sdkquery.FilteredPaginate(store, pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		market := new(types.Market)
+		if err := q.k.cdc.Unmarshal(value, market); err != nil {
+			return false, grpcstatus.Error(grpccodes.Internal, err.Error())
+		}
 		amm, err := q.k.GetAMM(ctx, market.Pair)
 		if err != nil {
-			return nil, err
+			return false, err
 		}
-		position, err := q.position(ctx, market.Pair, traderAddr, market, amm)
-		if err == nil {
+		position, err := q.position(ctx, market.Pair, traderAddr, *market, amm)
+		if err != nil {
+			return false, nil
+		}
+		if accumulate {
 			positions = append(positions, position)
 		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &types.QueryPositionsResponse{
-		Positions: positions,
+		Positions:  positions,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -166,29 +184,51 @@ func (q queryServer) ModuleAccounts(
 func (q queryServer) QueryMarkets(
 	goCtx context.Context, req *types.QueryMarketsRequest,
 ) (*types.QueryMarketsResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := storeprefix.NewStore(ctx.KVStore(q.k.storeKey), NamespaceMarkets.Prefix())
+
+	pagination, err := common.ParsePaginationOrAll(req.Pagination)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
 
 	var ammMarkets []types.AmmMarket
-	markets := q.k.Markets.Iterate(ctx, collections.Range[collections.Pair[asset.Pair, uint64]]{}).Values()
-	for _, market := range markets {
+	pageRes, err := sdkquery.FilteredPaginate(store, pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		market := new(types.Market)
+		if err := q.k.cdc.Unmarshal(value, market); err != nil {
+			return false, grpcstatus.Error(grpccodes.Internal, err.Error())
+		}
 		// disabled markets are not returned
 		if !req.Versioned && !market.Enabled {
-			continue
+			return false, nil
+		}
+		if !accumulate {
+			return true, nil
 		}
 
-		pair := market.Pair
-		amm, err := q.k.AMMs.Get(ctx, collections.Join(pair, market.Version))
+		amm, err := q.k.AMMs.Get(ctx, collections.Join(market.Pair, market.Version))
 		if err != nil {
-			return nil, err
+			return false, err
 		}
-		duo := types.AmmMarket{
+		ammMarkets = append(ammMarkets, types.AmmMarket{
 			Amm:    amm,
-			Market: market,
-		}
-		ammMarkets = append(ammMarkets, duo)
+			Market: *market,
+			IsOpen: market.IsOpen(ctx.BlockTime()),
+		})
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return &types.QueryMarketsResponse{AmmMarkets: ammMarkets}, nil
+	return &types.QueryMarketsResponse{
+		AmmMarkets: ammMarkets,
+		Pagination: pageRes,
+	}, nil
 }
 
 func (q queryServer) QueryCollateral(
@@ -200,3 +240,159 @@ func (q queryServer) QueryCollateral(
 		CollateralDenom: denom,
 	}, nil
 }
+
+func (q queryServer) QueryTraderStats(
+	goCtx context.Context, req *types.QueryTraderStatsRequest,
+) (*types.QueryTraderStatsResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	stats := q.k.TraderStats.GetOr(ctx, collections.Join(req.Epoch, traderAddr), types.TraderStats{
+		Volume:      sdk.ZeroInt(),
+		RealizedPnl: sdk.ZeroDec(),
+		Fees:        sdk.ZeroInt(),
+	})
+	return &types.QueryTraderStatsResponse{Stats: stats}, nil
+}
+
+// QueryLeaderboard ranks the traders of an epoch. Pages are addressed by
+// offset, so that entries keep their rank. The next key returned in the page
+// response encodes the offset of the next page.
+func (q queryServer) QueryLeaderboard(
+	goCtx context.Context, req *types.QueryLeaderboardRequest,
+) (*types.QueryLeaderboardResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+	if _, ok := types.LeaderboardRanking_name[int32(req.RankBy)]; !ok {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "invalid ranking %d", req.RankBy)
+	}
+
+	pagination, _, err := common.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	offset := pagination.Offset
+	if pagination.Key != nil {
+		if len(pagination.Key) != 8 {
+			return nil, grpcstatus.Error(grpccodes.InvalidArgument, "invalid pagination key")
+		}
+		offset = sdk.BigEndianToUint64(pagination.Key)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	entries, total := q.k.GetLeaderboard(ctx, req.Epoch, req.RankBy, offset, pagination.Limit, pagination.Reverse)
+
+	pageRes := &sdkquery.PageResponse{}
+	if end := offset + uint64(len(entries)); end < total {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(end)
+	}
+	if pagination.CountTotal {
+		pageRes.Total = total
+	}
+
+	return &types.QueryLeaderboardResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q queryServer) QueryConditionalOrders(
+	goCtx context.Context, req *types.QueryConditionalOrdersRequest,
+) (*types.QueryConditionalOrdersResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryConditionalOrdersResponse{
+		Orders: q.k.GetConditionalOrders(ctx, traderAddr),
+	}, nil
+}
+
+func (q queryServer) QueryMarketPrice(
+	goCtx context.Context, req *types.QueryMarketPriceRequest,
+) (*types.QueryMarketPriceResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	market, err := q.k.GetMarket(ctx, req.Pair)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.NotFound, err.Error())
+	}
+	amm, err := q.k.GetAMM(ctx, req.Pair)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.NotFound, err.Error())
+	}
+
+	lookback := req.TwapLookbackWindow
+	if lookback == 0 {
+		lookback = market.TwapLookbackWindow
+	}
+	markTwap, err := q.k.CalcTwap(ctx, req.Pair, types.TwapCalcOption_SPOT, types.Direction_DIRECTION_UNSPECIFIED, sdk.ZeroDec(), lookback)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QueryMarketPriceResponse{
+		MarkPrice:       amm.InstMarkPrice(),
+		MarkPriceTwap:   markTwap,
+		BaseReserve:     amm.BaseReserve,
+		QuoteReserve:    amm.QuoteReserve,
+		PriceMultiplier: amm.PriceMultiplier,
+	}, nil
+}
+
+func (q queryServer) QuerySwapQuote(
+	goCtx context.Context, req *types.QuerySwapQuoteRequest,
+) (*types.QuerySwapQuoteResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "nil request")
+	}
+	if req.Dir != types.Direction_LONG && req.Dir != types.Direction_SHORT {
+		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "invalid direction %s", req.Dir)
+	}
+	if (req.QuoteAssetAmount == nil) == (req.BaseAssetAmount == nil) {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "exactly one of quote_asset_amount and base_asset_amount must be set")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	amm, err := q.k.GetAMM(ctx, req.Pair)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.NotFound, err.Error())
+	}
+
+	var quoteAssetAmt, baseAssetAmt sdk.Dec
+	if req.QuoteAssetAmount != nil {
+		quoteAssetAmt = *req.QuoteAssetAmount
+		baseAssetAmt, err = amm.BaseAssetForQuote(quoteAssetAmt, req.Dir)
+	} else {
+		baseAssetAmt = *req.BaseAssetAmount
+		quoteAssetAmt, err = amm.QuoteAssetForBase(baseAssetAmt, req.Dir)
+	}
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	averagePrice := sdk.ZeroDec()
+	if baseAssetAmt.IsPositive() {
+		averagePrice = quoteAssetAmt.Quo(baseAssetAmt)
+	}
+	return &types.QuerySwapQuoteResponse{
+		QuoteAssetAmount: quoteAssetAmt,
+		BaseAssetAmount:  baseAssetAmt,
+		AveragePrice:     averagePrice,
+	}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
@@ -385,6 +386,20 @@ func TestQueryMarkets(t *testing.T) {
 		).Then(
 			QueryMarkets(true, QueryMarkets_ShouldLength(3)),
 		),
+		TC("paginated, pages skip inactive markets").Given(
+			CreateCustomMarket("BTC:USD", WithVersion(1), WithEnabled(true)),
+			CreateCustomMarket("ETC:USD", WithVersion(1), WithEnabled(false)),
+			CreateCustomMarket("ETC:USD", WithVersion(2), WithEnabled(true)),
+			CreateCustomMarket("ETH:USD", WithVersion(1), WithEnabled(true)),
+		).Then(
+			QueryMarketsPage(false, &sdkquery.PageRequest{Limit: 2}, QueryMarkets_ShouldLength(2)),
+			QueryMarketsPage(false, &sdkquery.PageRequest{Limit: 2, Offset: 2}, QueryMarkets_ShouldLength(1)),
+			QueryMarketsPage(true, &sdkquery.PageRequest{Limit: 2, Offset: 2}, QueryMarkets_ShouldLength(2)),
+			QueryMarketsPage(false, &sdkquery.PageRequest{Limit: 1, Reverse: true},
+				QueryMarkets_ShouldLength(1),
+				QueryMarkets_MarketsShouldContain(types.DefaultMarket("ETH:USD").WithEnabled(true)),
+			),
+		),
 	}
 
 	NewTestSuite(t).WithTestCases(tc...).Run()
@@ -486,3 +501,84 @@ func TestQueryCollateral(t *testing.T) {
 
 	NewTestSuite(t).WithTestCases(tc...).Run()
 }
+
+func TestQueryMarketPrice(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	startTime := time.Now()
+
+	tc := TestCases{
+		TC("mark price and TWAP of a pegged market").
+			Given(
+				SetBlockTime(startTime),
+				CreateCustomMarket(pair, WithEnabled(true), WithPricePeg(sdk.NewDec(2))),
+			).
+			When(
+				MoveToNextBlock(),
+			).
+			Then(
+				QueryMarketPrice(
+					types.QueryMarketPriceRequest{Pair: pair},
+					types.QueryMarketPriceResponse{
+						MarkPrice:       sdk.NewDec(2),
+						MarkPriceTwap:   sdk.NewDec(2),
+						BaseReserve:     sdk.NewDec(1e12),
+						QuoteReserve:    sdk.NewDec(1e12),
+						PriceMultiplier: sdk.NewDec(2),
+					},
+				),
+				QueryMarketPrice(
+					types.QueryMarketPriceRequest{Pair: pair, TwapLookbackWindow: time.Hour},
+					types.QueryMarketPriceResponse{
+						MarkPrice:       sdk.NewDec(2),
+						MarkPriceTwap:   sdk.NewDec(2),
+						BaseReserve:     sdk.NewDec(1e12),
+						QuoteReserve:    sdk.NewDec(1e12),
+						PriceMultiplier: sdk.NewDec(2),
+					},
+				),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestQuerySwapQuote(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	quoteAmt := sdk.NewDec(1e11)
+	baseAmt := sdk.NewDec(1e11)
+
+	tc := TestCases{
+		TC("quotes base for quote and quote for base").
+			Given(
+				CreateCustomMarket(pair, WithEnabled(true)),
+			).
+			Then(
+				QuerySwapQuote(
+					types.QuerySwapQuoteRequest{Pair: pair, Dir: types.Direction_LONG, QuoteAssetAmount: &quoteAmt},
+					types.QuerySwapQuoteResponse{
+						QuoteAssetAmount: quoteAmt,
+						BaseAssetAmount:  sdk.MustNewDecFromStr("90909090909.090909090909090909"),
+						AveragePrice:     sdk.MustNewDecFromStr("1.100000000000000000"),
+					},
+				),
+				QuerySwapQuote(
+					types.QuerySwapQuoteRequest{Pair: pair, Dir: types.Direction_LONG, BaseAssetAmount: &baseAmt},
+					types.QuerySwapQuoteResponse{
+						QuoteAssetAmount: sdk.MustNewDecFromStr("111111111111.111111111111111111"),
+						BaseAssetAmount:  baseAmt,
+						AveragePrice:     sdk.MustNewDecFromStr("1.111111111111111111"),
+					},
+				),
+				QuerySwapQuoteFail(types.QuerySwapQuoteRequest{Pair: pair, Dir: types.Direction_LONG}),
+				QuerySwapQuoteFail(types.QuerySwapQuoteRequest{
+					Pair: pair, Dir: types.Direction_LONG, QuoteAssetAmount: &quoteAmt, BaseAssetAmount: &baseAmt,
+				}),
+				QuerySwapQuoteFail(types.QuerySwapQuoteRequest{Pair: pair, QuoteAssetAmount: &quoteAmt}),
+				QuerySwapQuoteFail(types.QuerySwapQuoteRequest{
+					Pair: asset.Registry.Pair(denoms.ETH, denoms.NUSD), Dir: types.Direction_LONG, QuoteAssetAmount: &quoteAmt,
+				}),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
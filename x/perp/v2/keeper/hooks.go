@@ -20,7 +20,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, number ui
 	k.maybeUpdateDnREpoch(ctx, epochIdentifier, number)
 	for _, market := range k.Markets.Iterate(ctx, collections.Range[collections.Pair[asset.Pair, uint64]]{}).Values() {
 		if !market.Enabled || epochIdentifier != market.FundingRateEpochId {
-			return
+			continue
 		}
 
 		indexTwap, err := k.OracleKeeper.GetExchangeRateTwap(ctx, market.OraclePair)
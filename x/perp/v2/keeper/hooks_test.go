@@ -23,6 +23,7 @@ import (
 func TestAfterEpochEnd(t *testing.T) {
 	pairBtcUsd := asset.Registry.Pair(denoms.BTC, denoms.USD)
 	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	pairAtomUsdc := asset.Registry.Pair(denoms.ATOM, denoms.USDC)
 	startTime := time.Now()
 
 	adminUser, err := sdk.AccAddressFromBech32(testutil.ADDR_SUDO_ROOT)
@@ -43,6 +44,22 @@ func TestAfterEpochEnd(t *testing.T) {
 				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.MustNewDecFromStr("-0.099999999999999999"))),
 			),
 
+		TC("disabled market does not stop funding of other markets").
+			Given(
+				CreateCustomMarket(pairAtomUsdc, WithEnabled(false)),
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				SetBlockTime(startTime),
+				InsertOraclePriceSnapshot(pairBtcUsd, startTime.Add(15*time.Minute), sdk.MustNewDecFromStr("5.8")),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+			).
+			When(
+				MoveToNextBlockWithDuration(30*time.Minute),
+			).
+			Then(
+				MarketShouldBeEqual(pairAtomUsdc, Market_LatestCPFShouldBeEqualTo(sdk.ZeroDec())),
+				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.MustNewDecFromStr("-0.099999999999999999"))),
+			),
+
 		TC("index < mark").
 			Given(
 				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
@@ -33,21 +33,32 @@ type Keeper struct {
 	AMMs              collections.Map[collections.Pair[asset.Pair, uint64], types.AMM]
 	Collateral        collections.Item[string]
 
-	Positions              collections.Map[collections.Pair[collections.Pair[asset.Pair, uint64], sdk.AccAddress], types.Position]
-	ReserveSnapshots       collections.Map[collections.Pair[asset.Pair, time.Time], types.ReserveSnapshot]
-	DnREpoch               collections.Item[uint64]                                                    // Keeps track of the current DnR epoch.
-	DnREpochName           collections.Item[string]                                                    // Keeps track of the current DnR epoch identifier, provided by x/epoch.
-	GlobalVolumes          collections.Map[uint64, math.Int]                                           // Keeps track of global volumes for each epoch.
-	TraderVolumes          collections.Map[collections.Pair[sdk.AccAddress, uint64], math.Int]         // Keeps track of user volumes for each epoch.
-	GlobalDiscounts        collections.Map[math.Int, math.LegacyDec]                                   // maps a volume level to a discount
-	TraderDiscounts        collections.Map[collections.Pair[sdk.AccAddress, math.Int], math.LegacyDec] // maps a user and volume level to a discount, supersedes global discounts
-	EpochRebateAllocations collections.Map[uint64, types.DNRAllocation]                                // maps an epoch to a string representing the allocation of rebates for that epoch
+	Positions                      collections.Map[collections.Pair[collections.Pair[asset.Pair, uint64], sdk.AccAddress], types.Position]
+	ReserveSnapshots               collections.Map[collections.Pair[asset.Pair, time.Time], types.ReserveSnapshot]
+	NextReserveSnapshotPruneTimeMs collections.Item[uint64]                                                     // block time, in unix milliseconds, before which no reserve snapshot expires
+	DnREpoch                       collections.Item[uint64]                                                     // Keeps track of the current DnR epoch.
+	DnREpochName                   collections.Item[string]                                                     // Keeps track of the current DnR epoch identifier, provided by x/epoch.
+	GlobalVolumes                  collections.Map[uint64, math.Int]                                            // Keeps track of global volumes for each epoch.
+	TraderVolumes                  collections.Map[collections.Pair[sdk.AccAddress, uint64], math.Int]          // Keeps track of user volumes for each epoch.
+	GlobalDiscounts                collections.Map[math.Int, math.LegacyDec]                                    // maps a volume level to a discount
+	TraderDiscounts                collections.Map[collections.Pair[sdk.AccAddress, math.Int], math.LegacyDec]  // maps a user and volume level to a discount, supersedes global discounts
+	EpochRebateAllocations         collections.Map[uint64, types.DNRAllocation]                                 // maps an epoch to a string representing the allocation of rebates for that epoch
+	TraderStats                    collections.Map[collections.Pair[uint64, sdk.AccAddress], types.TraderStats] // maps an epoch and a trader to the trader's statistics for that epoch
+	Leaderboards                   collections.KeySet[leaderboardKey]                                           // indexes the trader statistics of each epoch by score, for each ranking
+	EpochTraderCounts              collections.Map[uint64, uint64]                                              // maps an epoch to the number of traders with statistics for that epoch
+
+	ConditionalOrders       collections.Map[uint64, types.ConditionalOrder]              // maps an order id to an open conditional order
+	TraderConditionalOrders collections.KeySet[collections.Pair[sdk.AccAddress, uint64]] // indexes the open conditional orders of each trader
+	NextConditionalOrderID  collections.Sequence                                         // id of the next conditional order
+
+	BlockSummary collections.Item[types.EventBlockSummary] // aggregates the activity of the current block, in the transient store
 }
 
 // NewKeeper Creates a new x/perp Keeper instance.
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
+	tStoreKey storetypes.StoreKey,
 
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
@@ -93,6 +104,10 @@ func NewKeeper(
 			collections.PairKeyEncoder(asset.PairKeyEncoder, collections.TimeKeyEncoder),
 			collections.ProtoValueEncoder[types.ReserveSnapshot](cdc),
 		),
+		NextReserveSnapshotPruneTimeMs: collections.NewItem(
+			storeKey, NamespaceNextReserveSnapshotPruneTimeMs,
+			collections.Uint64ValueEncoder,
+		),
 		DnREpoch: collections.NewItem(
 			storeKey, NamespaceDnrEpoch,
 			collections.Uint64ValueEncoder,
@@ -130,6 +145,37 @@ func NewKeeper(
 			storeKey, NamespaceDnrEpochName,
 			common.StringValueEncoder,
 		),
+		TraderStats: collections.NewMap(
+			storeKey, NamespaceTraderStats,
+			collections.PairKeyEncoder(collections.Uint64KeyEncoder, collections.AccAddressKeyEncoder),
+			collections.ProtoValueEncoder[types.TraderStats](cdc),
+		),
+		Leaderboards: collections.NewKeySet(
+			storeKey, NamespaceLeaderboards,
+			collections.PairKeyEncoder(
+				collections.PairKeyEncoder(collections.Uint64KeyEncoder, collections.Uint64KeyEncoder),
+				collections.PairKeyEncoder[sdk.Dec, sdk.AccAddress](leaderboardScoreKeyEncoder{}, collections.AccAddressKeyEncoder),
+			),
+		),
+		EpochTraderCounts: collections.NewMap(
+			storeKey, NamespaceEpochTraderCounts,
+			collections.Uint64KeyEncoder,
+			collections.Uint64ValueEncoder,
+		),
+		ConditionalOrders: collections.NewMap(
+			storeKey, NamespaceConditionalOrders,
+			collections.Uint64KeyEncoder,
+			collections.ProtoValueEncoder[types.ConditionalOrder](cdc),
+		),
+		TraderConditionalOrders: collections.NewKeySet(
+			storeKey, NamespaceTraderConditionalOrders,
+			collections.PairKeyEncoder(collections.AccAddressKeyEncoder, collections.Uint64KeyEncoder),
+		),
+		NextConditionalOrderID: collections.NewSequence(storeKey, NamespaceNextConditionalOrderID),
+		BlockSummary: collections.NewItem(
+			tStoreKey, NamespaceBlockSummary,
+			collections.ProtoValueEncoder[types.EventBlockSummary](cdc),
+		),
 	}
 }
 
@@ -147,6 +193,14 @@ const (
 	NamespaceMarketLastVersion
 	NamespaceCollateral
 	NamespaceDnrEpochName
+	NamespaceTraderStats
+	NamespaceConditionalOrders
+	NamespaceTraderConditionalOrders
+	NamespaceNextConditionalOrderID
+	NamespaceBlockSummary
+	NamespaceLeaderboards
+	NamespaceEpochTraderCounts
+	NamespaceNextReserveSnapshotPruneTimeMs
 )
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
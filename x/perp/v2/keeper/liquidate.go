@@ -310,6 +310,8 @@ func (k Keeper) executeFullLiquidation(
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	k.recordLiquidationInBlockSummary(ctx, positionResp.ExchangedNotionalValue, totalBadDebt.RoundInt())
+
 	_ = ctx.EventManager().EmitTypedEvent(&types.PositionLiquidatedEvent{
 		PositionChangedEvent: types.PositionChangedEvent{
 			FinalPosition:    positionResp.Position,
@@ -378,14 +380,15 @@ func (k Keeper) executePartialLiquidation(
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
-	err = k.distributeLiquidateRewards(ctx, market, liquidator,
-		sdk.NewCoin(collateral, feeToPerpEcosystemFund.RoundInt()),
-		sdk.NewCoin(collateral, feeToLiquidator.RoundInt()),
-	)
+	liquidatorFee = sdk.NewCoin(collateral, feeToLiquidator.RoundInt())
+	ecosystemFundFee = sdk.NewCoin(collateral, feeToPerpEcosystemFund.RoundInt())
+	err = k.distributeLiquidateRewards(ctx, market, liquidator, liquidatorFee, ecosystemFundFee)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	k.recordLiquidationInBlockSummary(ctx, positionResp.ExchangedNotionalValue, sdk.ZeroInt())
+
 	_ = ctx.EventManager().EmitTypedEvent(&types.PositionLiquidatedEvent{
 		PositionChangedEvent: types.PositionChangedEvent{
 			FinalPosition:    positionResp.Position,
@@ -399,8 +402,8 @@ func (k Keeper) executePartialLiquidation(
 			ChangeReason:     types.ChangeReason_PartialLiquidation,
 		},
 		LiquidatorAddress:  liquidator.String(),
-		FeeToLiquidator:    sdk.NewCoin(collateral, feeToLiquidator.RoundInt()),
-		FeeToEcosystemFund: sdk.NewCoin(collateral, feeToPerpEcosystemFund.RoundInt()),
+		FeeToLiquidator:    liquidatorFee,
+		FeeToEcosystemFund: ecosystemFundFee,
 	})
 
 	return liquidatorFee, ecosystemFundFee, err
@@ -12,6 +12,7 @@ import (
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
 	. "github.com/NibiruChain/nibiru/x/common/testutil/assertion"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/action"
 	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/assertion"
 
@@ -312,6 +313,37 @@ func TestMultiLiquidate(t *testing.T) {
 	NewTestSuite(t).WithTestCases(tc...).Run()
 }
 
+func TestPartialLiquidationResponseFees(t *testing.T) {
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	alice := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+
+	app, ctx := testapp.NewNibiruTestAppAndContextAtTime(time.UnixMilli(0))
+	for _, given := range []Action{
+		SetBlockNumber(1),
+		CreateCustomMarket(pairBtcUsdc),
+		InsertPosition(WithTrader(alice), WithPair(pairBtcUsdc), WithSize(sdk.NewDec(10000)), WithMargin(sdk.NewDec(1000)), WithOpenNotional(sdk.NewDec(10400))),
+		FundModule(types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 1000))),
+		MoveToNextBlock(),
+	} {
+		var err error
+		ctx, err = given.Do(app, ctx)
+		require.NoError(t, err)
+	}
+
+	resps, err := app.PerpKeeperV2.MultiLiquidate(ctx, liquidator, []*types.MsgMultiLiquidate_Liquidation{
+		{Pair: pairBtcUsdc, Trader: alice.String()},
+	})
+	require.NoError(t, err)
+	require.Len(t, resps, 1)
+	require.True(t, resps[0].Success)
+
+	// the response reports the fees paid by the partial liquidation
+	require.Equal(t, sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 125), *resps[0].LiquidatorFee)
+	require.Equal(t, sdk.NewInt64Coin(types.TestingCollateralDenomNUSD, 125), *resps[0].PerpEfFee)
+	require.Equal(t, sdk.NewInt(125), app.BankKeeper.GetBalance(ctx, liquidator, types.TestingCollateralDenomNUSD).Amount)
+}
+
 func TestPrettyLiquidateResponse(t *testing.T) {
 	type TestCase struct {
 		name        string
@@ -170,7 +170,8 @@ func (k Keeper) RemoveMargin(
 	position.LatestCumulativePremiumFraction = market.LatestCumulativePremiumFraction
 	position.LastUpdatedBlockNumber = ctx.BlockHeight()
 
-	err = k.checkMarginRatio(ctx, market, amm, position)
+	// the position must keep the margin it could have been opened with
+	err = k.checkMarginRatio(ctx, market, amm, position, market.InitialMarginRatio)
 	if err != nil {
 		return nil, err
 	}
@@ -232,6 +232,25 @@ func TestRemoveMargin(t *testing.T) {
 				ModuleBalanceEqual(types.FeePoolModuleAccount, types.TestingCollateralDenomNUSD, sdk.OneInt()),
 			),
 
+		TC("existing long position, removing margin below the initial margin ratio fails").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(1000)))),
+				MarketOrder(alice, pairBtcUsdc, types.Direction_LONG, sdk.NewInt(1000), sdk.OneDec(), sdk.ZeroDec()),
+				MoveToNextBlock(),
+			).
+			When(
+				// a margin ratio of ~0.098 is above the maintenance margin ratio
+				// (0.0625) but below the initial margin ratio (1 / 10x leverage)
+				RemoveMarginFail(alice, pairBtcUsdc, sdk.NewInt(900), types.ErrMarginRatioTooLow),
+				RemoveMargin(alice, pairBtcUsdc, sdk.NewInt(890)),
+			).
+			Then(
+				BalanceEqual(alice, types.TestingCollateralDenomNUSD, sdk.NewInt(890)),
+			),
+
 		TC("existing short position, remove margin").
 			Given(
 				CreateCustomMarket(pairBtcUsdc, WithEnabled(true)),
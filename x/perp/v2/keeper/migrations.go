@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+)
+
+// Migrator runs the in-place store migrations of the perp module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate3to4 sets the initial margin ratio of the markets stored before the
+// field existed, or stored with a ratio below the minimum Market.Validate
+// accepts, to the larger of their maintenance margin ratio and 1 / max
+// leverage. Market orders needed both until then. Sudoers raise it with
+// MsgSetMarketRiskParams.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	for _, market := range m.keeper.Markets.Iterate(ctx, collections.Range[collections.Pair[asset.Pair, uint64]]{}).Values() {
+		minRatio := market.MaintenanceMarginRatio
+		if market.MaxLeverage.IsPositive() {
+			minRatio = sdk.MaxDec(minRatio, sdk.OneDec().Quo(market.MaxLeverage))
+		}
+		if !market.InitialMarginRatio.IsNil() && market.InitialMarginRatio.GTE(minRatio) {
+			continue
+		}
+		market.InitialMarginRatio = minRatio
+		m.keeper.SaveMarket(ctx, market)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/perp/v2/keeper"
+	"github.com/NibiruChain/nibiru/x/perp/v2/types"
+)
+
+func TestMigrate3to4(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+
+	// a market stored before the initial margin ratio existed
+	oldMarket := types.DefaultMarket(asset.Registry.Pair(denoms.ETH, denoms.NUSD))
+	oldMarket.InitialMarginRatio = sdk.Dec{}
+	app.PerpKeeperV2.SaveMarket(ctx, oldMarket)
+
+	// one whose max leverage asked for more than its maintenance margin ratio
+	lowLeverageMarket := types.DefaultMarket(asset.Registry.Pair(denoms.BTC, denoms.NUSD)).
+		WithMaxLeverage(sdk.NewDec(5))
+	lowLeverageMarket.InitialMarginRatio = sdk.Dec{}
+	app.PerpKeeperV2.SaveMarket(ctx, lowLeverageMarket)
+
+	// a market that already has one is left unchanged
+	newMarket := types.DefaultMarket(asset.Registry.Pair(denoms.ATOM, denoms.NUSD)).
+		WithInitialMarginRatio(sdk.MustNewDecFromStr("0.2"))
+	app.PerpKeeperV2.SaveMarket(ctx, newMarket)
+
+	require.NoError(t, keeper.NewMigrator(app.PerpKeeperV2).Migrate3to4(ctx))
+
+	market, err := app.PerpKeeperV2.GetMarketByPairAndVersion(ctx, oldMarket.Pair, oldMarket.Version)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MaxDec(oldMarket.MaintenanceMarginRatio, sdk.OneDec().Quo(oldMarket.MaxLeverage)),
+		market.InitialMarginRatio)
+	require.NoError(t, market.Validate())
+
+	market, err = app.PerpKeeperV2.GetMarketByPairAndVersion(ctx, lowLeverageMarket.Pair, lowLeverageMarket.Version)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), market.InitialMarginRatio)
+	require.NoError(t, market.Validate())
+
+	market, err = app.PerpKeeperV2.GetMarketByPairAndVersion(ctx, newMarket.Pair, newMarket.Version)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), market.InitialMarginRatio)
+}
@@ -239,3 +239,75 @@ func (m msgServer) CloseMarket(ctx context.Context, msg *types.MsgCloseMarket) (
 	err := m.k.Sudo().CloseMarket(sdk.UnwrapSDKContext(ctx), msg.Pair, sender)
 	return &types.MsgCloseMarketResponse{}, err
 }
+
+// SetTradingSchedule: gRPC tx msg for setting the trading hours of a market.
+// [SUDO] Only callable by sudoers.
+func (m msgServer) SetTradingSchedule(
+	goCtx context.Context, msg *types.MsgSetTradingSchedule,
+) (*types.MsgSetTradingScheduleResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().SetTradingSchedule(ctx, msg.Pair, msg.TradingSchedule, sender)
+	return &types.MsgSetTradingScheduleResponse{}, err
+}
+
+// SetMarketRiskParams: gRPC tx msg for setting the margin requirements of a
+// market. [SUDO] Only callable by sudoers.
+func (m msgServer) SetMarketRiskParams(
+	goCtx context.Context, msg *types.MsgSetMarketRiskParams,
+) (*types.MsgSetMarketRiskParamsResponse, error) {
+	// Sender is checked in `msg.ValidateBasic` before reaching this fn call.
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := m.k.Sudo().SetMarketRiskParams(
+		ctx, msg.Pair, msg.MaintenanceMarginRatio, msg.InitialMarginRatio, msg.MaxLeverage,
+		msg.PriceFluctuationLimitRatio, sender,
+	)
+	return &types.MsgSetMarketRiskParamsResponse{}, err
+}
+
+func (m msgServer) PlaceConditionalOrder(
+	goCtx context.Context, msg *types.MsgPlaceConditionalOrder,
+) (*types.MsgPlaceConditionalOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	orderID, err := m.k.PlaceConditionalOrder(
+		ctx, traderAddr, msg.Pair, msg.TriggerCondition, msg.TriggerPrice, msg.Size_, msg.ExecutionFee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPlaceConditionalOrderResponse{OrderId: orderID}, nil
+}
+
+func (m msgServer) CancelConditionalOrder(
+	goCtx context.Context, msg *types.MsgCancelConditionalOrder,
+) (*types.MsgCancelConditionalOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	if err := m.k.CancelConditionalOrder(ctx, traderAddr, msg.OrderId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelConditionalOrderResponse{}, nil
+}
+
+func (m msgServer) ExecuteConditionalOrder(
+	goCtx context.Context, msg *types.MsgExecuteConditionalOrder,
+) (*types.MsgExecuteConditionalOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	executorAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	exchangedSize, executionFee, err := m.k.ExecuteConditionalOrder(ctx, executorAddr, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecuteConditionalOrderResponse{
+		ExchangedPositionSize: exchangedSize,
+		ExecutionFee:          executionFee,
+	}, nil
+}
@@ -19,13 +19,14 @@ func (k Keeper) GetPosition(ctx sdk.Context, pair asset.Pair, version uint64, ac
 	return position, nil
 }
 
+// DeletePosition deletes the position and cancels its conditional orders.
 func (k Keeper) DeletePosition(ctx sdk.Context, pair asset.Pair, version uint64, account sdk.AccAddress) error {
 	err := k.Positions.Delete(ctx, collections.Join(collections.Join(pair, version), account))
 	if err != nil {
 		return types.ErrPositionNotFound
 	}
 
-	return nil
+	return k.cancelPositionConditionalOrders(ctx, account, pair)
 }
 
 func (k Keeper) SavePosition(ctx sdk.Context, pair asset.Pair, version uint64, account sdk.AccAddress, position types.Position) {
@@ -160,6 +160,67 @@ func (k sudoExtension) CloseMarket(ctx sdk.Context, pair asset.Pair, sender sdk.
 	return nil
 }
 
+// SetTradingSchedule replaces the trading schedule of a market. Market orders
+// are rejected while the schedule says the market is closed, but positions can
+// still be closed. Maintenance windows that already ended are dropped.
+// [SUDO] Only callable by sudoers.
+func (k sudoExtension) SetTradingSchedule(
+	ctx sdk.Context,
+	pair asset.Pair,
+	schedule types.TradingSchedule,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return err
+	}
+
+	market.TradingSchedule = schedule.WithoutEndedWindows(ctx.BlockTime())
+	k.SaveMarket(ctx, market)
+
+	return nil
+}
+
+// SetMarketRiskParams sets the maintenance margin ratio, the initial margin
+// ratio and the max leverage of a market. The price fluctuation limit ratio is
+// only set if it is not nil. [SUDO] Only callable by sudoers.
+func (k sudoExtension) SetMarketRiskParams(
+	ctx sdk.Context,
+	pair asset.Pair,
+	maintenanceMarginRatio sdk.Dec,
+	initialMarginRatio sdk.Dec,
+	maxLeverage sdk.Dec,
+	priceFluctuationLimitRatio *sdk.Dec,
+	sender sdk.AccAddress,
+) error {
+	if err := k.SudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return err
+	}
+	market, err := k.GetMarket(ctx, pair)
+	if err != nil {
+		return err
+	}
+
+	market.MaintenanceMarginRatio = maintenanceMarginRatio
+	market.InitialMarginRatio = initialMarginRatio
+	market.MaxLeverage = maxLeverage
+	if priceFluctuationLimitRatio != nil {
+		market.PriceFluctuationLimitRatio = *priceFluctuationLimitRatio
+	}
+	if err := market.Validate(); err != nil {
+		return err
+	}
+	k.SaveMarket(ctx, market)
+
+	return nil
+}
+
 // ChangeCollateralDenom Updates the collateral denom. A denom is valid if it is
 // possible to make an sdk.Coin using it. [SUDO] Only callable by sudoers.
 func (k sudoExtension) ChangeCollateralDenom(
@@ -301,6 +301,114 @@ func TestCloseMarket(t *testing.T) {
 	NewTestSuite(t).WithTestCases(tc...).Run()
 }
 
+func TestTradingSchedule(t *testing.T) {
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+
+	friday := time.Date(2023, time.September, 15, 12, 0, 0, 0, time.UTC)
+	saturday := friday.Add(24 * time.Hour)
+	weekends := perptypes.TradingSchedule{
+		ClosedWeekdays: []uint32{uint32(time.Saturday), uint32(time.Sunday)},
+	}
+	maintenance := perptypes.TradingSchedule{
+		MaintenanceWindows: []perptypes.MaintenanceWindow{{
+			StartMs: friday.Add(time.Hour).UnixMilli(),
+			EndMs:   friday.Add(2 * time.Hour).UnixMilli(),
+		}},
+	}
+
+	openPosition := func(schedule perptypes.TradingSchedule) []Action {
+		return []Action{
+			CreateCustomMarket(
+				pairBtcUsdc,
+				WithEnabled(true),
+				WithPricePeg(sdk.OneDec()),
+				WithSqrtDepth(sdk.NewDec(100_000)),
+				WithTradingSchedule(schedule),
+			),
+			SetBlockNumber(1),
+			SetBlockTime(friday),
+			FundAccount(alice, sdk.NewCoins(sdk.NewCoin(perptypes.TestingCollateralDenomNUSD, sdk.NewInt(10_300)))),
+			MarketOrder(
+				alice,
+				pairBtcUsdc,
+				perptypes.Direction_LONG,
+				sdk.NewInt(10_000),
+				sdk.OneDec(),
+				sdk.ZeroDec(),
+			),
+		}
+	}
+
+	tc := TestCases{
+		TC("can only reduce position on closed weekday").
+			Given(openPosition(weekends)...).
+			When(
+				SetBlockTime(saturday),
+			).
+			Then(
+				MarketOrderFails(
+					alice,
+					pairBtcUsdc,
+					perptypes.Direction_LONG,
+					sdk.NewInt(100),
+					sdk.OneDec(),
+					sdk.ZeroDec(),
+					perptypes.ErrMarketClosed,
+				),
+				MarketOrderFails(
+					alice,
+					pairBtcUsdc,
+					perptypes.Direction_SHORT,
+					sdk.NewInt(100),
+					sdk.OneDec(),
+					sdk.ZeroDec(),
+					perptypes.ErrMarketClosed,
+				),
+				AddMargin(alice, pairBtcUsdc, sdk.NewInt(100)),
+				PartialClose(alice, pairBtcUsdc, sdk.NewDec(5_000)),
+				PositionShouldExist(alice, pairBtcUsdc, 1),
+				ClosePosition(alice, pairBtcUsdc),
+				PositionShouldNotExist(alice, pairBtcUsdc, 1),
+			),
+		TC("can close position during maintenance window").
+			Given(openPosition(maintenance)...).
+			When(
+				SetBlockTime(friday.Add(time.Hour)),
+			).
+			Then(
+				MarketOrderFails(
+					alice,
+					pairBtcUsdc,
+					perptypes.Direction_LONG,
+					sdk.NewInt(100),
+					sdk.OneDec(),
+					sdk.ZeroDec(),
+					perptypes.ErrMarketClosed,
+				),
+				ClosePosition(alice, pairBtcUsdc),
+			),
+		TC("can open position after maintenance window").
+			Given(openPosition(maintenance)...).
+			When(
+				SetBlockTime(friday.Add(2*time.Hour)),
+			).
+			Then(
+				MarketOrder(
+					alice,
+					pairBtcUsdc,
+					perptypes.Direction_LONG,
+					sdk.NewInt(100),
+					sdk.OneDec(),
+					sdk.ZeroDec(),
+				),
+				ClosePosition(alice, pairBtcUsdc),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
 func TestAdmin_ChangeCollateralDenom(t *testing.T) {
 	adminSender := testutil.AccAddress()
 	nonAdminSender := testutil.AccAddress()
@@ -426,6 +534,10 @@ func (s *TestSuiteAdmin) HandleMsg(txMsg sdk.Msg) (err error) {
 		_, err = s.perpMsgServer.ChangeCollateralDenom(ctx, msg)
 	case *perptypes.MsgWithdrawFromPerpFund:
 		_, err = s.perpMsgServer.WithdrawFromPerpFund(ctx, msg)
+	case *perptypes.MsgSetTradingSchedule:
+		_, err = s.perpMsgServer.SetTradingSchedule(ctx, msg)
+	case *perptypes.MsgSetMarketRiskParams:
+		_, err = s.perpMsgServer.SetMarketRiskParams(ctx, msg)
 	default:
 		return fmt.Errorf("unexpected message of type %T encountered", msg)
 	}
@@ -458,6 +570,14 @@ func (s *TestSuiteAdmin) TestCheckPermissions() {
 			Denom:  "",
 			ToAddr: sender,
 		},
+		&perptypes.MsgSetTradingSchedule{
+			Sender: sender, Pair: asset.Pair("valid:pair"),
+		},
+		&perptypes.MsgSetMarketRiskParams{
+			Sender: sender, Pair: asset.Pair("valid:pair"),
+			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.05"), InitialMarginRatio: sdk.MustNewDecFromStr("0.2"),
+			MaxLeverage: sdk.NewDec(5),
+		},
 	} {
 		s.Run(fmt.Sprintf("%T", testCaseMsg), func() {
 			err := s.HandleMsg(testCaseMsg)
@@ -506,6 +626,34 @@ func (s *TestSuiteAdmin) DoWithdrawFromPerpFundTest(toAddr string) error {
 	return err
 }
 
+func (s *TestSuiteAdmin) DoSetTradingScheduleTest(pair asset.Pair) error {
+	_, err := s.perpMsgServer.SetTradingSchedule(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgSetTradingSchedule{
+			Sender: s.addrAdmin.String(),
+			Pair:   pair,
+			TradingSchedule: perptypes.TradingSchedule{
+				ClosedWeekdays: []uint32{0, 6},
+			},
+		},
+	)
+	return err
+}
+
+func (s *TestSuiteAdmin) DoSetMarketRiskParamsTest(pair asset.Pair) error {
+	priceFluctuationLimitRatio := sdk.MustNewDecFromStr("0.1")
+	_, err := s.perpMsgServer.SetMarketRiskParams(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgSetMarketRiskParams{
+			Sender:                     s.addrAdmin.String(),
+			Pair:                       pair,
+			MaintenanceMarginRatio:     sdk.MustNewDecFromStr("0.05"),
+			InitialMarginRatio:         sdk.MustNewDecFromStr("0.2"),
+			MaxLeverage:                sdk.NewDec(5),
+			PriceFluctuationLimitRatio: &priceFluctuationLimitRatio,
+		},
+	)
+	return err
+}
+
 // TestAdmin_DoHappy: Happy path test cases
 func (s *TestSuiteAdmin) TestAdmin_DoHappy() {
 	pair := asset.Registry.Pair(denoms.ATOM, denoms.NUSD)
@@ -514,9 +662,55 @@ func (s *TestSuiteAdmin) TestAdmin_DoHappy() {
 		s.DoShiftPegTest(pair),
 		s.DoShiftSwapInvariantTest(pair),
 		s.DoWithdrawFromPerpFundTest(s.addrAdmin.String()),
+		s.DoSetTradingScheduleTest(pair),
+		s.DoSetMarketRiskParamsTest(pair),
 	} {
 		s.NoError(err)
 	}
+
+	market, err := s.perpKeeper.GetMarket(s.ctx, pair)
+	s.NoError(err)
+	s.Equal([]uint32{0, 6}, market.TradingSchedule.ClosedWeekdays)
+	s.Equal(sdk.MustNewDecFromStr("0.05"), market.MaintenanceMarginRatio)
+	s.Equal(sdk.NewDec(5), market.MaxLeverage)
+	s.Equal(sdk.MustNewDecFromStr("0.2"), market.InitialMarginRatio)
+	s.Equal(sdk.MustNewDecFromStr("0.1"), market.PriceFluctuationLimitRatio)
+
+	s.T().Log("a max leverage above 1 / maintenance margin ratio is invalid")
+	_, err = s.perpMsgServer.SetMarketRiskParams(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgSetMarketRiskParams{
+			Sender:                 s.addrAdmin.String(),
+			Pair:                   pair,
+			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.05"),
+			InitialMarginRatio:     sdk.MustNewDecFromStr("0.05"),
+			MaxLeverage:            sdk.NewDec(25),
+		},
+	)
+	s.Error(err)
+
+	s.T().Log("an initial margin ratio below the maintenance margin ratio is invalid")
+	_, err = s.perpMsgServer.SetMarketRiskParams(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgSetMarketRiskParams{
+			Sender:                 s.addrAdmin.String(),
+			Pair:                   pair,
+			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.05"),
+			InitialMarginRatio:     sdk.MustNewDecFromStr("0.04"),
+			MaxLeverage:            sdk.NewDec(25),
+		},
+	)
+	s.Error(err)
+
+	s.T().Log("an initial margin ratio below 1 / max leverage is invalid")
+	_, err = s.perpMsgServer.SetMarketRiskParams(
+		sdk.WrapSDKContext(s.ctx), &perptypes.MsgSetMarketRiskParams{
+			Sender:                 s.addrAdmin.String(),
+			Pair:                   pair,
+			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.05"),
+			InitialMarginRatio:     sdk.MustNewDecFromStr("0.06"),
+			MaxLeverage:            sdk.NewDec(10),
+		},
+	)
+	s.ErrorContains(err, "initial margin ratio must be >= 1 / max leverage")
 }
 
 // TestAdmin_SadPathsInvalidPair: Test scenarios that fail due to the use of a
@@ -526,6 +720,8 @@ func (s *TestSuiteAdmin) TestAdmin_SadPathsInvalidPair() {
 	for _, err := range []error{
 		s.DoShiftPegTest(pair),
 		s.DoShiftSwapInvariantTest(pair),
+		s.DoSetTradingScheduleTest(pair),
+		s.DoSetMarketRiskParamsTest(pair),
 	} {
 		s.Error(err)
 	}
@@ -1,8 +1,12 @@
 package keeper
 
 import (
+	"time"
+
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/x/common/asset"
 	types "github.com/NibiruChain/nibiru/x/perp/v2/types"
 )
 
@@ -32,6 +36,41 @@ func checkUserLimits(limit, amount sdk.Dec, dir types.Direction) error {
 	return nil
 }
 
+// checkPriceFluctuationLimit returns an error if the mark price of the AMM moved
+// by more than the market's price fluctuation limit ratio from the mark price
+// of the latest reserve snapshot taken before this block. A zero limit disables
+// the check.
+func (k Keeper) checkPriceFluctuationLimit(ctx sdk.Context, market types.Market, amm types.AMM) error {
+	limit := market.PriceFluctuationLimitRatio
+	if limit.IsNil() || limit.IsZero() {
+		return nil
+	}
+
+	iter := k.ReserveSnapshots.Iterate(
+		ctx,
+		collections.PairRange[asset.Pair, time.Time]{}.
+			Prefix(amm.Pair).
+			EndExclusive(ctx.BlockTime()).
+			Descending(),
+	)
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil
+	}
+
+	lastPrice := iter.Value().Amm.InstMarkPrice()
+	if !lastPrice.IsPositive() {
+		return nil
+	}
+	price := amm.InstMarkPrice()
+	if price.Sub(lastPrice).Abs().Quo(lastPrice).GT(limit) {
+		return types.ErrOverFluctuationLimit.Wrapf(
+			"mark price moved from %s to %s, limit ratio %s", lastPrice, price, limit,
+		)
+	}
+	return nil
+}
+
 // SwapQuoteAsset trades quoteAssets in exchange for baseAssets.
 // Updates the AMM reserves and persists it to state.
 //
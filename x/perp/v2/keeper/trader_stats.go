@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/perp/v2/types"
+)
+
+// leaderboardKey indexes a trader's statistics in the leaderboard of an epoch
+// and ranking: ((epoch, ranking), (score, trader)).
+type leaderboardKey = collections.Pair[
+	collections.Pair[uint64, uint64],
+	collections.Pair[sdk.Dec, sdk.AccAddress],
+]
+
+// leaderboardRankings lists the rankings maintained in the leaderboard index.
+var leaderboardRankings = []types.LeaderboardRanking{
+	types.LeaderboardRanking_RANK_BY_VOLUME,
+	types.LeaderboardRanking_RANK_BY_REALIZED_PNL,
+}
+
+// updateTraderStats records a position change in the trader's statistics for
+// the current DnR epoch.
+func (k Keeper) updateTraderStats(
+	ctx sdk.Context,
+	trader sdk.AccAddress,
+	exchangedNotional sdk.Dec,
+	realizedPnl sdk.Dec,
+	fee sdkmath.Int,
+) {
+	epoch := k.DnREpoch.GetOr(ctx, 0)
+	stats := k.TraderStats.GetOr(ctx, collections.Join(epoch, trader), types.TraderStats{
+		Volume:      sdkmath.ZeroInt(),
+		RealizedPnl: sdk.ZeroDec(),
+		Fees:        sdkmath.ZeroInt(),
+	})
+
+	stats.Volume = stats.Volume.Add(exchangedNotional.Abs().TruncateInt())
+	stats.RealizedPnl = stats.RealizedPnl.Add(realizedPnl)
+	stats.Fees = stats.Fees.Add(fee)
+	stats.NumTrades++
+
+	k.SetTraderStats(ctx, epoch, trader, stats)
+}
+
+// SetTraderStats stores the statistics of a trader for an epoch and keeps the
+// leaderboard index in sync.
+func (k Keeper) SetTraderStats(ctx sdk.Context, epoch uint64, trader sdk.AccAddress, stats types.TraderStats) {
+	key := collections.Join(epoch, trader)
+	if old, err := k.TraderStats.Get(ctx, key); err == nil {
+		k.removeFromLeaderboards(ctx, epoch, trader, old)
+	} else {
+		k.EpochTraderCounts.Insert(ctx, epoch, k.EpochTraderCounts.GetOr(ctx, epoch, 0)+1)
+	}
+
+	k.TraderStats.Insert(ctx, key, stats)
+	for _, rankBy := range leaderboardRankings {
+		k.Leaderboards.Insert(ctx, newLeaderboardKey(epoch, rankBy, trader, stats))
+	}
+}
+
+func (k Keeper) removeFromLeaderboards(ctx sdk.Context, epoch uint64, trader sdk.AccAddress, stats types.TraderStats) {
+	for _, rankBy := range leaderboardRankings {
+		k.Leaderboards.Delete(ctx, newLeaderboardKey(epoch, rankBy, trader, stats))
+	}
+}
+
+func newLeaderboardKey(
+	epoch uint64, rankBy types.LeaderboardRanking, trader sdk.AccAddress, stats types.TraderStats,
+) leaderboardKey {
+	return collections.Join(
+		collections.Join(epoch, uint64(rankBy)),
+		collections.Join(leaderboardScore(rankBy, stats), trader),
+	)
+}
+
+// leaderboardScore returns the value traders are ranked by.
+func leaderboardScore(rankBy types.LeaderboardRanking, stats types.TraderStats) sdk.Dec {
+	switch rankBy {
+	case types.LeaderboardRanking_RANK_BY_REALIZED_PNL:
+		return stats.RealizedPnl
+	default:
+		return sdk.NewDecFromInt(stats.Volume)
+	}
+}
+
+// GetLeaderboard returns at most limit entries of the leaderboard of the epoch,
+// skipping the first offset ones. Entries are sorted from the highest to the
+// lowest ranked trader, or the opposite if reverse is set. Ties are broken by
+// address so that the order is deterministic. Also returns the number of
+// traders in the leaderboard.
+func (k Keeper) GetLeaderboard(
+	ctx sdk.Context, epoch uint64, rankBy types.LeaderboardRanking, offset, limit uint64, reverse bool,
+) (entries []types.LeaderboardEntry, total uint64) {
+	total = k.EpochTraderCounts.GetOr(ctx, epoch, 0)
+
+	rng := collections.PairRange[collections.Pair[uint64, uint64], collections.Pair[sdk.Dec, sdk.AccAddress]]{}.
+		Prefix(collections.Join(epoch, uint64(rankBy)))
+	if reverse {
+		rng = rng.Descending()
+	}
+	iter := k.Leaderboards.Iterate(ctx, rng)
+	defer iter.Close()
+
+	for i := uint64(0); iter.Valid() && i < offset; i++ {
+		iter.Next()
+	}
+	for i := offset; iter.Valid() && i < offset+limit; i++ {
+		trader := iter.Key().K2().K2()
+		rank := i + 1
+		if reverse {
+			rank = total - i
+		}
+		entries = append(entries, types.LeaderboardEntry{
+			Rank:   rank,
+			Trader: trader.String(),
+			Stats:  k.TraderStats.GetOr(ctx, collections.Join(epoch, trader), types.TraderStats{}),
+		})
+		iter.Next()
+	}
+	return entries, total
+}
+
+// PruneTraderStats deletes at most limit trader statistics of the epochs that
+// are more than types.TraderStatsRetentionEpochs before the current DnR epoch.
+// Returns the number of deleted statistics.
+func (k Keeper) PruneTraderStats(ctx sdk.Context, limit uint64) (pruned uint64) {
+	currentEpoch := k.DnREpoch.GetOr(ctx, 0)
+	if currentEpoch <= types.TraderStatsRetentionEpochs {
+		return 0
+	}
+	cutoff := currentEpoch - types.TraderStatsRetentionEpochs
+
+	iter := k.TraderStats.Iterate(
+		ctx,
+		collections.Range[collections.Pair[uint64, sdk.AccAddress]]{}.
+			EndExclusive(collections.PairPrefix[uint64, sdk.AccAddress](cutoff)),
+	)
+	var kvs []collections.KeyValue[collections.Pair[uint64, sdk.AccAddress], types.TraderStats]
+	for ; iter.Valid() && uint64(len(kvs)) < limit; iter.Next() {
+		kvs = append(kvs, iter.KeyValue())
+	}
+	iter.Close()
+
+	for _, kv := range kvs {
+		epoch, trader := kv.Key.K1(), kv.Key.K2()
+		k.removeFromLeaderboards(ctx, epoch, trader, kv.Value)
+		_ = k.TraderStats.Delete(ctx, kv.Key)
+		if count := k.EpochTraderCounts.GetOr(ctx, epoch, 0); count > 1 {
+			k.EpochTraderCounts.Insert(ctx, epoch, count-1)
+		} else {
+			_ = k.EpochTraderCounts.Delete(ctx, epoch)
+		}
+		pruned++
+	}
+	return pruned
+}
+
+// leaderboardScoreKeyEncoder encodes scores so that higher scores sort first.
+// A score is stored as scoreOffset minus its underlying integer, as a fixed
+// length big endian number. scoreOffset is greater than the absolute value of
+// any sdk.Dec, so the result is always positive.
+type leaderboardScoreKeyEncoder struct{}
+
+const leaderboardScoreLen = 40
+
+var scoreOffset = new(big.Int).Lsh(big.NewInt(1), leaderboardScoreLen*8-1)
+
+func (leaderboardScoreKeyEncoder) Encode(score sdk.Dec) []byte {
+	bz := make([]byte, leaderboardScoreLen)
+	return new(big.Int).Sub(scoreOffset, score.BigInt()).FillBytes(bz)
+}
+
+func (leaderboardScoreKeyEncoder) Decode(b []byte) (int, sdk.Dec) {
+	if len(b) < leaderboardScoreLen {
+		panic(fmt.Errorf("invalid leaderboard score bytes: %s", collections.HumanizeBytes(b)))
+	}
+	i := new(big.Int).Sub(scoreOffset, new(big.Int).SetBytes(b[:leaderboardScoreLen]))
+	return leaderboardScoreLen, sdk.NewDecFromBigIntWithPrec(i, sdk.Precision)
+}
+
+func (leaderboardScoreKeyEncoder) Stringify(score sdk.Dec) string { return score.String() }
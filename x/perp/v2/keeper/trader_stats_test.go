@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	. "github.com/NibiruChain/nibiru/x/perp/v2/integration/action"
+	"github.com/NibiruChain/nibiru/x/perp/v2/types"
+)
+
+func TestTraderStats(t *testing.T) {
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	pairBtcNusd := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	setupMarket := func() []Action {
+		return []Action{
+			DnREpochIs(1),
+			CreateCustomMarket(
+				pairBtcNusd,
+				WithEnabled(true),
+				WithPricePeg(sdk.OneDec()),
+				WithSqrtDepth(sdk.NewDec(100_000)),
+			),
+			FundAccount(alice, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(20_000)))),
+			FundAccount(bob, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(20_000)))),
+			FundModule(types.PerpFundModuleAccount, sdk.NewCoins(sdk.NewCoin(types.TestingCollateralDenomNUSD, sdk.NewInt(100_000_000)))),
+		}
+	}
+
+	tests := TestCases{
+		TC("trades are recorded in the stats of the current epoch").
+			Given(setupMarket()...).
+			When(
+				MarketOrder(alice, pairBtcNusd, types.Direction_LONG, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec()),
+				MarketOrder(alice, pairBtcNusd, types.Direction_SHORT, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec()),
+			).
+			Then(
+				QueryTraderStats(alice, 1, types.TraderStats{
+					Volume:      sdk.NewInt(19_960),
+					RealizedPnl: sdk.ZeroDec(),
+					Fees:        sdk.NewInt(40),
+					NumTrades:   2,
+				}),
+				QueryTraderStats(alice, 0, types.TraderStats{
+					Volume:      sdk.ZeroInt(),
+					RealizedPnl: sdk.ZeroDec(),
+					Fees:        sdk.ZeroInt(),
+				}),
+			),
+
+		TC("leaderboard ranks traders by volume").
+			Given(setupMarket()...).
+			When(
+				MarketOrder(alice, pairBtcNusd, types.Direction_LONG, sdk.NewInt(5_000), sdk.OneDec(), sdk.ZeroDec()),
+				MarketOrder(bob, pairBtcNusd, types.Direction_LONG, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec()),
+			).
+			Then(
+				QueryLeaderboard(1, types.LeaderboardRanking_RANK_BY_VOLUME, nil, bob, alice),
+				QueryLeaderboard(1, types.LeaderboardRanking_RANK_BY_VOLUME, &sdkquery.PageRequest{Limit: 1}, bob),
+				QueryLeaderboard(1, types.LeaderboardRanking_RANK_BY_VOLUME, &sdkquery.PageRequest{Limit: 1, Offset: 1}, alice),
+				QueryLeaderboard(1, types.LeaderboardRanking_RANK_BY_VOLUME, &sdkquery.PageRequest{Reverse: true}, alice, bob),
+				QueryLeaderboard(2, types.LeaderboardRanking_RANK_BY_VOLUME, nil),
+			),
+
+		TC("leaderboard ranks traders by realized pnl").
+			Given(setupMarket()...).
+			When(
+				MarketOrder(alice, pairBtcNusd, types.Direction_LONG, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec()),
+				MarketOrder(bob, pairBtcNusd, types.Direction_LONG, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec()),
+				// alice closes in profit after bob pushed the price up
+				ClosePosition(alice, pairBtcNusd),
+			).
+			Then(
+				QueryLeaderboard(1, types.LeaderboardRanking_RANK_BY_REALIZED_PNL, nil, alice, bob),
+				QueryLeaderboard(1, types.LeaderboardRanking_RANK_BY_VOLUME, nil, alice, bob),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tests...).Run()
+}
+
+func TestGetLeaderboard(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	k := app.PerpKeeperV2
+	alice, bob, carol := testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress()
+	statsWith := func(volume int64, pnl string) types.TraderStats {
+		return types.TraderStats{
+			Volume:      sdk.NewInt(volume),
+			RealizedPnl: sdk.MustNewDecFromStr(pnl),
+			Fees:        sdk.ZeroInt(),
+		}
+	}
+
+	k.SetTraderStats(ctx, 1, alice, statsWith(100, "-5.5"))
+	k.SetTraderStats(ctx, 1, bob, statsWith(300, "-0.1"))
+	k.SetTraderStats(ctx, 1, carol, statsWith(200, "10"))
+	// updating the stats of a trader moves it in the index
+	k.SetTraderStats(ctx, 1, alice, statsWith(400, "-20"))
+	k.SetTraderStats(ctx, 2, carol, statsWith(1, "0"))
+
+	traders := func(entries []types.LeaderboardEntry) (traders []string, ranks []uint64) {
+		for _, e := range entries {
+			traders = append(traders, e.Trader)
+			ranks = append(ranks, e.Rank)
+		}
+		return traders, ranks
+	}
+
+	entries, total := k.GetLeaderboard(ctx, 1, types.LeaderboardRanking_RANK_BY_REALIZED_PNL, 0, 10, false)
+	gotTraders, gotRanks := traders(entries)
+	require.EqualValues(t, 3, total)
+	require.Equal(t, []string{carol.String(), bob.String(), alice.String()}, gotTraders)
+	require.Equal(t, []uint64{1, 2, 3}, gotRanks)
+	require.Equal(t, statsWith(400, "-20"), entries[2].Stats)
+
+	entries, _ = k.GetLeaderboard(ctx, 1, types.LeaderboardRanking_RANK_BY_VOLUME, 1, 10, false)
+	gotTraders, gotRanks = traders(entries)
+	require.Equal(t, []string{bob.String(), carol.String()}, gotTraders)
+	require.Equal(t, []uint64{2, 3}, gotRanks)
+
+	entries, _ = k.GetLeaderboard(ctx, 1, types.LeaderboardRanking_RANK_BY_VOLUME, 0, 2, true)
+	gotTraders, gotRanks = traders(entries)
+	require.Equal(t, []string{carol.String(), bob.String()}, gotTraders)
+	require.Equal(t, []uint64{3, 2}, gotRanks)
+
+	entries, total = k.GetLeaderboard(ctx, 2, types.LeaderboardRanking_RANK_BY_VOLUME, 0, 10, false)
+	require.EqualValues(t, 1, total)
+	require.Len(t, entries, 1)
+}
+
+func TestPruneTraderStats(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	k := app.PerpKeeperV2
+	stats := types.TraderStats{Volume: sdk.OneInt(), RealizedPnl: sdk.ZeroDec(), Fees: sdk.ZeroInt()}
+	for epoch := uint64(1); epoch <= 3; epoch++ {
+		for i := 0; i < 2; i++ {
+			k.SetTraderStats(ctx, epoch, testutil.AccAddress(), stats)
+		}
+	}
+	countStats := func(epoch uint64) int {
+		return len(k.TraderStats.Iterate(
+			ctx, collections.PairRange[uint64, sdk.AccAddress]{}.Prefix(epoch)).Keys())
+	}
+
+	// nothing is pruned while the epochs are within the retention window
+	k.DnREpoch.Set(ctx, types.TraderStatsRetentionEpochs+1)
+	require.EqualValues(t, 0, k.PruneTraderStats(ctx, 10))
+
+	// epochs 1 and 2 expired, the limit is applied across epochs
+	k.DnREpoch.Set(ctx, types.TraderStatsRetentionEpochs+3)
+	require.EqualValues(t, 3, k.PruneTraderStats(ctx, 3))
+	require.Equal(t, 0, countStats(1))
+	require.Equal(t, 1, countStats(2))
+	require.Equal(t, 2, countStats(3))
+
+	require.EqualValues(t, 1, k.PruneTraderStats(ctx, 3))
+	require.Equal(t, 0, countStats(2))
+	require.Equal(t, 2, countStats(3))
+
+	// the leaderboard index and counts are pruned with the stats
+	entries, total := k.GetLeaderboard(ctx, 2, types.LeaderboardRanking_RANK_BY_VOLUME, 0, 10, false)
+	require.Empty(t, entries)
+	require.Zero(t, total)
+	_, err := k.EpochTraderCounts.Get(ctx, 1)
+	require.Error(t, err)
+	_, total = k.GetLeaderboard(ctx, 3, types.LeaderboardRanking_RANK_BY_REALIZED_PNL, 0, 10, false)
+	require.EqualValues(t, 2, total)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	types "github.com/NibiruChain/nibiru/x/perp/v2/types"
 )
@@ -182,3 +183,23 @@ func getPriceWithSnapshot(
 
 	return sdk.ZeroDec(), nil
 }
+
+// PruneReserveSnapshots deletes at most limit reserve snapshots that are older
+// than the retention window of their pair. The newest snapshot before the
+// window is kept, since the TWAP uses it as the price at the start of the
+// window. Returns the number of deleted snapshots.
+func (k Keeper) PruneReserveSnapshots(ctx sdk.Context, limit uint64) (pruned uint64) {
+	return common.TimeSeriesPruner[asset.Pair, types.ReserveSnapshot]{
+		Entries:         k.ReserveSnapshots,
+		NextPruneTimeMs: k.NextReserveSnapshotPruneTimeMs,
+		Retention: func(pair asset.Pair) time.Duration {
+			retention := types.ReserveSnapshotRetentionWindow
+			if market, err := k.GetMarket(ctx, pair); err == nil && market.TwapLookbackWindow > retention {
+				retention = market.TwapLookbackWindow
+			}
+			return retention
+		},
+		MinRetention:      types.ReserveSnapshotRetentionWindow,
+		KeepLatestExpired: true,
+	}.Prune(ctx, limit)
+}
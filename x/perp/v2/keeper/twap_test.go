@@ -402,3 +402,55 @@ func TestCalcTwapExtended(t *testing.T) {
 		})
 	}
 }
+
+func TestPruneReserveSnapshots(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	pairBtcNusd := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	pairEthNusd := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	now := time.Date(2023, time.September, 15, 12, 0, 0, 0, time.UTC)
+
+	// the btc market keeps its snapshots for its 48h TWAP lookback window, the
+	// eth pair has no market and uses the default retention window
+	app.PerpKeeperV2.MarketLastVersion.Insert(ctx, pairBtcNusd, types.MarketLastVersion{Version: 1})
+	app.PerpKeeperV2.SaveMarket(ctx, mock.TestMarket().WithTwapLookbackWindow(48*time.Hour))
+	insertSnapshots := func(pair asset.Pair, ages ...time.Duration) {
+		for _, age := range ages {
+			snapshotTime := now.Add(-age)
+			app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(pair, snapshotTime),
+				types.ReserveSnapshot{Amm: *mock.TestAMMDefault(), TimestampMs: snapshotTime.UnixMilli()})
+		}
+	}
+	insertSnapshots(pairBtcNusd, 96*time.Hour, 72*time.Hour, 60*time.Hour, 30*time.Hour, time.Hour)
+	insertSnapshots(pairEthNusd, 48*time.Hour, 36*time.Hour, 25*time.Hour, time.Hour)
+	countSnapshots := func(pair asset.Pair) int {
+		return len(app.PerpKeeperV2.ReserveSnapshots.Iterate(
+			ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair)).Keys())
+	}
+
+	ctx = ctx.WithBlockTime(now)
+
+	// the limit is applied across pairs
+	require.EqualValues(t, 3, app.PerpKeeperV2.PruneReserveSnapshots(ctx, 3))
+	require.Equal(t, 3, countSnapshots(pairBtcNusd))
+	require.Equal(t, 3, countSnapshots(pairEthNusd))
+
+	// the newest snapshot before the retention window is kept
+	require.EqualValues(t, 1, app.PerpKeeperV2.PruneReserveSnapshots(ctx, 100))
+	require.Equal(t, 3, countSnapshots(pairBtcNusd))
+	require.Equal(t, 2, countSnapshots(pairEthNusd))
+	_, err := app.PerpKeeperV2.ReserveSnapshots.Get(ctx, collections.Join(pairBtcNusd, now.Add(-60*time.Hour)))
+	require.NoError(t, err)
+	_, err = app.PerpKeeperV2.ReserveSnapshots.Get(ctx, collections.Join(pairEthNusd, now.Add(-25*time.Hour)))
+	require.NoError(t, err)
+
+	require.Zero(t, app.PerpKeeperV2.PruneReserveSnapshots(ctx, 100))
+
+	// the next pass waits until the btc snapshot from 60h ago can be pruned,
+	// when the snapshot from 30h ago leaves the 48h window
+	nextPruneTimeMs, err := app.PerpKeeperV2.NextReserveSnapshotPruneTimeMs.Get(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, now.Add(18*time.Hour).UnixMilli(), nextPruneTimeMs)
+	insertSnapshots(pairEthNusd, 72*time.Hour)
+	require.Zero(t, app.PerpKeeperV2.PruneReserveSnapshots(ctx.WithBlockTime(now.Add(time.Hour)), 100))
+	require.EqualValues(t, 1, app.PerpKeeperV2.PruneReserveSnapshots(ctx.WithBlockTime(now.Add(18*time.Hour)), 100))
+}
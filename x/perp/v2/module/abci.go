@@ -2,6 +2,7 @@ package perp
 
 import (
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/collections"
@@ -20,6 +21,13 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 			continue
 		}
 
+		// drop maintenance windows that ended
+		schedule := market.TradingSchedule.WithoutEndedWindows(ctx.BlockTime())
+		if len(schedule.MaintenanceWindows) < len(market.TradingSchedule.MaintenanceWindows) {
+			market.TradingSchedule = schedule
+			k.SaveMarket(ctx, market)
+		}
+
 		// only snapshot enabled markets
 		if !market.Enabled {
 			continue
@@ -65,5 +73,16 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 		})
 	}
 
+	pruned := k.PruneReserveSnapshots(ctx, types.MaxReserveSnapshotPrunesPerBlock)
+	telemetry.IncrCounter(float32(pruned), types.ModuleName, "reserve_snapshots_pruned")
+	if pruned == types.MaxReserveSnapshotPrunesPerBlock {
+		telemetry.IncrCounter(1, types.ModuleName, "reserve_snapshot_prune_limit_reached")
+	}
+
+	pruned = k.PruneTraderStats(ctx, types.MaxTraderStatsPrunesPerBlock)
+	telemetry.IncrCounter(float32(pruned), types.ModuleName, "trader_stats_pruned")
+
+	k.EmitBlockSummary(ctx)
+
 	return []abci.ValidatorUpdate{}
 }
@@ -144,3 +144,35 @@ func TestEndBlocker(t *testing.T) {
 
 	// add index price
 }
+
+func TestEndBlockerPrunesEndedMaintenanceWindows(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	now := time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now).WithBlockHeight(1)
+
+	ended := types.MaintenanceWindow{StartMs: now.Add(-2 * time.Hour).UnixMilli(), EndMs: now.Add(-time.Hour).UnixMilli()}
+	upcoming := types.MaintenanceWindow{StartMs: now.Add(time.Hour).UnixMilli(), EndMs: now.Add(2 * time.Hour).UnixMilli()}
+	initialMarket := mock.TestMarket().WithTradingSchedule(types.TradingSchedule{
+		MaintenanceWindows: []types.MaintenanceWindow{ended, upcoming},
+	})
+	initialAmm := *mock.TestAMMDefault()
+	require.NoError(t, app.PerpKeeperV2.Sudo().CreateMarket(
+		/* ctx */ ctx, keeper.ArgsCreateMarket{
+			Pair:            pair,
+			PriceMultiplier: initialAmm.PriceMultiplier,
+			SqrtDepth:       initialAmm.SqrtDepth,
+			Market:          &initialMarket,
+		},
+	))
+
+	perp.EndBlocker(ctx, app.PerpKeeperV2)
+	market, err := app.PerpKeeperV2.GetMarket(ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, []types.MaintenanceWindow{upcoming}, market.TradingSchedule.MaintenanceWindows)
+
+	perp.EndBlocker(ctx.WithBlockTime(now.Add(2*time.Hour)), app.PerpKeeperV2)
+	market, err = app.PerpKeeperV2.GetMarket(ctx, pair)
+	require.NoError(t, err)
+	require.Empty(t, market.TradingSchedule.MaintenanceWindows)
+}
@@ -57,6 +57,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			vol.Volume,
 		)
 	}
+	for _, stats := range genState.TraderStats {
+		k.SetTraderStats(ctx, stats.Epoch, sdk.MustAccAddressFromBech32(stats.Trader), stats.Stats)
+	}
+	for _, order := range genState.ConditionalOrders {
+		k.ConditionalOrders.Insert(ctx, order.Id, order)
+		k.TraderConditionalOrders.Insert(
+			ctx, collections.Join(sdk.MustAccAddressFromBech32(order.Trader), order.Id))
+	}
+	if genState.NextConditionalOrderId != 0 {
+		k.NextConditionalOrderID.Set(ctx, genState.NextConditionalOrderId)
+	}
+
 	for _, globalDiscount := range genState.GlobalDiscount {
 		k.GlobalDiscounts.Insert(
 			ctx,
@@ -139,6 +151,22 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		})
 	}
 
+	// export trader stats
+	traderStats := k.TraderStats.Iterate(ctx, collections.PairRange[uint64, sdk.AccAddress]{})
+	defer traderStats.Close()
+	for ; traderStats.Valid(); traderStats.Next() {
+		key := traderStats.Key()
+		genesis.TraderStats = append(genesis.TraderStats, types.GenesisState_TraderStatsEntry{
+			Trader: key.K2().String(),
+			Epoch:  key.K1(),
+			Stats:  traderStats.Value(),
+		})
+	}
+
+	// export conditional orders
+	genesis.ConditionalOrders = k.ConditionalOrders.Iterate(ctx, collections.Range[uint64]{}).Values()
+	genesis.NextConditionalOrderId = k.NextConditionalOrderID.Peek(ctx)
+
 	// export global discounts
 	discounts := k.GlobalDiscounts.Iterate(ctx, collections.Range[math.Int]{})
 	defer discounts.Close()
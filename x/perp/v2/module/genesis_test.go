@@ -100,10 +100,28 @@ func RunTestGenesis(t *testing.T, tc TestCase) {
 	app.PerpKeeperV2.GlobalDiscounts.Insert(ctx, sdk.NewInt(1_000_000), sdk.MustNewDecFromStr("0.05"))
 	app.PerpKeeperV2.TraderVolumes.Insert(ctx, collections.Join(testutil.AccAddress(), uint64(0)), math.NewInt(1_000_000))
 	app.PerpKeeperV2.GlobalVolumes.Insert(ctx, uint64(0), math.NewInt(1_000_000))
+	app.PerpKeeperV2.TraderStats.Insert(ctx, collections.Join(uint64(0), testutil.AccAddress()), types.TraderStats{
+		Volume:      math.NewInt(1_000_000),
+		RealizedPnl: sdk.MustNewDecFromStr("-25.5"),
+		Fees:        math.NewInt(1_000),
+		NumTrades:   2,
+	})
 	app.PerpKeeperV2.EpochRebateAllocations.Insert(ctx, uint64(0), types.DNRAllocation{
 		Epoch:  0,
 		Amount: sdk.NewCoins(sdk.NewCoin(denoms.NUSD, sdk.NewInt(1_000_000))),
 	})
+	orderID := app.PerpKeeperV2.NextConditionalOrderID.Next(ctx)
+	orderTrader := testutil.AccAddress()
+	app.PerpKeeperV2.ConditionalOrders.Insert(ctx, orderID, types.ConditionalOrder{
+		Id:               orderID,
+		Trader:           orderTrader.String(),
+		Pair:             pair,
+		TriggerCondition: types.TriggerCondition_PRICE_AT_OR_BELOW,
+		TriggerPrice:     sdk.OneDec(),
+		Size_:            sdk.ZeroDec(),
+		ExecutionFee:     sdk.NewInt64Coin(denoms.NUSD, 100),
+	})
+	app.PerpKeeperV2.TraderConditionalOrders.Insert(ctx, collections.Join(orderTrader, orderID))
 	app.PerpKeeperV2.DnREpochName.Set(ctx, "weekly")
 	app.PerpKeeperV2.DnREpoch.Set(ctx, 1)
 
@@ -150,6 +168,10 @@ func RunTestGenesis(t *testing.T, tc TestCase) {
 	require.Equal(t, genState.CustomDiscounts, genStateAfterInit.CustomDiscounts)
 	require.Equal(t, genState.GlobalDiscount, genStateAfterInit.GlobalDiscount)
 	require.Equal(t, genState.TraderVolumes, genStateAfterInit.TraderVolumes)
+	require.Equal(t, genState.TraderStats, genStateAfterInit.TraderStats)
+	require.Equal(t, genState.ConditionalOrders, genStateAfterInit.ConditionalOrders)
+	require.Equal(t, genState.NextConditionalOrderId, genStateAfterInit.NextConditionalOrderId)
+	require.Equal(t, app.PerpKeeperV2.GetConditionalOrders(ctx, orderTrader), genState.ConditionalOrders)
 	require.Equal(t, genState.CollateralDenom, genStateAfterInit.CollateralDenom)
 	require.Equal(t, genState.GlobalVolumes, genStateAfterInit.GlobalVolumes)
 	require.Equal(t, genState.RebatesAllocations, genStateAfterInit.RebatesAllocations)
@@ -169,7 +191,7 @@ func TestNewAppModuleBasic(t *testing.T) {
 	appModule := perp.NewAppModule(cdc, app.PerpKeeperV2, nil, nil, nil)
 
 	require.Equal(t, types.ModuleName, appModule.Name())
-	require.Equal(t, uint64(3), appModule.ConsensusVersion())
+	require.Equal(t, uint64(4), appModule.ConsensusVersion())
 
 	exportedGenesis := appModule.ExportGenesis(ctx, cdc)
 	err := appModule.ValidateGenesis(cdc, nil, exportedGenesis)
@@ -191,6 +191,24 @@ func (amm AMM) GetQuoteReserveAmt(
 	return quoteReserveDelta, nil
 }
 
+// BaseAssetForQuote returns the amount of base assets exchanged when swapping
+// the given amount of quote assets in the given direction. LONG adds the quote
+// assets to the AMM and SHORT removes them.
+func (amm AMM) BaseAssetForQuote(quoteAssetAmt sdk.Dec, dir Direction) (sdk.Dec, error) {
+	return amm.GetBaseReserveAmt(amm.QuoteAssetToReserve(quoteAssetAmt), dir)
+}
+
+// QuoteAssetForBase returns the amount of quote assets exchanged when swapping
+// the given amount of base assets in the given direction. LONG removes the
+// base assets from the AMM and SHORT adds them.
+func (amm AMM) QuoteAssetForBase(baseAssetAmt sdk.Dec, dir Direction) (sdk.Dec, error) {
+	quoteReserveAmt, err := amm.GetQuoteReserveAmt(baseAssetAmt, dir)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return amm.QuoteReserveToAsset(quoteReserveAmt), nil
+}
+
 // InstMarkPrice returns the instantaneous mark price of the trading pair.
 // This is the price if the AMM has zero slippage, or equivalently, if there's
 // infinite liquidity depth with the same ratio of reserves.
@@ -225,6 +225,36 @@ func TestSwapQuoteAsset(t *testing.T) {
 // To get rid of the bias, we swap it away and see what that is in quote units:
 // dy = k / (x + dx)  - y, where dx = bias
 // dy = 100^2 / (100 - 20) - 100  = +25
+func TestBaseAndQuoteAssetConversion(t *testing.T) {
+	amm := types.AMM{
+		BaseReserve:     sdk.NewDec(100),
+		QuoteReserve:    sdk.NewDec(100),
+		PriceMultiplier: sdk.NewDec(2),
+	}
+
+	// 40 quote assets are 20 quote reserves
+	baseAmt, err := amm.BaseAssetForQuote(sdk.NewDec(40), types.Direction_LONG)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("16.666666666666666667"), baseAmt)
+
+	baseAmt, err = amm.BaseAssetForQuote(sdk.NewDec(40), types.Direction_SHORT)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(25), baseAmt)
+
+	quoteAmt, err := amm.QuoteAssetForBase(sdk.NewDec(20), types.Direction_LONG)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(50), quoteAmt)
+
+	quoteAmt, err = amm.QuoteAssetForBase(sdk.NewDec(25), types.Direction_SHORT)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(40), quoteAmt)
+
+	_, err = amm.QuoteAssetForBase(sdk.NewDec(100), types.Direction_LONG)
+	require.ErrorIs(t, err, types.ErrAmmNonpositiveReserves)
+	_, err = amm.BaseAssetForQuote(sdk.NewDec(-1), types.Direction_LONG)
+	require.ErrorIs(t, err, types.ErrInputQuoteAmtNegative)
+}
+
 func TestRepegCost(t *testing.T) {
 	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
 	tests := []struct {
@@ -18,6 +18,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgChangeCollateralDenom{}, "perpv2/change_collateral_denom", nil)
 	cdc.RegisterConcrete(&MsgShiftPegMultiplier{}, "perpv2/shift_peg_multiplier", nil)
 	cdc.RegisterConcrete(&MsgShiftSwapInvariant{}, "perpv2/shift_swap_invariant", nil)
+	cdc.RegisterConcrete(&MsgSetTradingSchedule{}, "perpv2/set_trading_schedule", nil)
+	cdc.RegisterConcrete(&MsgSetMarketRiskParams{}, "perpv2/set_market_risk_params", nil)
+	cdc.RegisterConcrete(&MsgPlaceConditionalOrder{}, "perpv2/place_conditional_order", nil)
+	cdc.RegisterConcrete(&MsgCancelConditionalOrder{}, "perpv2/cancel_conditional_order", nil)
+	cdc.RegisterConcrete(&MsgExecuteConditionalOrder{}, "perpv2/execute_conditional_order", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -33,6 +38,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgChangeCollateralDenom{},
 		&MsgShiftPegMultiplier{},
 		&MsgShiftSwapInvariant{},
+		&MsgSetTradingSchedule{},
+		&MsgSetMarketRiskParams{},
+		&MsgPlaceConditionalOrder{},
+		&MsgCancelConditionalOrder{},
+		&MsgExecuteConditionalOrder{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
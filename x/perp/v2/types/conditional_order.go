@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxConditionalOrdersPerTrader bounds the number of open conditional orders
+// a trader can have, across all markets.
+const MaxConditionalOrdersPerTrader = 10
+
+// Validate checks the fields of a conditional order.
+func (o ConditionalOrder) Validate() error {
+	if err := o.Pair.Validate(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(o.Trader); err != nil {
+		return err
+	}
+	if err := o.ExecutionFee.Validate(); err != nil {
+		return err
+	}
+	return validateConditionalOrder(o.TriggerCondition, o.TriggerPrice, o.Size_, o.ExecutionFee.Amount)
+}
+
+// IsTriggered returns true if the mark price satisfies the order's trigger
+// condition.
+func (o ConditionalOrder) IsTriggered(markPrice sdk.Dec) bool {
+	switch o.TriggerCondition {
+	case TriggerCondition_PRICE_AT_OR_ABOVE:
+		return markPrice.GTE(o.TriggerPrice)
+	case TriggerCondition_PRICE_AT_OR_BELOW:
+		return markPrice.LTE(o.TriggerPrice)
+	default:
+		return false
+	}
+}
+
+func validateConditionalOrder(
+	condition TriggerCondition, triggerPrice sdk.Dec, size sdk.Dec, executionFee sdk.Int,
+) error {
+	if condition != TriggerCondition_PRICE_AT_OR_ABOVE && condition != TriggerCondition_PRICE_AT_OR_BELOW {
+		return fmt.Errorf("invalid trigger condition: %s", condition)
+	}
+	if triggerPrice.IsNil() || !triggerPrice.IsPositive() {
+		return fmt.Errorf("trigger price must be positive, not: %s", triggerPrice)
+	}
+	if size.IsNil() || size.IsNegative() {
+		return fmt.Errorf("size must not be negative, not: %s", size)
+	}
+	if executionFee.IsNil() || executionFee.IsNegative() {
+		return fmt.Errorf("execution fee must not be negative, not: %s", executionFee)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionalOrderIsTriggered(t *testing.T) {
+	order := ConditionalOrder{TriggerPrice: sdk.NewDec(10)}
+
+	order.TriggerCondition = TriggerCondition_PRICE_AT_OR_ABOVE
+	require.True(t, order.IsTriggered(sdk.NewDec(11)))
+	require.True(t, order.IsTriggered(sdk.NewDec(10)))
+	require.False(t, order.IsTriggered(sdk.NewDec(9)))
+
+	order.TriggerCondition = TriggerCondition_PRICE_AT_OR_BELOW
+	require.False(t, order.IsTriggered(sdk.NewDec(11)))
+	require.True(t, order.IsTriggered(sdk.NewDec(10)))
+	require.True(t, order.IsTriggered(sdk.NewDec(9)))
+
+	order.TriggerCondition = TriggerCondition_TRIGGER_CONDITION_UNSPECIFIED
+	require.False(t, order.IsTriggered(sdk.NewDec(10)))
+}
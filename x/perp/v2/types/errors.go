@@ -41,7 +41,7 @@ var (
 	ErrUserLeverageNegative  = errorMarketOrder("leverage cannot be zero")
 	ErrLeverageIsTooHigh     = errorMarketOrder("leverage cannot be higher than market parameter")
 
-	ErrMarginRatioTooLow        = registerError("margin ratio did not meet maintenance margin ratio")
+	ErrMarginRatioTooLow        = registerError("margin ratio did not meet the required margin ratio")
 	ErrAllLiquidationsFailed    = registerError("all liquidations failed")
 	ErrParseLiquidateResponse   = registerError("failed to JSON parse liquidate responses")
 	ErrPositionHealthy          = registerError("position is healthy")
@@ -52,6 +52,14 @@ var (
 	ErrCollateralDenomNotSet           = registerError("ErrorCollateral: no collateral denom set for the perp keeper")
 	ErrInvalidCollateral               = registerError("ErrorCollateral: invalid collateral denom")
 	ErrGeneric                         = registerError("perp GenericError")
+
+	ErrMarketClosed = registerError("market is closed for trading by its trading schedule")
+
+	ErrOverFluctuationLimit = registerError("mark price moved more than the price fluctuation limit in this block")
+
+	ErrConditionalOrderNotFound     = registerError("conditional order not found")
+	ErrConditionalOrderNotTriggered = registerError("conditional order is not triggered")
+	ErrTooManyConditionalOrders     = registerError("trader has too many open conditional orders")
 )
 
 // Register error instance for "ErrorMarketOrder"
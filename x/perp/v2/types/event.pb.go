@@ -617,6 +617,139 @@ func (m *EventShiftSwapInvariant) GetCostPaid() types.Coin {
 	return types.Coin{}
 }
 
+// ConditionalOrderExecutedEvent: ABCI event emitted from
+// MsgExecuteConditionalOrder
+type ConditionalOrderExecutedEvent struct {
+	Order ConditionalOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+	// address of the executor that received the execution fee
+	Executor string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+	// mark price TWAP at execution
+	MarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
+}
+
+func (m *ConditionalOrderExecutedEvent) Reset()         { *m = ConditionalOrderExecutedEvent{} }
+func (m *ConditionalOrderExecutedEvent) String() string { return proto.CompactTextString(m) }
+func (*ConditionalOrderExecutedEvent) ProtoMessage()    {}
+func (*ConditionalOrderExecutedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5313bbc89fa31dd, []int{9}
+}
+func (m *ConditionalOrderExecutedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConditionalOrderExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConditionalOrderExecutedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConditionalOrderExecutedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConditionalOrderExecutedEvent.Merge(m, src)
+}
+func (m *ConditionalOrderExecutedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConditionalOrderExecutedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConditionalOrderExecutedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConditionalOrderExecutedEvent proto.InternalMessageInfo
+
+func (m *ConditionalOrderExecutedEvent) GetOrder() ConditionalOrder {
+	if m != nil {
+		return m.Order
+	}
+	return ConditionalOrder{}
+}
+
+func (m *ConditionalOrderExecutedEvent) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
+}
+
+// EventBlockSummary: ABCI event emitted at the end of every block in which
+// positions changed or index prices were updated. It aggregates the activity of the block so that monitors
+// can follow the protocol without decoding every transaction.
+type EventBlockSummary struct {
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// number of position changes from trades, excluding liquidations
+	NumTrades uint64 `protobuf:"varint,2,opt,name=num_trades,json=numTrades,proto3" json:"num_trades,omitempty"`
+	// total notional exchanged by trades, in collateral
+	Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"volume"`
+	// total transaction fees paid by trades, in collateral
+	Fees github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=fees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fees"`
+	// number of full and partial liquidations
+	NumLiquidations uint64 `protobuf:"varint,5,opt,name=num_liquidations,json=numLiquidations,proto3" json:"num_liquidations,omitempty"`
+	// total notional exchanged by liquidations, in collateral
+	LiquidatedNotional github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=liquidated_notional,json=liquidatedNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"liquidated_notional"`
+	// total bad debt realized by liquidations, in collateral
+	BadDebt github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=bad_debt,json=badDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bad_debt"`
+	// oracle pairs of the enabled markets whose index price was updated in the
+	// block
+	UpdatedOraclePairs []github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,8,rep,name=updated_oracle_pairs,json=updatedOraclePairs,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"updated_oracle_pairs"`
+}
+
+func (m *EventBlockSummary) Reset()         { *m = EventBlockSummary{} }
+func (m *EventBlockSummary) String() string { return proto.CompactTextString(m) }
+func (*EventBlockSummary) ProtoMessage()    {}
+func (*EventBlockSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5313bbc89fa31dd, []int{10}
+}
+func (m *EventBlockSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockSummary.Merge(m, src)
+}
+func (m *EventBlockSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockSummary proto.InternalMessageInfo
+
+func (m *EventBlockSummary) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EventBlockSummary) GetNumTrades() uint64 {
+	if m != nil {
+		return m.NumTrades
+	}
+	return 0
+}
+
+func (m *EventBlockSummary) GetNumLiquidations() uint64 {
+	if m != nil {
+		return m.NumLiquidations
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("nibiru.perp.v2.LiquidationFailedEvent_LiquidationFailedReason", LiquidationFailedEvent_LiquidationFailedReason_name, LiquidationFailedEvent_LiquidationFailedReason_value)
 	proto.RegisterType((*PositionChangedEvent)(nil), "nibiru.perp.v2.PositionChangedEvent")
@@ -628,89 +761,104 @@ func init() {
 	proto.RegisterType((*MarketUpdatedEvent)(nil), "nibiru.perp.v2.MarketUpdatedEvent")
 	proto.RegisterType((*EventShiftPegMultiplier)(nil), "nibiru.perp.v2.EventShiftPegMultiplier")
 	proto.RegisterType((*EventShiftSwapInvariant)(nil), "nibiru.perp.v2.EventShiftSwapInvariant")
+	proto.RegisterType((*ConditionalOrderExecutedEvent)(nil), "nibiru.perp.v2.ConditionalOrderExecutedEvent")
+	proto.RegisterType((*EventBlockSummary)(nil), "nibiru.perp.v2.EventBlockSummary")
 }
 
 func init() { proto.RegisterFile("nibiru/perp/v2/event.proto", fileDescriptor_a5313bbc89fa31dd) }
 
 var fileDescriptor_a5313bbc89fa31dd = []byte{
-	// 1230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xe3, 0xb8, 0xa4, 0xcd, 0xc4, 0xb1, 0x9d, 0xc1, 0x24, 0xdb, 0x52, 0x39, 0x61, 0x55,
-	0x50, 0x2e, 0xdd, 0x55, 0x83, 0x84, 0xd4, 0x0a, 0x81, 0x92, 0xd4, 0x21, 0x96, 0x1a, 0xd7, 0xac,
-	0x9d, 0xd2, 0xf2, 0x43, 0xcb, 0x78, 0xf7, 0xd9, 0x19, 0x65, 0x77, 0x66, 0xd9, 0x19, 0xc7, 0x4d,
-	0xff, 0x01, 0x38, 0x22, 0x71, 0xe0, 0x7f, 0xe0, 0x2f, 0xe9, 0xb1, 0x47, 0xc4, 0xa1, 0xa0, 0x56,
-	0x1c, 0xb8, 0x21, 0xae, 0x5c, 0xd0, 0xce, 0xce, 0xc6, 0x3f, 0xd2, 0x36, 0xc4, 0xc0, 0x81, 0x53,
-	0xb2, 0xef, 0xcd, 0x7c, 0xde, 0x9b, 0xef, 0xbe, 0xf7, 0x66, 0x8d, 0xae, 0x30, 0xda, 0xa1, 0x71,
-	0xdf, 0x8e, 0x20, 0x8e, 0xec, 0xa3, 0x0d, 0x1b, 0x8e, 0x80, 0x49, 0x2b, 0x8a, 0xb9, 0xe4, 0xb8,
-	0x98, 0xfa, 0xac, 0xc4, 0x67, 0x1d, 0x6d, 0x5c, 0xa9, 0xf4, 0x78, 0x8f, 0x2b, 0x97, 0x9d, 0xfc,
-	0x97, 0xae, 0xba, 0x72, 0xb5, 0xc7, 0x79, 0x2f, 0x00, 0x9b, 0x44, 0xd4, 0x26, 0x8c, 0x71, 0x49,
-	0x24, 0xe5, 0x4c, 0x68, 0x6f, 0xd5, 0xe3, 0x22, 0xe4, 0xc2, 0xee, 0x10, 0x01, 0xf6, 0xd1, 0x8d,
-	0x0e, 0x48, 0x72, 0xc3, 0xf6, 0x38, 0x65, 0xda, 0x3f, 0x19, 0x5f, 0x48, 0x22, 0x41, 0xfb, 0x56,
-	0x35, 0x59, 0x3d, 0x75, 0xfa, 0x5d, 0x5b, 0xd2, 0x10, 0x84, 0x24, 0x61, 0x94, 0x2e, 0x30, 0x7f,
-	0x9f, 0x43, 0x95, 0x26, 0x17, 0x34, 0x09, 0xb8, 0x7d, 0x40, 0x58, 0x0f, 0xfc, 0x5a, 0x92, 0x3f,
-	0xae, 0xa1, 0x62, 0x97, 0x32, 0x12, 0xb8, 0x91, 0xf6, 0x1a, 0xb9, 0xb5, 0xdc, 0xfa, 0xc2, 0x86,
-	0x61, 0x8d, 0x1f, 0xc9, 0xca, 0x76, 0x6f, 0x5d, 0x78, 0xfc, 0x74, 0x75, 0xc6, 0x59, 0x54, 0xbb,
-	0x32, 0x23, 0xfe, 0x0c, 0x2d, 0x65, 0x00, 0x97, 0xf1, 0xe4, 0x0f, 0x09, 0x8c, 0xd9, 0xb5, 0xdc,
-	0xfa, 0xfc, 0x96, 0x95, 0xac, 0xff, 0xe9, 0xe9, 0xea, 0x3b, 0x3d, 0x2a, 0x0f, 0xfa, 0x1d, 0xcb,
-	0xe3, 0xa1, 0xad, 0x8f, 0x9a, 0xfe, 0xb9, 0x2e, 0xfc, 0x43, 0x5b, 0x1e, 0x47, 0x20, 0xac, 0xdb,
-	0xe0, 0x39, 0xe5, 0x0c, 0xd4, 0xd0, 0x1c, 0xdc, 0x41, 0x25, 0x19, 0x13, 0x26, 0x88, 0xa7, 0xf8,
-	0x5d, 0x00, 0x23, 0xaf, 0x92, 0xbc, 0x6c, 0xa5, 0x04, 0x2b, 0xd1, 0xcc, 0xd2, 0x9a, 0x59, 0xdb,
-	0x9c, 0xb2, 0xad, 0x6a, 0x12, 0xf5, 0x8f, 0xa7, 0xab, 0xcb, 0xc7, 0x24, 0x0c, 0x6e, 0x99, 0x13,
-	0xfb, 0x4d, 0xa7, 0x38, 0x62, 0xd9, 0x01, 0xc0, 0x1f, 0xa3, 0x42, 0x0c, 0x24, 0xa0, 0x8f, 0xc0,
-	0x77, 0x23, 0x16, 0x18, 0x17, 0xa6, 0xca, 0x7d, 0x21, 0x63, 0x34, 0x59, 0x80, 0x6f, 0xa1, 0x4b,
-	0x1d, 0xe2, 0xbb, 0x3e, 0x74, 0xa4, 0xf1, 0xda, 0x59, 0xf9, 0xa6, 0xaa, 0x5e, 0xec, 0x10, 0xff,
-	0x36, 0x74, 0x24, 0xfe, 0x04, 0x95, 0xba, 0x7d, 0xe6, 0x53, 0xd6, 0x73, 0x23, 0x72, 0x1c, 0x02,
-	0x93, 0xc6, 0xdc, 0x54, 0x19, 0x15, 0x35, 0xa6, 0x99, 0x52, 0xf0, 0x5b, 0xa8, 0xd0, 0x09, 0xb8,
-	0x77, 0xe8, 0x1e, 0x00, 0xed, 0x1d, 0x48, 0xe3, 0xe2, 0x5a, 0x6e, 0x3d, 0xef, 0x2c, 0x28, 0xdb,
-	0xae, 0x32, 0xe1, 0x36, 0x2a, 0x86, 0x24, 0xee, 0x51, 0xe6, 0x4a, 0xee, 0xf6, 0x05, 0xc4, 0xc6,
-	0xa5, 0x73, 0x87, 0xae, 0x33, 0xe9, 0x14, 0x52, 0x4a, 0x9b, 0xef, 0x0b, 0x88, 0xf1, 0x4d, 0xb4,
-	0xe8, 0xa9, 0xc2, 0x73, 0x63, 0x20, 0x82, 0x33, 0x63, 0x5e, 0x41, 0x2b, 0x1a, 0x5a, 0x48, 0xab,
-	0xd2, 0x51, 0x3e, 0xa7, 0xe0, 0x8d, 0x3c, 0xe1, 0x7d, 0x54, 0x84, 0x87, 0xa9, 0xc5, 0x77, 0x05,
-	0x7d, 0x04, 0x06, 0x9a, 0x4a, 0x8b, 0xc5, 0x13, 0x4a, 0x8b, 0x3e, 0x02, 0xfc, 0x05, 0xc2, 0x43,
-	0xec, 0x49, 0xd1, 0x2e, 0x4c, 0x85, 0x5e, 0x3a, 0x21, 0x65, 0x55, 0x6b, 0x7e, 0x9d, 0x47, 0x2b,
-	0x59, 0x7f, 0xdc, 0xa1, 0x5f, 0xf5, 0xa9, 0x4f, 0x64, 0xd6, 0x75, 0x5f, 0xa2, 0xe5, 0x93, 0x76,
-	0xc9, 0x32, 0x50, 0xf3, 0x44, 0x77, 0xdf, 0xb5, 0x97, 0x75, 0xdf, 0x68, 0xef, 0xea, 0x9a, 0xa9,
-	0x44, 0x2f, 0xea, 0xeb, 0xeb, 0x08, 0x07, 0x3a, 0x28, 0x8f, 0x5d, 0xe2, 0xfb, 0x31, 0x08, 0x91,
-	0x76, 0xa4, 0xb3, 0x34, 0xf4, 0x6c, 0xa6, 0x0e, 0xdc, 0x43, 0x4b, 0x5d, 0x80, 0xe4, 0x85, 0x0f,
-	0x7d, 0x67, 0x37, 0xd9, 0x9a, 0x6e, 0x32, 0x23, 0x6d, 0xb2, 0x53, 0x04, 0xd3, 0x29, 0x75, 0x01,
-	0xda, 0xfc, 0xce, 0x89, 0x05, 0xc7, 0xe8, 0x0d, 0xbd, 0x0c, 0x3c, 0x2e, 0x8e, 0x85, 0x84, 0xd0,
-	0x4d, 0x4a, 0x54, 0x35, 0xdc, 0x2b, 0x83, 0x5d, 0xd3, 0xc1, 0xae, 0x8e, 0x05, 0x1b, 0xa7, 0x98,
-	0x0e, 0x56, 0x01, 0x6b, 0x99, 0x75, 0x27, 0x31, 0x7e, 0x3f, 0x3b, 0x1c, 0x7e, 0x2d, 0x90, 0x32,
-	0xc8, 0x44, 0xda, 0x43, 0x17, 0x22, 0x42, 0x63, 0x25, 0xfa, 0xfc, 0xd6, 0x4d, 0xfd, 0xce, 0x6f,
-	0x8c, 0xbc, 0xf3, 0x86, 0x7a, 0x0d, 0xdb, 0x07, 0x84, 0x32, 0x5b, 0xcf, 0xdf, 0x87, 0xb6, 0xc7,
-	0xc3, 0x90, 0x33, 0x9b, 0x08, 0x01, 0xd2, 0x6a, 0x12, 0x1a, 0x3b, 0x0a, 0x83, 0xdf, 0x46, 0xc9,
-	0x54, 0xf1, 0x61, 0x52, 0xef, 0xc5, 0xd4, 0x9a, 0x69, 0xfd, 0x4d, 0x0e, 0x2d, 0x8a, 0x34, 0x0d,
-	0x37, 0x99, 0xef, 0xc2, 0xc8, 0xaf, 0xe5, 0x5f, 0x7d, 0xf6, 0x5d, 0x7d, 0xf6, 0x4a, 0x7a, 0xf6,
-	0xb1, 0xdd, 0xe6, 0x0f, 0x3f, 0xaf, 0xae, 0xff, 0x8d, 0x32, 0x4d, 0x40, 0xc2, 0x29, 0xe8, 0xbd,
-	0xea, 0xc9, 0xfc, 0x35, 0x8f, 0x56, 0x76, 0xd2, 0x01, 0xe1, 0x10, 0x09, 0x63, 0x15, 0xf4, 0x2f,
-	0x8b, 0x73, 0x0f, 0x95, 0x42, 0x12, 0x1f, 0xba, 0x51, 0x4c, 0x3d, 0x70, 0xe5, 0x80, 0x44, 0x53,
-	0xde, 0x0f, 0x8b, 0x09, 0xa6, 0x99, 0x50, 0xda, 0x03, 0x12, 0xe1, 0xfb, 0xa8, 0x4c, 0x99, 0x0f,
-	0x0f, 0x47, 0xc1, 0xf9, 0xe9, 0x46, 0xa5, 0xe2, 0x0c, 0xc9, 0x0f, 0x50, 0x39, 0x8a, 0x21, 0xa4,
-	0xfd, 0xd0, 0xed, 0xc6, 0xe9, 0x4d, 0xa1, 0xe6, 0xf8, 0xf9, 0xc9, 0x25, 0xcd, 0xd9, 0xd1, 0x18,
-	0xcc, 0xd0, 0x9b, 0x5e, 0x3f, 0xec, 0x07, 0x44, 0xd2, 0x23, 0x70, 0x4f, 0x45, 0x99, 0x6e, 0xd4,
-	0x5f, 0x1e, 0x22, 0x9b, 0xe3, 0xf1, 0xcc, 0xdf, 0x66, 0xd1, 0x72, 0xd6, 0x84, 0xc9, 0x85, 0x47,
-	0xe8, 0x7f, 0xd5, 0x03, 0xcb, 0x68, 0x2e, 0xad, 0x76, 0x5d, 0xfb, 0xfa, 0x09, 0x57, 0x11, 0x9a,
-	0x98, 0x2c, 0xf3, 0xce, 0x88, 0x05, 0xdf, 0x43, 0x73, 0xfa, 0x5e, 0x48, 0x06, 0x41, 0x71, 0xe3,
-	0x83, 0xc9, 0x09, 0xf8, 0xe2, 0xf4, 0x4f, 0x9b, 0xf5, 0x0d, 0xa2, 0x69, 0x66, 0x84, 0x56, 0x5e,
-	0xb2, 0x04, 0x97, 0xd0, 0xc2, 0x7e, 0xa3, 0xd5, 0xac, 0x6d, 0xd7, 0x77, 0xea, 0xb5, 0xdb, 0xe5,
-	0x19, 0x5c, 0x41, 0xe5, 0xe6, 0xdd, 0x56, 0xbd, 0x5d, 0xbf, 0xdb, 0x70, 0x77, 0x6b, 0x9b, 0x77,
-	0xda, 0xbb, 0x0f, 0xca, 0xb9, 0xc4, 0xda, 0xb8, 0xdb, 0xa8, 0xdd, 0xaf, 0xb7, 0xda, 0xb5, 0x46,
-	0xdb, 0x6d, 0x6e, 0xd6, 0x9d, 0xf2, 0x2c, 0x36, 0x50, 0x65, 0xcc, 0xaa, 0xf7, 0x95, 0xf3, 0xe6,
-	0x9f, 0x39, 0x54, 0xda, 0x0c, 0xc3, 0xfd, 0x68, 0x64, 0xde, 0xbf, 0x87, 0xe6, 0xd3, 0xaf, 0x2c,
-	0x12, 0x86, 0x7a, 0xc4, 0xbf, 0x3e, 0x79, 0xc0, 0xcd, 0xbd, 0x3d, 0x3d, 0xd1, 0x2f, 0xa9, 0xb5,
-	0x9b, 0x61, 0xf8, 0xff, 0x6b, 0x1a, 0x73, 0x1f, 0xe1, 0x3d, 0x12, 0x1f, 0x82, 0x1c, 0x3b, 0xff,
-	0x87, 0xa8, 0x90, 0x9e, 0x3f, 0x54, 0x3e, 0x2d, 0xc1, 0xf2, 0xa4, 0x04, 0xe9, 0x4e, 0xad, 0xc2,
-	0x82, 0xda, 0x91, 0x9a, 0xcc, 0xef, 0x66, 0xd1, 0x8a, 0x42, 0xb5, 0x0e, 0x68, 0x57, 0x36, 0xa1,
-	0xb7, 0xd7, 0x0f, 0x24, 0x8d, 0x02, 0x0a, 0x31, 0xfe, 0x1c, 0x61, 0x1e, 0xf8, 0x6e, 0x04, 0x3d,
-	0x37, 0x3c, 0xb1, 0xea, 0x7a, 0x3e, 0xf7, 0xc7, 0x27, 0x0f, 0xfc, 0x53, 0x74, 0x06, 0x83, 0x49,
-	0xfa, 0x94, 0x9f, 0xb6, 0x0c, 0x06, 0xe3, 0xf4, 0xf7, 0xd1, 0xbc, 0xc7, 0x85, 0x74, 0x23, 0x42,
-	0xfd, 0xb3, 0xef, 0x5b, 0x5d, 0x1e, 0xc9, 0x8e, 0x26, 0xa1, 0xfe, 0x84, 0x2a, 0xad, 0x01, 0x89,
-	0xea, 0xec, 0x88, 0xc4, 0x94, 0x30, 0x99, 0xa9, 0x22, 0x06, 0x24, 0x72, 0x69, 0x66, 0x9d, 0x42,
-	0x95, 0xe4, 0x4b, 0x2e, 0x51, 0xe5, 0x14, 0x3d, 0x51, 0x65, 0x82, 0x3e, 0x3b, 0x1d, 0x9d, 0xc1,
-	0x60, 0x9c, 0xfe, 0x8f, 0x54, 0xd9, 0xfa, 0xe8, 0xf1, 0xb3, 0x6a, 0xee, 0xc9, 0xb3, 0x6a, 0xee,
-	0x97, 0x67, 0xd5, 0xdc, 0xb7, 0xcf, 0xab, 0x33, 0x4f, 0x9e, 0x57, 0x67, 0x7e, 0x7c, 0x5e, 0x9d,
-	0xf9, 0xf4, 0xfa, 0x59, 0x53, 0x2d, 0xfb, 0x6d, 0xa5, 0x92, 0xeb, 0xcc, 0xa9, 0xdf, 0x4e, 0xef,
-	0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0x50, 0x37, 0x90, 0x96, 0xfa, 0x0d, 0x00, 0x00,
+	// 1428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x98, 0xcb, 0x6f, 0xdb, 0x46,
+	0x13, 0xc0, 0x2d, 0x4b, 0x71, 0xac, 0xf5, 0x43, 0xf2, 0x46, 0x9f, 0xcd, 0xf8, 0x4b, 0x64, 0x7f,
+	0x44, 0xbe, 0xc2, 0x3d, 0x44, 0x44, 0x5c, 0xa0, 0x40, 0x82, 0xa0, 0x85, 0xed, 0xc8, 0xb5, 0x80,
+	0xd8, 0x56, 0x29, 0x39, 0x4d, 0xfa, 0x00, 0xbb, 0x22, 0x57, 0xf2, 0xc2, 0xe4, 0x2e, 0xcb, 0x5d,
+	0xfa, 0x91, 0x7f, 0xa0, 0x3d, 0x16, 0xe8, 0xa1, 0x7f, 0x41, 0x2f, 0xfd, 0x4b, 0x72, 0xcc, 0xb1,
+	0xe8, 0x21, 0x2d, 0x12, 0xf4, 0xd0, 0x5b, 0xd1, 0x6b, 0x2f, 0x05, 0x77, 0x97, 0x7a, 0x39, 0xa9,
+	0x5b, 0xa6, 0x3d, 0xf4, 0x64, 0x73, 0x66, 0xe7, 0x37, 0xbb, 0xc3, 0x99, 0xd9, 0xa1, 0xc0, 0x32,
+	0x25, 0x1d, 0x12, 0xc5, 0x56, 0x88, 0xa3, 0xd0, 0x3a, 0x5e, 0xb7, 0xf0, 0x31, 0xa6, 0xa2, 0x16,
+	0x46, 0x4c, 0x30, 0x38, 0xaf, 0x74, 0xb5, 0x44, 0x57, 0x3b, 0x5e, 0x5f, 0xae, 0xf4, 0x58, 0x8f,
+	0x49, 0x95, 0x95, 0xfc, 0xa7, 0x56, 0x2d, 0x5f, 0xeb, 0x31, 0xd6, 0xf3, 0xb1, 0x85, 0x42, 0x62,
+	0x21, 0x4a, 0x99, 0x40, 0x82, 0x30, 0xca, 0xb5, 0xb6, 0xea, 0x32, 0x1e, 0x30, 0x6e, 0x75, 0x10,
+	0xc7, 0xd6, 0xf1, 0xad, 0x0e, 0x16, 0xe8, 0x96, 0xe5, 0x32, 0x42, 0xb5, 0x7e, 0xdc, 0x3f, 0x17,
+	0x48, 0x60, 0xad, 0x5b, 0xd1, 0x64, 0xf9, 0xd4, 0x89, 0xbb, 0x96, 0x20, 0x01, 0xe6, 0x02, 0x05,
+	0xa1, 0x5a, 0x60, 0xfe, 0x32, 0x05, 0x2a, 0x4d, 0xc6, 0x49, 0xe2, 0x70, 0xeb, 0x10, 0xd1, 0x1e,
+	0xf6, 0xea, 0xc9, 0xfe, 0x61, 0x1d, 0xcc, 0x77, 0x09, 0x45, 0xbe, 0x13, 0x6a, 0xad, 0x91, 0x5b,
+	0xcd, 0xad, 0xcd, 0xac, 0x1b, 0xb5, 0xd1, 0x23, 0xd5, 0x52, 0xeb, 0xcd, 0xc2, 0x93, 0x67, 0x2b,
+	0x13, 0xf6, 0x9c, 0xb4, 0x4a, 0x85, 0xf0, 0x23, 0xb0, 0x90, 0x02, 0x1c, 0xca, 0x92, 0x3f, 0xc8,
+	0x37, 0x26, 0x57, 0x73, 0x6b, 0xc5, 0xcd, 0x5a, 0xb2, 0xfe, 0xfb, 0x67, 0x2b, 0x6f, 0xf4, 0x88,
+	0x38, 0x8c, 0x3b, 0x35, 0x97, 0x05, 0x96, 0x3e, 0xaa, 0xfa, 0x73, 0x93, 0x7b, 0x47, 0x96, 0x38,
+	0x0b, 0x31, 0xaf, 0xdd, 0xc3, 0xae, 0x5d, 0x4e, 0x41, 0x7b, 0x9a, 0x03, 0x3b, 0xa0, 0x24, 0x22,
+	0x44, 0x39, 0x72, 0x25, 0xbf, 0x8b, 0xb1, 0x91, 0x97, 0x9b, 0xbc, 0x5a, 0x53, 0x84, 0x5a, 0x12,
+	0xb3, 0x9a, 0x8e, 0x59, 0x6d, 0x8b, 0x11, 0xba, 0x59, 0x4d, 0xbc, 0xfe, 0xfa, 0x6c, 0x65, 0xf1,
+	0x0c, 0x05, 0xfe, 0x1d, 0x73, 0xcc, 0xde, 0xb4, 0xe7, 0x87, 0x24, 0xdb, 0x18, 0xc3, 0xf7, 0xc1,
+	0x6c, 0x84, 0x91, 0x4f, 0x1e, 0x63, 0xcf, 0x09, 0xa9, 0x6f, 0x14, 0x32, 0xed, 0x7d, 0x26, 0x65,
+	0x34, 0xa9, 0x0f, 0xef, 0x80, 0xe9, 0x0e, 0xf2, 0x1c, 0x0f, 0x77, 0x84, 0x71, 0xe9, 0xa2, 0xfd,
+	0xaa, 0xa8, 0x5e, 0xee, 0x20, 0xef, 0x1e, 0xee, 0x08, 0xf8, 0x01, 0x28, 0x75, 0x63, 0xea, 0x11,
+	0xda, 0x73, 0x42, 0x74, 0x16, 0x60, 0x2a, 0x8c, 0xa9, 0x4c, 0x3b, 0x9a, 0xd7, 0x98, 0xa6, 0xa2,
+	0xc0, 0xff, 0x81, 0xd9, 0x8e, 0xcf, 0xdc, 0x23, 0xe7, 0x10, 0x93, 0xde, 0xa1, 0x30, 0x2e, 0xaf,
+	0xe6, 0xd6, 0xf2, 0xf6, 0x8c, 0x94, 0xed, 0x48, 0x11, 0x6c, 0x83, 0xf9, 0x00, 0x45, 0x3d, 0x42,
+	0x1d, 0xc1, 0x9c, 0x98, 0xe3, 0xc8, 0x98, 0xfe, 0xcb, 0xae, 0x1b, 0x54, 0xd8, 0xb3, 0x8a, 0xd2,
+	0x66, 0x07, 0x1c, 0x47, 0xf0, 0x36, 0x98, 0x73, 0x65, 0xe2, 0x39, 0x11, 0x46, 0x9c, 0x51, 0xa3,
+	0x28, 0xa1, 0x15, 0x0d, 0x9d, 0x55, 0x59, 0x69, 0x4b, 0x9d, 0x3d, 0xeb, 0x0e, 0x3d, 0xc1, 0x03,
+	0x30, 0x8f, 0x4f, 0x95, 0xc4, 0x73, 0x38, 0x79, 0x8c, 0x0d, 0x90, 0x29, 0x16, 0x73, 0x7d, 0x4a,
+	0x8b, 0x3c, 0xc6, 0xf0, 0x13, 0x00, 0x07, 0xd8, 0x7e, 0xd2, 0xce, 0x64, 0x42, 0x2f, 0xf4, 0x49,
+	0x69, 0xd6, 0x9a, 0x9f, 0xe7, 0xc1, 0x52, 0x5a, 0x1f, 0xf7, 0xc9, 0x67, 0x31, 0xf1, 0x90, 0x48,
+	0xab, 0xee, 0x53, 0xb0, 0xd8, 0x2f, 0x97, 0x74, 0x07, 0xb2, 0x9f, 0xe8, 0xea, 0xbb, 0xf1, 0xaa,
+	0xea, 0x1b, 0xae, 0x5d, 0x9d, 0x33, 0x95, 0xf0, 0x65, 0x75, 0x7d, 0x13, 0x40, 0x5f, 0x3b, 0x65,
+	0x91, 0x83, 0x3c, 0x2f, 0xc2, 0x9c, 0xab, 0x8a, 0xb4, 0x17, 0x06, 0x9a, 0x0d, 0xa5, 0x80, 0x3d,
+	0xb0, 0xd0, 0xc5, 0x38, 0x79, 0xe1, 0x03, 0xdd, 0xc5, 0x45, 0xb6, 0xaa, 0x8b, 0xcc, 0x50, 0x45,
+	0x76, 0x8e, 0x60, 0xda, 0xa5, 0x2e, 0xc6, 0x6d, 0x76, 0xbf, 0x2f, 0x81, 0x11, 0xf8, 0x8f, 0x5e,
+	0x86, 0x5d, 0xc6, 0xcf, 0xb8, 0xc0, 0x81, 0x93, 0xa4, 0xa8, 0x51, 0xb8, 0xc8, 0xd9, 0x0d, 0xed,
+	0xec, 0xda, 0x88, 0xb3, 0x51, 0x8a, 0x69, 0x43, 0xe9, 0xb0, 0x9e, 0x4a, 0xb7, 0x13, 0xe1, 0xd7,
+	0x93, 0x83, 0xe6, 0xd7, 0xc2, 0x42, 0xf8, 0x69, 0x90, 0x76, 0x41, 0x21, 0x44, 0x24, 0x92, 0x41,
+	0x2f, 0x6e, 0xde, 0xd6, 0xef, 0xfc, 0xd6, 0xd0, 0x3b, 0xdf, 0x93, 0xaf, 0x61, 0xeb, 0x10, 0x11,
+	0x6a, 0xe9, 0xfe, 0x7b, 0x6a, 0xb9, 0x2c, 0x08, 0x18, 0xb5, 0x10, 0xe7, 0x58, 0xd4, 0x9a, 0x88,
+	0x44, 0xb6, 0xc4, 0xc0, 0xff, 0x83, 0xa4, 0xab, 0x78, 0x78, 0x3c, 0xde, 0x73, 0x4a, 0x9a, 0xc6,
+	0xfa, 0x8b, 0x1c, 0x98, 0xe3, 0x6a, 0x1b, 0x4e, 0xd2, 0xdf, 0xb9, 0x91, 0x5f, 0xcd, 0xff, 0xf1,
+	0xd9, 0x77, 0xf4, 0xd9, 0x2b, 0xea, 0xec, 0x23, 0xd6, 0xe6, 0xb7, 0x3f, 0xac, 0xac, 0xfd, 0x89,
+	0x34, 0x4d, 0x40, 0xdc, 0x9e, 0xd5, 0xb6, 0xf2, 0xc9, 0xfc, 0x29, 0x0f, 0x96, 0xb6, 0x55, 0x83,
+	0xb0, 0x91, 0xc0, 0x23, 0x19, 0xf4, 0x37, 0x07, 0xe7, 0x01, 0x28, 0x05, 0x28, 0x3a, 0x72, 0xc2,
+	0x88, 0xb8, 0xd8, 0x11, 0x27, 0x28, 0xcc, 0x78, 0x3f, 0xcc, 0x25, 0x98, 0x66, 0x42, 0x69, 0x9f,
+	0xa0, 0x10, 0x3e, 0x04, 0x65, 0x42, 0x3d, 0x7c, 0x3a, 0x0c, 0xce, 0x67, 0x6b, 0x95, 0x92, 0x33,
+	0x20, 0x3f, 0x02, 0xe5, 0x30, 0xc2, 0x01, 0x89, 0x03, 0xa7, 0x1b, 0xa9, 0x9b, 0xc2, 0xb8, 0x94,
+	0x89, 0x5c, 0xd2, 0x9c, 0x6d, 0x8d, 0x81, 0x14, 0xfc, 0xd7, 0x8d, 0x83, 0xd8, 0x47, 0x82, 0x1c,
+	0x63, 0xe7, 0x9c, 0x97, 0x6c, 0xad, 0xfe, 0xea, 0x00, 0xd9, 0x1c, 0xf5, 0x67, 0xfe, 0x3c, 0x09,
+	0x16, 0xd3, 0x22, 0x4c, 0x2e, 0x3c, 0x44, 0xfe, 0xa9, 0x1a, 0x58, 0x04, 0x53, 0x2a, 0xdb, 0x75,
+	0xee, 0xeb, 0x27, 0x58, 0x05, 0x60, 0xac, 0xb3, 0x14, 0xed, 0x21, 0x09, 0x7c, 0x00, 0xa6, 0xf4,
+	0xbd, 0x90, 0x34, 0x82, 0xf9, 0xf5, 0x77, 0xc6, 0x3b, 0xe0, 0xcb, 0xb7, 0x7f, 0x5e, 0xac, 0x6f,
+	0x10, 0x4d, 0x33, 0x43, 0xb0, 0xf4, 0x8a, 0x25, 0xb0, 0x04, 0x66, 0x0e, 0xf6, 0x5a, 0xcd, 0xfa,
+	0x56, 0x63, 0xbb, 0x51, 0xbf, 0x57, 0x9e, 0x80, 0x15, 0x50, 0x6e, 0xee, 0xb7, 0x1a, 0xed, 0xc6,
+	0xfe, 0x9e, 0xb3, 0x53, 0xdf, 0xb8, 0xdf, 0xde, 0x79, 0x54, 0xce, 0x25, 0xd2, 0xbd, 0xfd, 0xbd,
+	0xfa, 0xc3, 0x46, 0xab, 0x5d, 0xdf, 0x6b, 0x3b, 0xcd, 0x8d, 0x86, 0x5d, 0x9e, 0x84, 0x06, 0xa8,
+	0x8c, 0x48, 0xb5, 0x5d, 0x39, 0x6f, 0xfe, 0x96, 0x03, 0xa5, 0x8d, 0x20, 0x38, 0x08, 0x87, 0xfa,
+	0xfd, 0xdb, 0xa0, 0xa8, 0xa6, 0x2c, 0x14, 0x04, 0xba, 0xc5, 0x5f, 0x19, 0x3f, 0xe0, 0xc6, 0xee,
+	0xae, 0xee, 0xe8, 0xd3, 0x72, 0xed, 0x46, 0x10, 0xfc, 0xfb, 0x8a, 0xc6, 0x3c, 0x00, 0x70, 0x17,
+	0x45, 0x47, 0x58, 0x8c, 0x9c, 0xff, 0x5d, 0x30, 0xab, 0xce, 0x1f, 0x48, 0x9d, 0x0e, 0xc1, 0xe2,
+	0x78, 0x08, 0x94, 0xa5, 0x8e, 0xc2, 0x8c, 0xb4, 0x50, 0x22, 0xf3, 0xab, 0x49, 0xb0, 0x24, 0x51,
+	0xad, 0x43, 0xd2, 0x15, 0x4d, 0xdc, 0xdb, 0x8d, 0x7d, 0x41, 0x42, 0x9f, 0xe0, 0x08, 0x7e, 0x0c,
+	0x20, 0xf3, 0x3d, 0x27, 0xc4, 0x3d, 0x27, 0xe8, 0x4b, 0x8d, 0x5c, 0xa6, 0xe3, 0x94, 0x99, 0xef,
+	0x9d, 0xa3, 0x53, 0x7c, 0x32, 0x4e, 0xcf, 0x38, 0xda, 0x52, 0x7c, 0x32, 0x4a, 0xbf, 0x0b, 0x8a,
+	0x2e, 0xe3, 0xc2, 0x09, 0x11, 0xf1, 0x2e, 0xbe, 0x6f, 0x75, 0x7a, 0x24, 0x16, 0x4d, 0x44, 0xbc,
+	0xb1, 0xa8, 0xb4, 0x4e, 0x50, 0xd8, 0xa0, 0xc7, 0x28, 0x22, 0x88, 0x8a, 0x34, 0x2a, 0xfc, 0x04,
+	0x85, 0x0e, 0x49, 0xa5, 0x46, 0x2e, 0xd3, 0x24, 0x97, 0x44, 0xe5, 0x1c, 0x3d, 0x89, 0xca, 0x18,
+	0x7d, 0x32, 0x1b, 0x9d, 0xe2, 0x93, 0x51, 0xfa, 0xeb, 0x45, 0xe5, 0x49, 0x0e, 0x5c, 0xdf, 0x62,
+	0xd4, 0x23, 0x6a, 0x10, 0xdb, 0x8f, 0x3c, 0x1c, 0xd5, 0x4f, 0xb1, 0x1b, 0xf7, 0xd3, 0xf1, 0x2e,
+	0xb8, 0xc4, 0x12, 0xa9, 0xce, 0xc3, 0xd5, 0xf1, 0x3c, 0x1c, 0xb7, 0xd6, 0x2e, 0x94, 0x11, 0x5c,
+	0x06, 0xd3, 0x58, 0xe2, 0x58, 0xda, 0xe4, 0xfa, 0xcf, 0x70, 0x17, 0x80, 0x41, 0xc1, 0x66, 0x2c,
+	0xa9, 0x62, 0xbf, 0x56, 0xcd, 0x6f, 0x0a, 0x60, 0x41, 0xcd, 0x7a, 0xc9, 0x7c, 0xde, 0x8a, 0x83,
+	0x00, 0x45, 0x67, 0xe7, 0x66, 0xf8, 0xdc, 0xf9, 0x19, 0xfe, 0x3a, 0x00, 0x34, 0x0e, 0x1c, 0xd9,
+	0x7c, 0xd5, 0x18, 0x52, 0xb0, 0x8b, 0x34, 0x0e, 0xda, 0x52, 0x00, 0xb7, 0xc1, 0xd4, 0x31, 0xf3,
+	0xe3, 0x20, 0xcb, 0x16, 0x93, 0x57, 0xa6, 0xad, 0xe1, 0x26, 0x28, 0x74, 0x31, 0xe6, 0x46, 0x21,
+	0x13, 0x45, 0xda, 0xc2, 0x37, 0x41, 0x39, 0xd9, 0xaa, 0x3f, 0xe8, 0xd2, 0x5c, 0x5e, 0xb3, 0x05,
+	0xbb, 0x44, 0xe3, 0x60, 0xa8, 0x79, 0x73, 0xe8, 0x80, 0x2b, 0x7e, 0x7f, 0x92, 0x1e, 0x8c, 0xec,
+	0x53, 0x99, 0xbc, 0xc3, 0x01, 0xaa, 0xff, 0xa5, 0xd9, 0x18, 0xfa, 0x64, 0xbb, 0x9c, 0x89, 0xda,
+	0xff, 0x82, 0x3b, 0x02, 0x95, 0x58, 0xb5, 0x40, 0x87, 0x45, 0xc8, 0xf5, 0x71, 0x92, 0xcd, 0x11,
+	0x37, 0xa6, 0x57, 0xf3, 0xaf, 0x77, 0xcf, 0x42, 0x8d, 0xdd, 0x97, 0xd4, 0x44, 0xc4, 0x37, 0xdf,
+	0x7b, 0xf2, 0xbc, 0x9a, 0x7b, 0xfa, 0xbc, 0x9a, 0xfb, 0xf1, 0x79, 0x35, 0xf7, 0xe5, 0x8b, 0xea,
+	0xc4, 0xd3, 0x17, 0xd5, 0x89, 0xef, 0x5e, 0x54, 0x27, 0x3e, 0xbc, 0x79, 0x91, 0x83, 0xf4, 0xe7,
+	0x04, 0x79, 0x84, 0xce, 0x94, 0xfc, 0xb9, 0xe0, 0xad, 0xdf, 0x07, 0x00, 0x80, 0x2e, 0x99, 0x3c,
+	0xed, 0x10, 0x00, 0x00,
 }
 
 func (m *PositionChangedEvent) Marshal() (dAtA []byte, err error) {
@@ -1272,6 +1420,148 @@ func (m *EventShiftSwapInvariant) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ConditionalOrderExecutedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConditionalOrderExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConditionalOrderExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBlockSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedOraclePairs) > 0 {
+		for iNdEx := len(m.UpdatedOraclePairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.UpdatedOraclePairs[iNdEx].Size()
+				i -= size
+				if _, err := m.UpdatedOraclePairs[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.BadDebt.Size()
+		i -= size
+		if _, err := m.BadDebt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.LiquidatedNotional.Size()
+		i -= size
+		if _, err := m.LiquidatedNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.NumLiquidations != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.NumLiquidations))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.NumTrades != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.NumTrades))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -1452,6 +1742,55 @@ func (m *EventShiftSwapInvariant) Size() (n int) {
 	return n
 }
 
+func (m *ConditionalOrderExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventBlockSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.NumTrades != 0 {
+		n += 1 + sovEvent(uint64(m.NumTrades))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.NumLiquidations != 0 {
+		n += 1 + sovEvent(uint64(m.NumLiquidations))
+	}
+	l = m.LiquidatedNotional.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if len(m.UpdatedOraclePairs) > 0 {
+		for _, e := range m.UpdatedOraclePairs {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3118,6 +3457,434 @@ func (m *EventShiftSwapInvariant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConditionalOrderExecutedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConditionalOrderExecutedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConditionalOrderExecutedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBlockSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBlockSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTrades", wireType)
+			}
+			m.NumTrades = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTrades |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumLiquidations", wireType)
+			}
+			m.NumLiquidations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumLiquidations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatedNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidatedNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedOraclePairs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_NibiruChain_nibiru_x_common_asset.Pair
+			m.UpdatedOraclePairs = append(m.UpdatedOraclePairs, v)
+			if err := m.UpdatedOraclePairs[len(m.UpdatedOraclePairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,6 +40,37 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	seenOrders := make(map[uint64]bool, len(gs.ConditionalOrders))
+	for _, order := range gs.ConditionalOrders {
+		if err := order.Validate(); err != nil {
+			return err
+		}
+		if seenOrders[order.Id] {
+			return fmt.Errorf("duplicate conditional order id %d", order.Id)
+		}
+		seenOrders[order.Id] = true
+		if order.Id >= gs.NextConditionalOrderId {
+			return fmt.Errorf("conditional order id %d is not lower than the next order id %d",
+				order.Id, gs.NextConditionalOrderId)
+		}
+	}
+
+	type epochTrader struct {
+		epoch  uint64
+		trader string
+	}
+	seenStats := make(map[epochTrader]bool, len(gs.TraderStats))
+	for _, stats := range gs.TraderStats {
+		if err := stats.Validate(); err != nil {
+			return err
+		}
+		key := epochTrader{epoch: stats.Epoch, trader: stats.Trader}
+		if seenStats[key] {
+			return fmt.Errorf("duplicate stats of trader %s in epoch %d", stats.Trader, stats.Epoch)
+		}
+		seenStats[key] = true
+	}
+
 	// TODO: validate positions
 	//for _, pos := range gs.Positions {
 	//	if err := pos.Validate(); err != nil {
@@ -65,7 +97,9 @@ func DefaultMarket(pair asset.Pair) Market {
 		PrepaidBadDebt:                  sdk.NewCoin(TestingCollateralDenomNUSD, sdk.ZeroInt()),
 		MaintenanceMarginRatio:          sdk.MustNewDecFromStr("0.0625"),
 		MaxLeverage:                     sdk.NewDec(10),
+		InitialMarginRatio:              sdk.MustNewDecFromStr("0.1"),
 		OraclePair:                      asset.NewPair(pair.BaseDenom(), denoms.USD),
+		PriceFluctuationLimitRatio:      sdk.ZeroDec(),
 	}
 }
 
@@ -37,14 +37,18 @@ type GenesisState struct {
 	ReserveSnapshots []ReserveSnapshot `protobuf:"bytes,5,rep,name=reserve_snapshots,json=reserveSnapshots,proto3" json:"reserve_snapshots"`
 	DnrEpoch         uint64            `protobuf:"varint,6,opt,name=dnr_epoch,json=dnrEpoch,proto3" json:"dnr_epoch,omitempty"`
 	// For testing purposes, we allow the collateral to be set at genesis
-	CollateralDenom    string                        `protobuf:"bytes,11,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
-	TraderVolumes      []GenesisState_TraderVolume   `protobuf:"bytes,7,rep,name=trader_volumes,json=traderVolumes,proto3" json:"trader_volumes"`
-	GlobalDiscount     []GenesisState_Discount       `protobuf:"bytes,8,rep,name=global_discount,json=globalDiscount,proto3" json:"global_discount"`
-	CustomDiscounts    []GenesisState_CustomDiscount `protobuf:"bytes,9,rep,name=custom_discounts,json=customDiscounts,proto3" json:"custom_discounts"`
-	MarketLastVersions []GenesisMarketLastVersion    `protobuf:"bytes,10,rep,name=market_last_versions,json=marketLastVersions,proto3" json:"market_last_versions"`
-	GlobalVolumes      []GenesisState_GlobalVolume   `protobuf:"bytes,13,rep,name=global_volumes,json=globalVolumes,proto3" json:"global_volumes"`
-	RebatesAllocations []DNRAllocation               `protobuf:"bytes,12,rep,name=rebates_allocations,json=rebatesAllocations,proto3" json:"rebates_allocations"`
-	DnrEpochName       string                        `protobuf:"bytes,14,opt,name=dnr_epoch_name,json=dnrEpochName,proto3" json:"dnr_epoch_name,omitempty"`
+	CollateralDenom    string                          `protobuf:"bytes,11,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
+	TraderVolumes      []GenesisState_TraderVolume     `protobuf:"bytes,7,rep,name=trader_volumes,json=traderVolumes,proto3" json:"trader_volumes"`
+	GlobalDiscount     []GenesisState_Discount         `protobuf:"bytes,8,rep,name=global_discount,json=globalDiscount,proto3" json:"global_discount"`
+	CustomDiscounts    []GenesisState_CustomDiscount   `protobuf:"bytes,9,rep,name=custom_discounts,json=customDiscounts,proto3" json:"custom_discounts"`
+	MarketLastVersions []GenesisMarketLastVersion      `protobuf:"bytes,10,rep,name=market_last_versions,json=marketLastVersions,proto3" json:"market_last_versions"`
+	GlobalVolumes      []GenesisState_GlobalVolume     `protobuf:"bytes,13,rep,name=global_volumes,json=globalVolumes,proto3" json:"global_volumes"`
+	RebatesAllocations []DNRAllocation                 `protobuf:"bytes,12,rep,name=rebates_allocations,json=rebatesAllocations,proto3" json:"rebates_allocations"`
+	DnrEpochName       string                          `protobuf:"bytes,14,opt,name=dnr_epoch_name,json=dnrEpochName,proto3" json:"dnr_epoch_name,omitempty"`
+	TraderStats        []GenesisState_TraderStatsEntry `protobuf:"bytes,15,rep,name=trader_stats,json=traderStats,proto3" json:"trader_stats"`
+	ConditionalOrders  []ConditionalOrder              `protobuf:"bytes,16,rep,name=conditional_orders,json=conditionalOrders,proto3" json:"conditional_orders"`
+	// id assigned to the next conditional order
+	NextConditionalOrderId uint64 `protobuf:"varint,17,opt,name=next_conditional_order_id,json=nextConditionalOrderId,proto3" json:"next_conditional_order_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -171,6 +175,27 @@ func (m *GenesisState) GetDnrEpochName() string {
 	return ""
 }
 
+func (m *GenesisState) GetTraderStats() []GenesisState_TraderStatsEntry {
+	if m != nil {
+		return m.TraderStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConditionalOrders() []ConditionalOrder {
+	if m != nil {
+		return m.ConditionalOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextConditionalOrderId() uint64 {
+	if m != nil {
+		return m.NextConditionalOrderId
+	}
+	return 0
+}
+
 type GenesisState_TraderVolume struct {
 	Trader string                                 `protobuf:"bytes,1,opt,name=trader,proto3" json:"trader,omitempty"`
 	Epoch  uint64                                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
@@ -359,6 +384,66 @@ func (m *GenesisState_GlobalVolume) GetEpoch() uint64 {
 	return 0
 }
 
+type GenesisState_TraderStatsEntry struct {
+	Trader string      `protobuf:"bytes,1,opt,name=trader,proto3" json:"trader,omitempty"`
+	Epoch  uint64      `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Stats  TraderStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *GenesisState_TraderStatsEntry) Reset()         { *m = GenesisState_TraderStatsEntry{} }
+func (m *GenesisState_TraderStatsEntry) String() string { return proto.CompactTextString(m) }
+func (*GenesisState_TraderStatsEntry) ProtoMessage()    {}
+func (*GenesisState_TraderStatsEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2c7acfef3993fde, []int{0, 4}
+}
+func (m *GenesisState_TraderStatsEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState_TraderStatsEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState_TraderStatsEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState_TraderStatsEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState_TraderStatsEntry.Merge(m, src)
+}
+func (m *GenesisState_TraderStatsEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState_TraderStatsEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState_TraderStatsEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState_TraderStatsEntry proto.InternalMessageInfo
+
+func (m *GenesisState_TraderStatsEntry) GetTrader() string {
+	if m != nil {
+		return m.Trader
+	}
+	return ""
+}
+
+func (m *GenesisState_TraderStatsEntry) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *GenesisState_TraderStatsEntry) GetStats() TraderStats {
+	if m != nil {
+		return m.Stats
+	}
+	return TraderStats{}
+}
+
 // GenesisMarketLastVersion is the last version including pair only used for
 // genesis
 type GenesisMarketLastVersion struct {
@@ -465,6 +550,7 @@ func init() {
 	proto.RegisterType((*GenesisState_Discount)(nil), "nibiru.perp.v2.GenesisState.Discount")
 	proto.RegisterType((*GenesisState_CustomDiscount)(nil), "nibiru.perp.v2.GenesisState.CustomDiscount")
 	proto.RegisterType((*GenesisState_GlobalVolume)(nil), "nibiru.perp.v2.GenesisState.GlobalVolume")
+	proto.RegisterType((*GenesisState_TraderStatsEntry)(nil), "nibiru.perp.v2.GenesisState.TraderStatsEntry")
 	proto.RegisterType((*GenesisMarketLastVersion)(nil), "nibiru.perp.v2.GenesisMarketLastVersion")
 	proto.RegisterType((*GenesisPosition)(nil), "nibiru.perp.v2.GenesisPosition")
 }
@@ -472,57 +558,63 @@ func init() {
 func init() { proto.RegisterFile("nibiru/perp/v2/genesis.proto", fileDescriptor_c2c7acfef3993fde) }
 
 var fileDescriptor_c2c7acfef3993fde = []byte{
-	// 788 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xdf, 0x6a, 0x13, 0x4f,
-	0x14, 0xce, 0x36, 0x69, 0x9a, 0x4c, 0xd2, 0xa4, 0xbf, 0x69, 0x29, 0x43, 0x7e, 0x35, 0x2d, 0x45,
-	0x25, 0x45, 0xba, 0x4b, 0x23, 0x08, 0x7a, 0x65, 0xd3, 0x68, 0x11, 0x4c, 0x29, 0xdb, 0xd2, 0x0b,
-	0x11, 0xd6, 0xc9, 0x66, 0xdc, 0x2c, 0xdd, 0xdd, 0x59, 0x76, 0x26, 0x41, 0xaf, 0xf5, 0x01, 0x14,
-	0x7c, 0x16, 0x9f, 0xa1, 0x97, 0xbd, 0x14, 0x2f, 0x8a, 0xb4, 0x2f, 0x22, 0x3b, 0x33, 0x9b, 0x3f,
-	0x4b, 0x53, 0x2b, 0x05, 0xaf, 0x92, 0x39, 0xe7, 0x7c, 0xdf, 0xf9, 0xe6, 0xec, 0x37, 0x33, 0x60,
-	0x2d, 0x70, 0xbb, 0x6e, 0x34, 0x30, 0x42, 0x12, 0x85, 0xc6, 0xb0, 0x69, 0x38, 0x24, 0x20, 0xcc,
-	0x65, 0x7a, 0x18, 0x51, 0x4e, 0x61, 0x45, 0x66, 0xf5, 0x38, 0xab, 0x0f, 0x9b, 0xb5, 0xba, 0x4d,
-	0x99, 0x4f, 0x99, 0xd1, 0xc5, 0x8c, 0x18, 0xc3, 0x9d, 0x2e, 0xe1, 0x78, 0xc7, 0xb0, 0xa9, 0x1b,
-	0xc8, 0xfa, 0xda, 0x9a, 0x43, 0xa9, 0xe3, 0x11, 0x03, 0x87, 0xae, 0x81, 0x83, 0x80, 0x72, 0xcc,
-	0x5d, 0x1a, 0x28, 0xb6, 0xda, 0x8a, 0x43, 0x1d, 0x2a, 0xfe, 0x1a, 0xf1, 0x3f, 0x15, 0xad, 0xa5,
-	0x14, 0x30, 0x8e, 0x39, 0x91, 0xb9, 0xcd, 0xaf, 0x25, 0x50, 0xde, 0x97, 0x8a, 0x8e, 0xe2, 0x30,
-	0x7c, 0x02, 0x16, 0x7c, 0x1c, 0x9d, 0x12, 0xce, 0xd0, 0xdc, 0x46, 0xb6, 0x51, 0x6a, 0xae, 0xea,
-	0xd3, 0x12, 0xf5, 0x8e, 0x48, 0xb7, 0x72, 0x67, 0x17, 0xeb, 0x19, 0x33, 0x29, 0x86, 0xdb, 0x20,
-	0x87, 0x7d, 0x9f, 0xa1, 0xac, 0x00, 0x2d, 0xa7, 0x41, 0xbb, 0x9d, 0x8e, 0x42, 0x88, 0x32, 0xb8,
-	0x07, 0x8a, 0x21, 0x65, 0xae, 0x10, 0x8f, 0x72, 0x02, 0xb3, 0x9e, 0xc6, 0x28, 0x5d, 0x87, 0xaa,
-	0x4e, 0xe1, 0xc7, 0x38, 0x68, 0x82, 0xff, 0x22, 0xc2, 0x48, 0x34, 0x24, 0x16, 0x0b, 0x70, 0xc8,
-	0xfa, 0x94, 0x33, 0x34, 0x7f, 0x3d, 0x99, 0x29, 0x0b, 0x8f, 0x54, 0x9d, 0x22, 0x5b, 0x8a, 0xa6,
-	0xc3, 0x0c, 0xfe, 0x0f, 0x8a, 0xbd, 0x20, 0xb2, 0x48, 0x48, 0xed, 0x3e, 0xca, 0x6f, 0x68, 0x8d,
-	0x9c, 0x59, 0xe8, 0x05, 0xd1, 0x8b, 0x78, 0x0d, 0xb7, 0xc0, 0x92, 0x4d, 0x3d, 0x0f, 0x73, 0x12,
-	0x61, 0xcf, 0xea, 0x91, 0x80, 0xfa, 0xa8, 0xb4, 0xa1, 0x35, 0x8a, 0x66, 0x75, 0x1c, 0x6f, 0xc7,
-	0x61, 0x78, 0x02, 0x2a, 0x3c, 0xc2, 0x3d, 0x12, 0x59, 0x43, 0xea, 0x0d, 0x7c, 0xc2, 0xd0, 0x82,
-	0x10, 0xb6, 0x35, 0x63, 0x97, 0x62, 0xfa, 0xfa, 0xb1, 0x80, 0x9c, 0x08, 0x84, 0x92, 0xb8, 0xc8,
-	0x27, 0x62, 0x0c, 0x1e, 0x83, 0xaa, 0xe3, 0xd1, 0x6e, 0xdc, 0xde, 0x65, 0x36, 0x1d, 0x04, 0x1c,
-	0x15, 0x04, 0xf1, 0x83, 0x1b, 0x89, 0xdb, 0xaa, 0x58, 0x91, 0x56, 0x24, 0x47, 0x12, 0x85, 0x6f,
-	0xc1, 0x92, 0x3d, 0x60, 0x9c, 0xfa, 0x23, 0x56, 0x86, 0x8a, 0x82, 0xf6, 0xd1, 0x8d, 0xb4, 0x7b,
-	0x02, 0x94, 0x22, 0xaf, 0xda, 0x53, 0x51, 0x06, 0xdf, 0x81, 0x15, 0x69, 0x13, 0xcb, 0xc3, 0x8c,
-	0x5b, 0x43, 0x12, 0x31, 0xf1, 0xdd, 0x81, 0xe8, 0xd0, 0x98, 0xd1, 0x41, 0xfa, 0xec, 0x35, 0x66,
-	0xfc, 0x44, 0x02, 0x14, 0x3d, 0xf4, 0xd3, 0x09, 0x16, 0x4f, 0x5b, 0x4d, 0x25, 0x99, 0xf6, 0xe2,
-	0x2d, 0xa6, 0xbd, 0x2f, 0x20, 0xd3, 0xd3, 0x76, 0x26, 0x62, 0xf1, 0xb4, 0x97, 0x23, 0xd2, 0xc5,
-	0x9c, 0x30, 0x0b, 0x7b, 0x1e, 0xb5, 0xe5, 0x69, 0x43, 0x65, 0x41, 0x7e, 0x2f, 0x4d, 0xde, 0x3e,
-	0x30, 0x77, 0x47, 0x55, 0x89, 0x5a, 0x85, 0x1f, 0x27, 0x18, 0xbc, 0x0f, 0x2a, 0x23, 0x8f, 0x59,
-	0x01, 0xf6, 0x09, 0xaa, 0x08, 0x13, 0x95, 0x13, 0xa3, 0x1d, 0x60, 0x9f, 0xd4, 0x3e, 0x6b, 0xa0,
-	0x3c, 0xe9, 0x07, 0xb8, 0x0a, 0xf2, 0xd2, 0x0b, 0x48, 0x13, 0xe5, 0x6a, 0x05, 0x57, 0xc0, 0xbc,
-	0xb4, 0xeb, 0x9c, 0xb0, 0xab, 0x5c, 0xc0, 0x97, 0x20, 0x2f, 0x67, 0x81, 0xb2, 0x71, 0x75, 0x4b,
-	0x8f, 0xe5, 0xfc, 0xbc, 0x58, 0x7f, 0xe8, 0xb8, 0xbc, 0x3f, 0xe8, 0xea, 0x36, 0xf5, 0x0d, 0x75,
-	0xd9, 0xc8, 0x9f, 0x6d, 0xd6, 0x3b, 0x35, 0xf8, 0xc7, 0x90, 0x30, 0xfd, 0x55, 0xc0, 0x4d, 0x85,
-	0xae, 0x7d, 0xd3, 0x40, 0x61, 0xe4, 0x93, 0xe7, 0x20, 0xfb, 0x9e, 0x10, 0xd9, 0xff, 0xaf, 0x18,
-	0xdb, 0xc4, 0x36, 0x63, 0xe8, 0x84, 0xac, 0xb9, 0x3b, 0xc9, 0x3a, 0x05, 0x95, 0x69, 0xf3, 0xcd,
-	0x1c, 0xcf, 0x2e, 0x28, 0x8c, 0x8e, 0x4a, 0xdc, 0xf3, 0xb6, 0x47, 0xc5, 0x1c, 0xc1, 0x6a, 0x1e,
-	0x28, 0x4f, 0x7a, 0x65, 0x3c, 0x71, 0xed, 0xfa, 0x89, 0xdf, 0x69, 0x6b, 0x9b, 0x9f, 0x34, 0x80,
-	0x66, 0x9d, 0x01, 0xd8, 0x01, 0xb9, 0x10, 0xbb, 0x6a, 0x8f, 0xad, 0xa7, 0xaa, 0xc5, 0xce, 0x44,
-	0x8b, 0x03, 0xb1, 0xb7, 0xbd, 0x3e, 0x76, 0x03, 0x43, 0xdd, 0xfc, 0x1f, 0x0c, 0x9b, 0xfa, 0x3e,
-	0x0d, 0x0c, 0xcc, 0x18, 0xe1, 0xfa, 0x21, 0x76, 0x23, 0x53, 0xd0, 0x40, 0x04, 0x16, 0xd4, 0x71,
-	0x54, 0xee, 0x49, 0x96, 0x9b, 0xdf, 0x35, 0x50, 0x4d, 0xdd, 0xc0, 0xff, 0xac, 0x39, 0x7c, 0x06,
-	0x0a, 0xc9, 0x35, 0x2f, 0xec, 0x5b, 0x6a, 0xa2, 0xf4, 0x37, 0x4b, 0x3d, 0x0b, 0xa3, 0xfa, 0xd6,
-	0xfe, 0xd9, 0x65, 0x5d, 0x3b, 0xbf, 0xac, 0x6b, 0xbf, 0x2e, 0xeb, 0xda, 0x97, 0xab, 0x7a, 0xe6,
-	0xfc, 0xaa, 0x9e, 0xf9, 0x71, 0x55, 0xcf, 0xbc, 0xd9, 0xfe, 0x93, 0xd0, 0xe4, 0x85, 0x14, 0xdf,
-	0xa4, 0x9b, 0x17, 0x4f, 0xe4, 0xe3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x5d, 0xaf, 0x6c,
-	0xc2, 0x07, 0x00, 0x00,
+	// 895 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xce, 0xc6, 0x4e, 0xe2, 0x9c, 0x38, 0xb6, 0x33, 0x8d, 0xa2, 0xc1, 0x2d, 0x4e, 0x14, 0x01,
+	0x4a, 0x85, 0xb2, 0xab, 0x04, 0x09, 0x54, 0xae, 0xc8, 0x4f, 0x89, 0x2a, 0x91, 0x50, 0x6d, 0x4b,
+	0x2e, 0x10, 0xd2, 0x32, 0x5e, 0x0f, 0xce, 0x2a, 0xbb, 0x33, 0xab, 0x39, 0x63, 0xab, 0xb9, 0x86,
+	0x07, 0xe0, 0x82, 0x67, 0xe1, 0x19, 0x7a, 0xd9, 0x4b, 0xc4, 0x45, 0x85, 0x92, 0x77, 0xe0, 0x1a,
+	0xed, 0xcc, 0xf8, 0x6f, 0x69, 0x4a, 0xaa, 0x4a, 0x5c, 0x79, 0xf7, 0xcc, 0xf7, 0x7d, 0xe7, 0x9b,
+	0xb3, 0xe7, 0xcc, 0x18, 0x1e, 0x88, 0xa4, 0x9b, 0xa8, 0x41, 0x90, 0x73, 0x95, 0x07, 0xc3, 0xfd,
+	0xa0, 0xcf, 0x05, 0xc7, 0x04, 0xfd, 0x5c, 0x49, 0x2d, 0x49, 0xc3, 0xae, 0xfa, 0xc5, 0xaa, 0x3f,
+	0xdc, 0x6f, 0x77, 0x62, 0x89, 0x99, 0xc4, 0xa0, 0xcb, 0x90, 0x07, 0xc3, 0xbd, 0x2e, 0xd7, 0x6c,
+	0x2f, 0x88, 0x65, 0x22, 0x2c, 0xbe, 0xfd, 0xa0, 0x2f, 0x65, 0x3f, 0xe5, 0x01, 0xcb, 0x93, 0x80,
+	0x09, 0x21, 0x35, 0xd3, 0x89, 0x14, 0x4e, 0xad, 0xbd, 0xde, 0x97, 0x7d, 0x69, 0x1e, 0x83, 0xe2,
+	0xc9, 0x45, 0xdb, 0x25, 0x07, 0xa8, 0x99, 0xe6, 0x76, 0x6d, 0xfb, 0xef, 0x55, 0xa8, 0x9f, 0x58,
+	0x47, 0xcf, 0x8a, 0x30, 0xf9, 0x1c, 0x96, 0x32, 0xa6, 0x2e, 0xb9, 0x46, 0x3a, 0xbf, 0x55, 0xd9,
+	0x59, 0xd9, 0xdf, 0xf0, 0x67, 0x2d, 0xfa, 0xa7, 0x66, 0xf9, 0xb0, 0xfa, 0xf2, 0xf5, 0xe6, 0x5c,
+	0x38, 0x02, 0x93, 0x5d, 0xa8, 0xb2, 0x2c, 0x43, 0x5a, 0x31, 0xa4, 0x7b, 0x65, 0xd2, 0xc1, 0xe9,
+	0xa9, 0x63, 0x18, 0x18, 0x39, 0x82, 0xe5, 0x5c, 0x62, 0x62, 0xcc, 0xd3, 0xaa, 0xe1, 0x6c, 0x96,
+	0x39, 0xce, 0xd7, 0x53, 0x87, 0x73, 0xfc, 0x09, 0x8f, 0x84, 0xb0, 0xa6, 0x38, 0x72, 0x35, 0xe4,
+	0x11, 0x0a, 0x96, 0xe3, 0x85, 0xd4, 0x48, 0x17, 0xde, 0x2c, 0x16, 0x5a, 0xe0, 0x33, 0x87, 0x73,
+	0x62, 0x2d, 0x35, 0x1b, 0x46, 0x72, 0x1f, 0x96, 0x7b, 0x42, 0x45, 0x3c, 0x97, 0xf1, 0x05, 0x5d,
+	0xdc, 0xf2, 0x76, 0xaa, 0x61, 0xad, 0x27, 0xd4, 0xe3, 0xe2, 0x9d, 0x3c, 0x84, 0x56, 0x2c, 0xd3,
+	0x94, 0x69, 0xae, 0x58, 0x1a, 0xf5, 0xb8, 0x90, 0x19, 0x5d, 0xd9, 0xf2, 0x76, 0x96, 0xc3, 0xe6,
+	0x24, 0x7e, 0x5c, 0x84, 0xc9, 0x39, 0x34, 0xb4, 0x62, 0x3d, 0xae, 0xa2, 0xa1, 0x4c, 0x07, 0x19,
+	0x47, 0xba, 0x64, 0x8c, 0x3d, 0xbc, 0x65, 0x97, 0xa6, 0xfa, 0xfe, 0x73, 0x43, 0x39, 0x37, 0x0c,
+	0x67, 0x71, 0x55, 0x4f, 0xc5, 0x90, 0x3c, 0x87, 0x66, 0x3f, 0x95, 0xdd, 0x22, 0x7d, 0x82, 0xb1,
+	0x1c, 0x08, 0x4d, 0x6b, 0x46, 0xf8, 0xe3, 0xb7, 0x0a, 0x1f, 0x3b, 0xb0, 0x13, 0x6d, 0x58, 0x8d,
+	0x51, 0x94, 0xfc, 0x00, 0xad, 0x78, 0x80, 0x5a, 0x66, 0x63, 0x55, 0xa4, 0xcb, 0x46, 0xf6, 0xd3,
+	0xb7, 0xca, 0x1e, 0x19, 0x52, 0x49, 0xbc, 0x19, 0xcf, 0x44, 0x91, 0xfc, 0x08, 0xeb, 0xb6, 0x4d,
+	0xa2, 0x94, 0xa1, 0x8e, 0x86, 0x5c, 0xa1, 0xf9, 0xee, 0x60, 0x32, 0xec, 0xdc, 0x92, 0xc1, 0xf6,
+	0xd9, 0x37, 0x0c, 0xf5, 0xb9, 0x25, 0x38, 0x79, 0x92, 0x95, 0x17, 0xb0, 0xa8, 0xb6, 0xab, 0xca,
+	0xa8, 0xda, 0xab, 0x77, 0xa8, 0xf6, 0x89, 0xa1, 0xcc, 0x56, 0xbb, 0x3f, 0x15, 0x2b, 0xaa, 0x7d,
+	0x4f, 0xf1, 0x2e, 0xd3, 0x1c, 0x23, 0x96, 0xa6, 0x32, 0xb6, 0xd3, 0x46, 0xeb, 0x46, 0xfc, 0xc3,
+	0xb2, 0xf8, 0xf1, 0x59, 0x78, 0x30, 0x46, 0x8d, 0xdc, 0x3a, 0xfe, 0x64, 0x01, 0xc9, 0x47, 0xd0,
+	0x18, 0xf7, 0x58, 0x24, 0x58, 0xc6, 0x69, 0xc3, 0x34, 0x51, 0x7d, 0xd4, 0x68, 0x67, 0x2c, 0xe3,
+	0xe4, 0x1c, 0xea, 0xae, 0x83, 0x8a, 0x81, 0x45, 0xda, 0x34, 0x49, 0x77, 0xef, 0xd0, 0x3f, 0xc5,
+	0x33, 0x3e, 0x16, 0x5a, 0x5d, 0x39, 0x13, 0x2b, 0x7a, 0x12, 0x27, 0xdf, 0x01, 0x89, 0xa5, 0xe8,
+	0x99, 0x19, 0x62, 0x69, 0x24, 0x55, 0x8f, 0x2b, 0xa4, 0x2d, 0xa3, 0xbe, 0x55, 0x56, 0x3f, 0x9a,
+	0x20, 0xbf, 0x2d, 0x80, 0x4e, 0x70, 0x2d, 0x2e, 0xc5, 0x91, 0x3c, 0x82, 0x0f, 0x04, 0x7f, 0xa1,
+	0xa3, 0x7f, 0x69, 0x47, 0x49, 0x8f, 0xae, 0x99, 0x41, 0xda, 0x28, 0x00, 0x65, 0xc5, 0x27, 0xbd,
+	0xf6, 0x2f, 0x1e, 0xd4, 0xa7, 0x3b, 0x9f, 0x6c, 0xc0, 0xa2, 0x75, 0x4c, 0x3d, 0x53, 0x18, 0xf7,
+	0x46, 0xd6, 0x61, 0xc1, 0x0e, 0xe6, 0xbc, 0xd1, 0xb3, 0x2f, 0xe4, 0x6b, 0x58, 0xb4, 0x5f, 0x9d,
+	0x56, 0x0a, 0xf4, 0xa1, 0x5f, 0x58, 0xfc, 0xf3, 0xf5, 0xe6, 0x27, 0xfd, 0x44, 0x5f, 0x0c, 0xba,
+	0x7e, 0x2c, 0xb3, 0xc0, 0x1d, 0xab, 0xf6, 0x67, 0x17, 0x7b, 0x97, 0x81, 0xbe, 0xca, 0x39, 0xfa,
+	0x4f, 0x84, 0x0e, 0x1d, 0xbb, 0xfd, 0x9b, 0x07, 0xb5, 0xf1, 0x44, 0x7c, 0x05, 0x95, 0x9f, 0x38,
+	0xa7, 0xde, 0x3b, 0x2b, 0x1e, 0xf3, 0x38, 0x2c, 0xa8, 0x53, 0xb6, 0xe6, 0xdf, 0xcb, 0xd6, 0x25,
+	0x34, 0x66, 0xc7, 0xec, 0xd6, 0xf2, 0x1c, 0x40, 0x6d, 0x7c, 0x28, 0x14, 0x39, 0xef, 0x7a, 0x28,
+	0x84, 0x63, 0x5a, 0x3b, 0x85, 0xfa, 0xf4, 0x54, 0x4c, 0x2a, 0xee, 0xbd, 0xb9, 0xe2, 0xef, 0xb7,
+	0xb5, 0x2b, 0x68, 0x95, 0x3b, 0xf6, 0x1d, 0xbf, 0xfd, 0x17, 0xb0, 0x60, 0xa7, 0xa3, 0x62, 0xf6,
+	0x7b, 0xbf, 0xbc, 0xdf, 0x29, 0x79, 0xd7, 0xba, 0x16, 0xbf, 0xfd, 0xb3, 0x07, 0xf4, 0xb6, 0x83,
+	0x86, 0x9c, 0x42, 0x35, 0x67, 0x89, 0x73, 0x70, 0xf8, 0xc8, 0xed, 0x6e, 0x6f, 0x6a, 0x77, 0x67,
+	0x26, 0xcd, 0xd1, 0x05, 0x4b, 0x44, 0xe0, 0xae, 0xd7, 0x17, 0x41, 0x2c, 0xb3, 0x4c, 0x8a, 0x80,
+	0x21, 0x72, 0xed, 0x3f, 0x65, 0x89, 0x0a, 0x8d, 0x0c, 0xa1, 0xb0, 0xe4, 0xce, 0x3c, 0x67, 0x7e,
+	0xf4, 0xba, 0xfd, 0xbb, 0x07, 0xcd, 0xd2, 0x35, 0xf7, 0xbf, 0x25, 0x27, 0x5f, 0x42, 0x6d, 0x74,
+	0x97, 0xba, 0xf2, 0xd1, 0x72, 0xf9, 0x4a, 0x77, 0xef, 0x18, 0x7f, 0x78, 0xf2, 0xf2, 0xba, 0xe3,
+	0xbd, 0xba, 0xee, 0x78, 0x7f, 0x5d, 0x77, 0xbc, 0x5f, 0x6f, 0x3a, 0x73, 0xaf, 0x6e, 0x3a, 0x73,
+	0x7f, 0xdc, 0x74, 0xe6, 0xbe, 0xdf, 0xfd, 0x2f, 0xa3, 0xa3, 0xbf, 0x21, 0xa6, 0x1d, 0xba, 0x8b,
+	0xe6, 0x7f, 0xc8, 0x67, 0xff, 0x0c, 0x00, 0xd9, 0xe6, 0x64, 0x25, 0x27, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -545,6 +637,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextConditionalOrderId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextConditionalOrderId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.ConditionalOrders) > 0 {
+		for iNdEx := len(m.ConditionalOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConditionalOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.TraderStats) > 0 {
+		for iNdEx := len(m.TraderStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraderStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.DnrEpochName) > 0 {
 		i -= len(m.DnrEpochName)
 		copy(dAtA[i:], m.DnrEpochName)
@@ -875,6 +1004,51 @@ func (m *GenesisState_GlobalVolume) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisState_TraderStatsEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState_TraderStatsEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState_TraderStatsEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisMarketLastVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1049,6 +1223,21 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.TraderStats) > 0 {
+		for _, e := range m.TraderStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConditionalOrders) > 0 {
+		for _, e := range m.ConditionalOrders {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextConditionalOrderId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextConditionalOrderId))
+	}
 	return n
 }
 
@@ -1114,6 +1303,24 @@ func (m *GenesisState_GlobalVolume) Size() (n int) {
 	return n
 }
 
+func (m *GenesisState_TraderStatsEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *GenesisMarketLastVersion) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1602,6 +1809,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.DnrEpochName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderStats = append(m.TraderStats, GenesisState_TraderStatsEntry{})
+			if err := m.TraderStats[len(m.TraderStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConditionalOrders = append(m.ConditionalOrders, ConditionalOrder{})
+			if err := m.ConditionalOrders[len(m.ConditionalOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextConditionalOrderId", wireType)
+			}
+			m.NextConditionalOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextConditionalOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2097,6 +2391,140 @@ func (m *GenesisState_GlobalVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GenesisState_TraderStatsEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraderStatsEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraderStatsEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisMarketLastVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -66,6 +66,7 @@ func TestGenesisValidate(t *testing.T) {
 		PrepaidBadDebt:                  sdk.NewCoin(denoms.USDC, sdk.ZeroInt()),
 		MaintenanceMarginRatio:          sdk.MustNewDecFromStr("0.0625"),
 		MaxLeverage:                     sdk.NewDec(10),
+		InitialMarginRatio:              sdk.MustNewDecFromStr("0.1"),
 	}
 	invalidAmms := types.AMM{
 		BaseReserve:     sdk.ZeroDec(),
@@ -136,6 +137,29 @@ func TestGenesisValidate(t *testing.T) {
 			},
 			shouldFail: true,
 		},
+		{
+			name: "invalid trader stats",
+			setupGenesis: func() *types.GenesisState {
+				genesis := types.GenesisState{
+					Markets:          []types.Market{validMarket},
+					Amms:             []types.AMM{validAmms},
+					Positions:        []types.GenesisPosition{validPositions},
+					ReserveSnapshots: []types.ReserveSnapshot{},
+					TraderStats: []types.GenesisState_TraderStatsEntry{{
+						Epoch:  1,
+						Trader: "invalid",
+						Stats: types.TraderStats{
+							Volume:      sdk.OneInt(),
+							RealizedPnl: sdk.ZeroDec(),
+							Fees:        sdk.ZeroInt(),
+						},
+					}},
+				}
+
+				return &genesis
+			},
+			shouldFail: true,
+		},
 		{
 			name: "invalid position",
 			setupGenesis: func() *types.GenesisState {
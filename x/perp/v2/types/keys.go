@@ -17,6 +17,10 @@ var (
 
 	MemStoreKey = "mem_" + ModuleName
 
+	// TStoreKey defines the transient store key, for state that only lives
+	// for the current block.
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey is the message route for perp.
 	RouterKey = ModuleName
 
@@ -46,18 +46,47 @@ func (market Market) Validate() error {
 		return fmt.Errorf("margin ratio opened with max leverage position will be lower than Maintenance margin ratio")
 	}
 
+	if market.InitialMarginRatio.IsNil() ||
+		market.InitialMarginRatio.LT(market.MaintenanceMarginRatio) ||
+		market.InitialMarginRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("initial margin ratio must be maintenance margin ratio <= ratio <= 1")
+	}
+
+	if market.InitialMarginRatio.LT(sdk.OneDec().Quo(market.MaxLeverage)) {
+		return fmt.Errorf("initial margin ratio must be >= 1 / max leverage, or positions opened with max leverage are undercollateralized")
+	}
+
+	if !market.PriceFluctuationLimitRatio.IsNil() && !isPercent(market.PriceFluctuationLimitRatio) {
+		return fmt.Errorf("price fluctuation limit ratio must be 0 <= ratio <= 1")
+	}
+
 	if err := market.OraclePair.Validate(); err != nil {
 		return fmt.Errorf("err when validating oracle pair %w", err)
 	}
 
+	if err := market.TradingSchedule.Validate(); err != nil {
+		return fmt.Errorf("err when validating trading schedule: %w", err)
+	}
+
 	return nil
 }
 
+// IsOpen returns true if the market is enabled and its trading schedule
+// allows trading at the given block time.
+func (market Market) IsOpen(blockTime time.Time) bool {
+	return market.Enabled && market.TradingSchedule.IsOpen(blockTime)
+}
+
 func (market Market) WithMaintenanceMarginRatio(value sdk.Dec) Market {
 	market.MaintenanceMarginRatio = value
 	return market
 }
 
+func (market Market) WithInitialMarginRatio(value sdk.Dec) Market {
+	market.InitialMarginRatio = value
+	return market
+}
+
 func (market Market) WithMaxLeverage(value sdk.Dec) Market {
 	market.MaxLeverage = value
 	return market
@@ -138,6 +167,16 @@ func (market Market) WithTwapLookbackWindow(value time.Duration) Market {
 	return market
 }
 
+func (market Market) WithTradingSchedule(value TradingSchedule) Market {
+	market.TradingSchedule = value
+	return market
+}
+
+func (market Market) WithPriceFluctuationLimitRatio(value sdk.Dec) Market {
+	market.PriceFluctuationLimitRatio = value
+	return market
+}
+
 func MarketsAreEqual(expected, actual Market) error {
 	if expected.Pair != actual.Pair {
 		return fmt.Errorf("expected market pair %s, got %s", expected.Pair, actual.Pair)
@@ -147,6 +186,10 @@ func MarketsAreEqual(expected, actual Market) error {
 		return fmt.Errorf("expected market maintenance margin ratio %s, got %s", expected.MaintenanceMarginRatio, actual.MaintenanceMarginRatio)
 	}
 
+	if !expected.InitialMarginRatio.Equal(actual.InitialMarginRatio) {
+		return fmt.Errorf("expected market initial margin ratio %s, got %s", expected.InitialMarginRatio, actual.InitialMarginRatio)
+	}
+
 	if !expected.MaxLeverage.Equal(actual.MaxLeverage) {
 		return fmt.Errorf("expected market max leverage %s, got %s", expected.MaxLeverage, actual.MaxLeverage)
 	}
@@ -191,6 +234,18 @@ func MarketsAreEqual(expected, actual Market) error {
 		return fmt.Errorf("expected oracle pair %s, got %s", expected.OraclePair, actual.OraclePair)
 	}
 
+	if expected.PriceFluctuationLimitRatio.String() != actual.PriceFluctuationLimitRatio.String() {
+		return fmt.Errorf(
+			"expected market price fluctuation limit ratio %s, got %s",
+			expected.PriceFluctuationLimitRatio,
+			actual.PriceFluctuationLimitRatio,
+		)
+	}
+
+	if expected.TradingSchedule.String() != actual.TradingSchedule.String() {
+		return fmt.Errorf("expected trading schedule %s, got %s", expected.TradingSchedule.String(), actual.TradingSchedule.String())
+	}
+
 	if !expected.LatestCumulativePremiumFraction.Equal(actual.LatestCumulativePremiumFraction) {
 		return fmt.Errorf(
 			"expected market latest cumulative premium fraction %s, got %s",
@@ -201,3 +256,63 @@ func MarketsAreEqual(expected, actual Market) error {
 
 	return nil
 }
+
+// Validate checks that the closed weekdays are valid days of the week and
+// that every maintenance window ends after it starts.
+func (schedule TradingSchedule) Validate() error {
+	seen := make(map[uint32]bool)
+	for _, weekday := range schedule.ClosedWeekdays {
+		if weekday > uint32(time.Saturday) {
+			return fmt.Errorf("invalid closed weekday %d: must be 0 (Sunday) to 6 (Saturday)", weekday)
+		}
+		if seen[weekday] {
+			return fmt.Errorf("duplicate closed weekday %d", weekday)
+		}
+		seen[weekday] = true
+	}
+
+	for _, window := range schedule.MaintenanceWindows {
+		if window.StartMs < 0 || window.EndMs <= window.StartMs {
+			return fmt.Errorf(
+				"invalid maintenance window [%d, %d): end must be after a non-negative start",
+				window.StartMs, window.EndMs,
+			)
+		}
+	}
+
+	return nil
+}
+
+// IsOpen returns false if the block time falls on a closed weekday (UTC) or
+// inside one of the maintenance windows.
+func (schedule TradingSchedule) IsOpen(blockTime time.Time) bool {
+	weekday := uint32(blockTime.UTC().Weekday())
+	for _, closedWeekday := range schedule.ClosedWeekdays {
+		if weekday == closedWeekday {
+			return false
+		}
+	}
+
+	blockTimeMs := blockTime.UnixMilli()
+	for _, window := range schedule.MaintenanceWindows {
+		if blockTimeMs >= window.StartMs && blockTimeMs < window.EndMs {
+			return false
+		}
+	}
+
+	return true
+}
+
+// WithoutEndedWindows returns a copy of the schedule without the maintenance
+// windows that ended at or before the given block time.
+func (schedule TradingSchedule) WithoutEndedWindows(blockTime time.Time) TradingSchedule {
+	blockTimeMs := blockTime.UnixMilli()
+	var windows []MaintenanceWindow
+	for _, window := range schedule.MaintenanceWindows {
+		if window.EndMs > blockTimeMs {
+			windows = append(windows, window)
+		}
+	}
+	schedule.MaintenanceWindows = windows
+	return schedule
+}
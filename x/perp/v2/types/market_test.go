@@ -23,6 +23,7 @@ func TestValidate(t *testing.T) {
 	// Test when all values are within expected ranges
 	market := Market{}.
 		WithMaintenanceMarginRatio(sdk.NewDecWithPrec(1, 1)).
+		WithInitialMarginRatio(sdk.NewDecWithPrec(1, 1)).
 		WithEcosystemFee(sdk.NewDecWithPrec(3, 1)).
 		WithExchangeFee(sdk.NewDecWithPrec(4, 1)).
 		WithLiquidationFee(sdk.NewDecWithPrec(2, 1)).
@@ -64,6 +65,10 @@ func TestValidate(t *testing.T) {
 			modifier:      func(m Market) Market { return m.WithMaxFundingRate(sdk.NewDec(-1)) },
 			requiredError: "max funding rate must be >= 0",
 		},
+		{
+			modifier:      func(m Market) Market { return m.WithPriceFluctuationLimitRatio(sdk.NewDec(2)) },
+			requiredError: "price fluctuation limit ratio must be 0 <= ratio <= 1",
+		},
 		{
 			modifier:      func(m Market) Market { return m.WithOraclePair("abc") },
 			requiredError: "err when validating oracle pair abc: invalid token pair",
@@ -74,6 +79,38 @@ func TestValidate(t *testing.T) {
 			},
 			requiredError: "margin ratio opened with max leverage position will be lower than Maintenance margin ratio",
 		},
+		{
+			modifier:      func(m Market) Market { return m.WithInitialMarginRatio(sdk.NewDecWithPrec(5, 2)) },
+			requiredError: "initial margin ratio must be maintenance margin ratio <= ratio <= 1",
+		},
+		{
+			modifier:      func(m Market) Market { return m.WithInitialMarginRatio(sdk.NewDec(2)) },
+			requiredError: "initial margin ratio must be maintenance margin ratio <= ratio <= 1",
+		},
+		{
+			modifier:      func(m Market) Market { return m.WithMaxLeverage(sdk.NewDec(5)) },
+			requiredError: "initial margin ratio must be >= 1 / max leverage",
+		},
+		{
+			modifier: func(m Market) Market {
+				return m.WithTradingSchedule(TradingSchedule{ClosedWeekdays: []uint32{7}})
+			},
+			requiredError: "invalid closed weekday 7",
+		},
+		{
+			modifier: func(m Market) Market {
+				return m.WithTradingSchedule(TradingSchedule{ClosedWeekdays: []uint32{6, 6}})
+			},
+			requiredError: "duplicate closed weekday 6",
+		},
+		{
+			modifier: func(m Market) Market {
+				return m.WithTradingSchedule(TradingSchedule{
+					MaintenanceWindows: []MaintenanceWindow{{StartMs: 10, EndMs: 10}},
+				})
+			},
+			requiredError: "invalid maintenance window [10, 10)",
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -87,9 +124,66 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestTradingScheduleIsOpen(t *testing.T) {
+	friday := time.Date(2023, time.September, 15, 23, 0, 0, 0, time.UTC)
+	schedule := TradingSchedule{
+		ClosedWeekdays: []uint32{uint32(time.Saturday), uint32(time.Sunday)},
+		MaintenanceWindows: []MaintenanceWindow{{
+			StartMs: friday.Add(-time.Hour).UnixMilli(),
+			EndMs:   friday.UnixMilli(),
+		}},
+	}
+
+	for _, tc := range []struct {
+		name      string
+		blockTime time.Time
+		want      bool
+	}{
+		{name: "open on weekday", blockTime: friday.Add(-2 * time.Hour), want: true},
+		{name: "closed during maintenance", blockTime: friday.Add(-time.Minute), want: false},
+		{name: "open at end of maintenance", blockTime: friday, want: true},
+		{name: "closed on saturday", blockTime: friday.Add(time.Hour), want: false},
+		{
+			name:      "weekday is computed in UTC",
+			blockTime: friday.Add(time.Hour).In(time.FixedZone("UTC-5", -5*60*60)),
+			want:      false,
+		},
+		{name: "closed on sunday", blockTime: friday.Add(25 * time.Hour), want: false},
+		{name: "open on monday", blockTime: friday.Add(49 * time.Hour), want: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, schedule.IsOpen(tc.blockTime))
+		})
+	}
+
+	require.True(t, TradingSchedule{}.IsOpen(friday.Add(time.Hour)))
+	require.False(t, Market{Enabled: false}.IsOpen(friday))
+	require.True(t, Market{Enabled: true}.IsOpen(friday))
+}
+
+func TestTradingScheduleWithoutEndedWindows(t *testing.T) {
+	now := time.Date(2023, time.September, 15, 12, 0, 0, 0, time.UTC)
+	ended := MaintenanceWindow{StartMs: now.Add(-2 * time.Hour).UnixMilli(), EndMs: now.UnixMilli()}
+	ongoing := MaintenanceWindow{StartMs: now.Add(-time.Hour).UnixMilli(), EndMs: now.Add(time.Hour).UnixMilli()}
+	upcoming := MaintenanceWindow{StartMs: now.Add(time.Hour).UnixMilli(), EndMs: now.Add(2 * time.Hour).UnixMilli()}
+
+	schedule := TradingSchedule{
+		ClosedWeekdays:     []uint32{uint32(time.Sunday)},
+		MaintenanceWindows: []MaintenanceWindow{ended, ongoing, upcoming},
+	}
+	pruned := schedule.WithoutEndedWindows(now)
+	require.Equal(t, []uint32{uint32(time.Sunday)}, pruned.ClosedWeekdays)
+	require.Equal(t, []MaintenanceWindow{ongoing, upcoming}, pruned.MaintenanceWindows)
+	// the original schedule is not modified
+	require.Len(t, schedule.MaintenanceWindows, 3)
+
+	require.Empty(t, schedule.WithoutEndedWindows(now.Add(2*time.Hour)).MaintenanceWindows)
+}
+
 func TestMarketEqual(t *testing.T) {
 	market := Market{}.
 		WithMaintenanceMarginRatio(sdk.NewDecWithPrec(1, 1)).
+		WithInitialMarginRatio(sdk.NewDecWithPrec(1, 1)).
 		WithEcosystemFee(sdk.NewDecWithPrec(3, 1)).
 		WithExchangeFee(sdk.NewDecWithPrec(4, 1)).
 		WithLiquidationFee(sdk.NewDecWithPrec(2, 1)).
@@ -114,6 +208,10 @@ func TestMarketEqual(t *testing.T) {
 			modifier:      func(m Market) Market { return m.WithMaintenanceMarginRatio(sdk.NewDec(42)) },
 			requiredError: "expected market maintenance margin ratio",
 		},
+		{
+			modifier:      func(m Market) Market { return m.WithInitialMarginRatio(sdk.NewDec(42)) },
+			requiredError: "expected market initial margin ratio",
+		},
 		{
 			modifier:      func(m Market) Market { return m.WithMaxLeverage(sdk.NewDec(42)) },
 			requiredError: "expected market max leverage",
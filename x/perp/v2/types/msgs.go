@@ -22,6 +22,11 @@ var (
 	_ sdk.Msg = &MsgShiftPegMultiplier{}
 	_ sdk.Msg = &MsgShiftSwapInvariant{}
 	_ sdk.Msg = &MsgWithdrawFromPerpFund{}
+	_ sdk.Msg = &MsgSetTradingSchedule{}
+	_ sdk.Msg = &MsgSetMarketRiskParams{}
+	_ sdk.Msg = &MsgPlaceConditionalOrder{}
+	_ sdk.Msg = &MsgCancelConditionalOrder{}
+	_ sdk.Msg = &MsgExecuteConditionalOrder{}
 )
 
 // ------------------------ MsgRemoveMargin ------------------------
@@ -462,3 +467,144 @@ func (m MsgWithdrawFromPerpFund) GetSigners() []sdk.AccAddress {
 func (m MsgWithdrawFromPerpFund) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
+
+// ------------------------ MsgSetTradingSchedule ------------------------
+
+func (m MsgSetTradingSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	return m.TradingSchedule.Validate()
+}
+
+func (m MsgSetTradingSchedule) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgSetTradingSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgSetMarketRiskParams ------------------------
+
+func (m MsgSetMarketRiskParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	if m.MaintenanceMarginRatio.IsNil() || m.InitialMarginRatio.IsNil() || m.MaxLeverage.IsNil() {
+		return fmt.Errorf("maintenance margin ratio, initial margin ratio and max leverage must be set")
+	}
+	if !isPercent(m.MaintenanceMarginRatio) {
+		return fmt.Errorf("maintenance margin ratio must be 0 <= ratio <= 1")
+	}
+	if m.InitialMarginRatio.LT(m.MaintenanceMarginRatio) || m.InitialMarginRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("initial margin ratio must be maintenance margin ratio <= ratio <= 1")
+	}
+	if !m.MaxLeverage.IsPositive() {
+		return fmt.Errorf("max leverage must be > 0")
+	}
+	if sdk.OneDec().Quo(m.MaxLeverage).LT(m.MaintenanceMarginRatio) {
+		return fmt.Errorf("1 / max leverage must be >= maintenance margin ratio")
+	}
+	if m.PriceFluctuationLimitRatio != nil && !isPercent(*m.PriceFluctuationLimitRatio) {
+		return fmt.Errorf("price fluctuation limit ratio must be 0 <= ratio <= 1")
+	}
+	return nil
+}
+
+func (m MsgSetMarketRiskParams) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgSetMarketRiskParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgPlaceConditionalOrder ------------------------
+
+func (m MsgPlaceConditionalOrder) Route() string { return "perp" }
+func (m MsgPlaceConditionalOrder) Type() string  { return "place_conditional_order_msg" }
+
+func (m MsgPlaceConditionalOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	return validateConditionalOrder(m.TriggerCondition, m.TriggerPrice, m.Size_, m.ExecutionFee)
+}
+
+func (m MsgPlaceConditionalOrder) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgPlaceConditionalOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgCancelConditionalOrder ------------------------
+
+func (m MsgCancelConditionalOrder) Route() string { return "perp" }
+func (m MsgCancelConditionalOrder) Type() string  { return "cancel_conditional_order_msg" }
+
+func (m MsgCancelConditionalOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgCancelConditionalOrder) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgCancelConditionalOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ------------------------ MsgExecuteConditionalOrder ------------------------
+
+func (m MsgExecuteConditionalOrder) Route() string { return "perp" }
+func (m MsgExecuteConditionalOrder) Type() string  { return "execute_conditional_order_msg" }
+
+func (m MsgExecuteConditionalOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgExecuteConditionalOrder) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgExecuteConditionalOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
@@ -460,6 +460,81 @@ func TestMsgValidateBasic(t *testing.T) {
 			expectErr:     true,
 			expectedError: ErrGeneric.Error(),
 		},
+		{
+			name: "MsgPlaceConditionalOrder: valid",
+			msg: &MsgPlaceConditionalOrder{
+				Sender:           validSender,
+				Pair:             validPair,
+				TriggerCondition: TriggerCondition_PRICE_AT_OR_BELOW,
+				TriggerPrice:     sdk.NewDec(25_000),
+				Size_:            sdk.ZeroDec(),
+				ExecutionFee:     sdk.NewInt(100),
+			},
+			expectErr: false,
+		},
+		{
+			name: "MsgPlaceConditionalOrder: unspecified trigger condition",
+			msg: &MsgPlaceConditionalOrder{
+				Sender:       validSender,
+				Pair:         validPair,
+				TriggerPrice: sdk.NewDec(25_000),
+				Size_:        sdk.ZeroDec(),
+				ExecutionFee: sdk.NewInt(100),
+			},
+			expectErr:     true,
+			expectedError: "invalid trigger condition",
+		},
+		{
+			name: "MsgPlaceConditionalOrder: non positive trigger price",
+			msg: &MsgPlaceConditionalOrder{
+				Sender:           validSender,
+				Pair:             validPair,
+				TriggerCondition: TriggerCondition_PRICE_AT_OR_ABOVE,
+				TriggerPrice:     sdk.ZeroDec(),
+				Size_:            sdk.ZeroDec(),
+				ExecutionFee:     sdk.NewInt(100),
+			},
+			expectErr:     true,
+			expectedError: "trigger price must be positive",
+		},
+		{
+			name: "MsgPlaceConditionalOrder: negative size",
+			msg: &MsgPlaceConditionalOrder{
+				Sender:           validSender,
+				Pair:             validPair,
+				TriggerCondition: TriggerCondition_PRICE_AT_OR_ABOVE,
+				TriggerPrice:     sdk.NewDec(25_000),
+				Size_:            sdk.NewDec(-1),
+				ExecutionFee:     sdk.NewInt(100),
+			},
+			expectErr:     true,
+			expectedError: "size must not be negative",
+		},
+		{
+			name: "MsgPlaceConditionalOrder: negative execution fee",
+			msg: &MsgPlaceConditionalOrder{
+				Sender:           validSender,
+				Pair:             validPair,
+				TriggerCondition: TriggerCondition_PRICE_AT_OR_ABOVE,
+				TriggerPrice:     sdk.NewDec(25_000),
+				Size_:            sdk.ZeroDec(),
+				ExecutionFee:     sdk.NewInt(-1),
+			},
+			expectErr:     true,
+			expectedError: "execution fee must not be negative",
+		},
+		{
+			name:          "MsgCancelConditionalOrder: invalid sender",
+			msg:           &MsgCancelConditionalOrder{Sender: "invalidaddr", OrderId: 1},
+			expectErr:     true,
+			expectedError: sdkerrors.ErrInvalidAddress.Error(),
+		},
+		{
+			name:          "MsgExecuteConditionalOrder: invalid sender",
+			msg:           &MsgExecuteConditionalOrder{Sender: "invalidaddr", OrderId: 1},
+			expectErr:     true,
+			expectedError: sdkerrors.ErrInvalidAddress.Error(),
+		},
 	}
 
 	for _, tc := range testCases {
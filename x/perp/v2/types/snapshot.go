@@ -5,6 +5,17 @@ import (
 	time "time"
 )
 
+const (
+	// ReserveSnapshotRetentionWindow is how long reserve snapshots are kept for
+	// TWAP queries. Markets with a longer TWAP lookback window keep their
+	// snapshots for that window instead.
+	ReserveSnapshotRetentionWindow = 24 * time.Hour
+
+	// MaxReserveSnapshotPrunesPerBlock bounds the number of expired reserve
+	// snapshots deleted by a single end blocker, across all pairs.
+	MaxReserveSnapshotPrunesPerBlock uint64 = 100
+)
+
 func (s ReserveSnapshot) Validate() error {
 	err := s.Amm.Pair.Validate()
 	if err != nil {
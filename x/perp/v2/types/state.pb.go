@@ -97,6 +97,40 @@ func (TwapCalcOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_8f4829f34f7b8040, []int{1}
 }
 
+// TriggerCondition is the mark price TWAP condition that makes a conditional
+// order executable.
+type TriggerCondition int32
+
+const (
+	TriggerCondition_TRIGGER_CONDITION_UNSPECIFIED TriggerCondition = 0
+	// The order executes when the mark price TWAP is at or above the trigger
+	// price.
+	TriggerCondition_PRICE_AT_OR_ABOVE TriggerCondition = 1
+	// The order executes when the mark price TWAP is at or below the trigger
+	// price.
+	TriggerCondition_PRICE_AT_OR_BELOW TriggerCondition = 2
+)
+
+var TriggerCondition_name = map[int32]string{
+	0: "TRIGGER_CONDITION_UNSPECIFIED",
+	1: "PRICE_AT_OR_ABOVE",
+	2: "PRICE_AT_OR_BELOW",
+}
+
+var TriggerCondition_value = map[string]int32{
+	"TRIGGER_CONDITION_UNSPECIFIED": 0,
+	"PRICE_AT_OR_ABOVE":             1,
+	"PRICE_AT_OR_BELOW":             2,
+}
+
+func (x TriggerCondition) String() string {
+	return proto.EnumName(TriggerCondition_name, int32(x))
+}
+
+func (TriggerCondition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8f4829f34f7b8040, []int{2}
+}
+
 type Market struct {
 	// the trading pair represented by this market
 	// always BASE:QUOTE, e.g. BTC:NUSD or ETH:NUSD
@@ -140,6 +174,19 @@ type Market struct {
 	// the pair of the oracle that is used to determine the index price
 	// for the market
 	OraclePair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,15,opt,name=oracle_pair,json=oraclePair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"oracle_pair"`
+	// the hours during which the market can be traded. An empty schedule means
+	// the market is always open.
+	TradingSchedule TradingSchedule `protobuf:"bytes,16,opt,name=trading_schedule,json=tradingSchedule,proto3" json:"trading_schedule"`
+	// the maximum relative change of the mark price within a block, measured
+	// against the reserve snapshot of the previous block. Trades that open,
+	// increase or flip a position and move the price further fail. Closing or
+	// reducing a position, including through conditional orders, and
+	// liquidations are not limited. Zero disables the limit.
+	PriceFluctuationLimitRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=price_fluctuation_limit_ratio,json=priceFluctuationLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_fluctuation_limit_ratio"`
+	// the minimum margin ratio a position must have after it is opened or
+	// increased, or after margin is removed from it. It lies between the
+	// maintenance margin ratio and 1, and is at least 1 / max_leverage.
+	InitialMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=initial_margin_ratio,json=initialMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_margin_ratio"`
 }
 
 func (m *Market) Reset()         { *m = Market{} }
@@ -210,6 +257,128 @@ func (m *Market) GetPrepaidBadDebt() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Market) GetTradingSchedule() TradingSchedule {
+	if m != nil {
+		return m.TradingSchedule
+	}
+	return TradingSchedule{}
+}
+
+// TradingSchedule defines when a market is closed for trading, e.g. over the
+// weekend for markets tracking TradFi assets. Market orders are rejected while
+// the market is closed. Positions can still be closed or partially closed,
+// margin can still be added or removed, and unhealthy positions can still be
+// liquidated.
+type TradingSchedule struct {
+	// days of the week, in UTC, on which the market is closed. Sunday is 0 and
+	// Saturday is 6.
+	ClosedWeekdays []uint32 `protobuf:"varint,1,rep,packed,name=closed_weekdays,json=closedWeekdays,proto3" json:"closed_weekdays,omitempty"`
+	// maintenance windows during which the market is closed. Windows that
+	// ended are removed from the market in the EndBlocker.
+	MaintenanceWindows []MaintenanceWindow `protobuf:"bytes,2,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows"`
+}
+
+func (m *TradingSchedule) Reset()         { *m = TradingSchedule{} }
+func (m *TradingSchedule) String() string { return proto.CompactTextString(m) }
+func (*TradingSchedule) ProtoMessage()    {}
+func (*TradingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f4829f34f7b8040, []int{1}
+}
+func (m *TradingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingSchedule.Merge(m, src)
+}
+func (m *TradingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradingSchedule proto.InternalMessageInfo
+
+func (m *TradingSchedule) GetClosedWeekdays() []uint32 {
+	if m != nil {
+		return m.ClosedWeekdays
+	}
+	return nil
+}
+
+func (m *TradingSchedule) GetMaintenanceWindows() []MaintenanceWindow {
+	if m != nil {
+		return m.MaintenanceWindows
+	}
+	return nil
+}
+
+// MaintenanceWindow is a closed period of time [start_ms, end_ms) given as
+// unix timestamps in milliseconds.
+type MaintenanceWindow struct {
+	StartMs int64 `protobuf:"varint,1,opt,name=start_ms,json=startMs,proto3" json:"start_ms,omitempty"`
+	EndMs   int64 `protobuf:"varint,2,opt,name=end_ms,json=endMs,proto3" json:"end_ms,omitempty"`
+}
+
+func (m *MaintenanceWindow) Reset()         { *m = MaintenanceWindow{} }
+func (m *MaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceWindow) ProtoMessage()    {}
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f4829f34f7b8040, []int{2}
+}
+func (m *MaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceWindow.Merge(m, src)
+}
+func (m *MaintenanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceWindow proto.InternalMessageInfo
+
+func (m *MaintenanceWindow) GetStartMs() int64 {
+	if m != nil {
+		return m.StartMs
+	}
+	return 0
+}
+
+func (m *MaintenanceWindow) GetEndMs() int64 {
+	if m != nil {
+		return m.EndMs
+	}
+	return 0
+}
+
 // MarketLastVersion is used to store the last version of the market
 type MarketLastVersion struct {
 	// version of the market
@@ -220,7 +389,7 @@ func (m *MarketLastVersion) Reset()         { *m = MarketLastVersion{} }
 func (m *MarketLastVersion) String() string { return proto.CompactTextString(m) }
 func (*MarketLastVersion) ProtoMessage()    {}
 func (*MarketLastVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f4829f34f7b8040, []int{1}
+	return fileDescriptor_8f4829f34f7b8040, []int{3}
 }
 func (m *MarketLastVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,7 +451,7 @@ func (m *AMM) Reset()         { *m = AMM{} }
 func (m *AMM) String() string { return proto.CompactTextString(m) }
 func (*AMM) ProtoMessage()    {}
 func (*AMM) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f4829f34f7b8040, []int{2}
+	return fileDescriptor_8f4829f34f7b8040, []int{4}
 }
 func (m *AMM) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,7 +509,7 @@ func (m *Position) Reset()         { *m = Position{} }
 func (m *Position) String() string { return proto.CompactTextString(m) }
 func (*Position) ProtoMessage()    {}
 func (*Position) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f4829f34f7b8040, []int{3}
+	return fileDescriptor_8f4829f34f7b8040, []int{5}
 }
 func (m *Position) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +563,7 @@ func (m *ReserveSnapshot) Reset()         { *m = ReserveSnapshot{} }
 func (m *ReserveSnapshot) String() string { return proto.CompactTextString(m) }
 func (*ReserveSnapshot) ProtoMessage()    {}
 func (*ReserveSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f4829f34f7b8040, []int{4}
+	return fileDescriptor_8f4829f34f7b8040, []int{6}
 }
 func (m *ReserveSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,7 +618,7 @@ func (m *DNRAllocation) Reset()         { *m = DNRAllocation{} }
 func (m *DNRAllocation) String() string { return proto.CompactTextString(m) }
 func (*DNRAllocation) ProtoMessage()    {}
 func (*DNRAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f4829f34f7b8040, []int{5}
+	return fileDescriptor_8f4829f34f7b8040, []int{7}
 }
 func (m *DNRAllocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -492,96 +661,257 @@ func (m *DNRAllocation) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// TraderStats are the trading statistics of a trader over a DnR epoch.
+type TraderStats struct {
+	// notional value exchanged by the trader's position changes
+	Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"volume"`
+	// sum of the PnL realized when reducing, closing or settling positions
+	RealizedPnl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
+	// exchange and ecosystem fund fees paid
+	Fees github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=fees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fees"`
+	// number of position changes
+	NumTrades uint64 `protobuf:"varint,4,opt,name=num_trades,json=numTrades,proto3" json:"num_trades,omitempty"`
+}
+
+func (m *TraderStats) Reset()         { *m = TraderStats{} }
+func (m *TraderStats) String() string { return proto.CompactTextString(m) }
+func (*TraderStats) ProtoMessage()    {}
+func (*TraderStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f4829f34f7b8040, []int{8}
+}
+func (m *TraderStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraderStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraderStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TraderStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraderStats.Merge(m, src)
+}
+func (m *TraderStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraderStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraderStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraderStats proto.InternalMessageInfo
+
+func (m *TraderStats) GetNumTrades() uint64 {
+	if m != nil {
+		return m.NumTrades
+	}
+	return 0
+}
+
+// ConditionalOrder reduces a trader's position once the mark price TWAP of the
+// market crosses a trigger price, e.g. a stop-loss or take-profit order.
+// Anyone can execute the order once it is triggered and receives the
+// execution fee escrowed by the trader when the order was placed. The orders of
+// a position are cancelled when the position is closed or liquidated.
+type ConditionalOrder struct {
+	Id               uint64                                            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Trader           string                                            `protobuf:"bytes,2,opt,name=trader,proto3" json:"trader,omitempty"`
+	Pair             github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,3,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	TriggerCondition TriggerCondition                                  `protobuf:"varint,4,opt,name=trigger_condition,json=triggerCondition,proto3,enum=nibiru.perp.v2.TriggerCondition" json:"trigger_condition,omitempty"`
+	TriggerPrice     github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,5,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price"`
+	// unsigned base asset amount to close. Zero closes the whole position.
+	Size_ github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=size,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"size"`
+	// collateral escrowed when the order was placed, paid to its executor
+	ExecutionFee types.Coin `protobuf:"bytes,7,opt,name=execution_fee,json=executionFee,proto3" json:"execution_fee"`
+}
+
+func (m *ConditionalOrder) Reset()         { *m = ConditionalOrder{} }
+func (m *ConditionalOrder) String() string { return proto.CompactTextString(m) }
+func (*ConditionalOrder) ProtoMessage()    {}
+func (*ConditionalOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f4829f34f7b8040, []int{9}
+}
+func (m *ConditionalOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConditionalOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConditionalOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConditionalOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConditionalOrder.Merge(m, src)
+}
+func (m *ConditionalOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConditionalOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConditionalOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConditionalOrder proto.InternalMessageInfo
+
+func (m *ConditionalOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ConditionalOrder) GetTrader() string {
+	if m != nil {
+		return m.Trader
+	}
+	return ""
+}
+
+func (m *ConditionalOrder) GetTriggerCondition() TriggerCondition {
+	if m != nil {
+		return m.TriggerCondition
+	}
+	return TriggerCondition_TRIGGER_CONDITION_UNSPECIFIED
+}
+
+func (m *ConditionalOrder) GetExecutionFee() types.Coin {
+	if m != nil {
+		return m.ExecutionFee
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterEnum("nibiru.perp.v2.Direction", Direction_name, Direction_value)
 	proto.RegisterEnum("nibiru.perp.v2.TwapCalcOption", TwapCalcOption_name, TwapCalcOption_value)
+	proto.RegisterEnum("nibiru.perp.v2.TriggerCondition", TriggerCondition_name, TriggerCondition_value)
 	proto.RegisterType((*Market)(nil), "nibiru.perp.v2.Market")
+	proto.RegisterType((*TradingSchedule)(nil), "nibiru.perp.v2.TradingSchedule")
+	proto.RegisterType((*MaintenanceWindow)(nil), "nibiru.perp.v2.MaintenanceWindow")
 	proto.RegisterType((*MarketLastVersion)(nil), "nibiru.perp.v2.MarketLastVersion")
 	proto.RegisterType((*AMM)(nil), "nibiru.perp.v2.AMM")
 	proto.RegisterType((*Position)(nil), "nibiru.perp.v2.Position")
 	proto.RegisterType((*ReserveSnapshot)(nil), "nibiru.perp.v2.ReserveSnapshot")
 	proto.RegisterType((*DNRAllocation)(nil), "nibiru.perp.v2.DNRAllocation")
+	proto.RegisterType((*TraderStats)(nil), "nibiru.perp.v2.TraderStats")
+	proto.RegisterType((*ConditionalOrder)(nil), "nibiru.perp.v2.ConditionalOrder")
 }
 
 func init() { proto.RegisterFile("nibiru/perp/v2/state.proto", fileDescriptor_8f4829f34f7b8040) }
 
 var fileDescriptor_8f4829f34f7b8040 = []byte{
-	// 1197 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0xc7, 0x4d, 0x4b, 0x76, 0xac, 0x95, 0x5f, 0xf4, 0x6c, 0xec, 0x3c, 0x74, 0xf0, 0x40, 0xf6,
-	0x23, 0xa0, 0x85, 0x91, 0x22, 0x64, 0xed, 0x9e, 0x82, 0x9e, 0xf4, 0x62, 0xa7, 0x06, 0xf4, 0x16,
-	0x4a, 0x6e, 0xd0, 0x20, 0xc5, 0x62, 0x49, 0xae, 0xa9, 0xad, 0x49, 0x2e, 0xb3, 0xbb, 0x94, 0x9d,
-	0xf6, 0x1b, 0xf4, 0xd4, 0x63, 0xfb, 0x15, 0xfa, 0x25, 0x7a, 0xcd, 0x31, 0xc7, 0xa2, 0x87, 0xa4,
-	0x48, 0x8e, 0xfd, 0x12, 0xc5, 0x2e, 0x29, 0x59, 0x06, 0x8a, 0x36, 0x65, 0x9b, 0x93, 0xb9, 0x1c,
-	0xce, 0x6f, 0x86, 0xe3, 0x99, 0xff, 0x50, 0xe0, 0x6e, 0x4c, 0x5d, 0xca, 0x53, 0x3b, 0x21, 0x3c,
-	0xb1, 0xa7, 0x47, 0xb6, 0x90, 0x58, 0x12, 0x2b, 0xe1, 0x4c, 0x32, 0xb8, 0x99, 0xd9, 0x2c, 0x65,
-	0xb3, 0xa6, 0x47, 0x77, 0xb7, 0x03, 0x16, 0x30, 0x6d, 0xb2, 0xd5, 0x55, 0xf6, 0xd4, 0xdd, 0xba,
-	0xc7, 0x44, 0xc4, 0x84, 0xed, 0x62, 0x41, 0xec, 0xe9, 0xa1, 0x4b, 0x24, 0x3e, 0xb4, 0x3d, 0x46,
-	0xe3, 0xdc, 0xbe, 0x9b, 0xd9, 0x51, 0xe6, 0x98, 0x1d, 0x66, 0xae, 0x01, 0x63, 0x41, 0x48, 0x6c,
-	0x7d, 0x72, 0xd3, 0x73, 0xdb, 0x4f, 0x39, 0x96, 0x94, 0xe5, 0xae, 0x8d, 0xdf, 0x2a, 0x60, 0xb5,
-	0x87, 0xf9, 0x05, 0x91, 0xb0, 0x07, 0xca, 0x09, 0xa6, 0xdc, 0x34, 0xf6, 0x8d, 0x83, 0x4a, 0xeb,
-	0xc1, 0x8b, 0x57, 0x7b, 0x4b, 0xbf, 0xbc, 0xda, 0x3b, 0x0c, 0xa8, 0x9c, 0xa4, 0xae, 0xe5, 0xb1,
-	0xc8, 0xee, 0xeb, 0x64, 0xdb, 0x13, 0x4c, 0x63, 0x3b, 0x7f, 0xa9, 0x2b, 0xdb, 0x63, 0x51, 0xc4,
-	0x62, 0x1b, 0x0b, 0x41, 0xa4, 0x35, 0xc4, 0x94, 0x3b, 0x1a, 0x03, 0x4d, 0x70, 0x8b, 0xc4, 0xd8,
-	0x0d, 0x89, 0x6f, 0x2e, 0xef, 0x1b, 0x07, 0x6b, 0xce, 0xec, 0xa8, 0x2c, 0x53, 0xc2, 0x05, 0x65,
-	0xb1, 0xb9, 0xb9, 0x6f, 0x1c, 0x94, 0x9d, 0xd9, 0x11, 0x4e, 0x80, 0x19, 0x61, 0x1a, 0x4b, 0x12,
-	0xe3, 0xd8, 0x23, 0x28, 0xc2, 0x3c, 0xa0, 0x31, 0xd2, 0x09, 0x9b, 0x25, 0x9d, 0x96, 0x95, 0xa7,
-	0xf5, 0xe1, 0x42, 0x5a, 0x79, 0x75, 0xb2, 0x3f, 0xf7, 0x85, 0x7f, 0x61, 0xcb, 0xe7, 0x09, 0x11,
-	0x56, 0x87, 0x78, 0xce, 0x9d, 0x05, 0x5e, 0x4f, 0xe3, 0x1c, 0x45, 0x83, 0x8f, 0xc0, 0x7a, 0x84,
-	0xaf, 0x50, 0x48, 0xa6, 0x84, 0xe3, 0x80, 0x98, 0xe5, 0x42, 0xf4, 0x6a, 0x84, 0xaf, 0xba, 0x39,
-	0x02, 0x7e, 0x03, 0x1a, 0x21, 0x96, 0x44, 0x48, 0xe4, 0xa5, 0x51, 0x1a, 0x62, 0x49, 0xa7, 0x04,
-	0x25, 0x9c, 0x44, 0x34, 0x8d, 0xd0, 0x39, 0xc7, 0x9e, 0x2a, 0xbb, 0xb9, 0x52, 0x28, 0xd0, 0x5e,
-	0x46, 0x6e, 0xcf, 0xc1, 0xc3, 0x8c, 0x7b, 0x92, 0x63, 0xe1, 0x53, 0x00, 0xc9, 0x95, 0x37, 0xc1,
-	0x71, 0x40, 0xd0, 0x39, 0x21, 0x79, 0xcd, 0x56, 0x0b, 0x05, 0xab, 0xcd, 0x48, 0x27, 0x84, 0x64,
-	0xd5, 0x0a, 0x80, 0x49, 0x3c, 0x26, 0x9e, 0x0b, 0x49, 0x22, 0x74, 0x9e, 0xc6, 0xfe, 0x42, 0x8c,
-	0x5b, 0x85, 0x62, 0xec, 0xcc, 0x79, 0x27, 0x69, 0xec, 0xcf, 0x03, 0xb9, 0x60, 0x27, 0xa4, 0xcf,
-	0x52, 0xea, 0xeb, 0x1e, 0x5d, 0x88, 0xb2, 0x56, 0x28, 0xca, 0xed, 0x05, 0xd8, 0x3c, 0xc6, 0x57,
-	0x60, 0x37, 0xc1, 0x5c, 0x52, 0x1c, 0xa2, 0xc5, 0x58, 0x59, 0x9c, 0x4a, 0xa1, 0x38, 0xff, 0xcd,
-	0x81, 0xdd, 0x6b, 0x5e, 0x16, 0xeb, 0x10, 0xec, 0xa8, 0x72, 0xd1, 0x38, 0x50, 0x7c, 0x82, 0x48,
-	0xc2, 0xbc, 0x09, 0xa2, 0xbe, 0x09, 0x54, 0x1c, 0x07, 0xe6, 0x46, 0x07, 0x4b, 0x72, 0xac, 0x4c,
-	0xa7, 0x3e, 0x3c, 0x03, 0xdb, 0xf2, 0x12, 0x27, 0x28, 0x64, 0xec, 0xc2, 0xc5, 0xde, 0x05, 0xba,
-	0xa4, 0xb1, 0xcf, 0x2e, 0xcd, 0xea, 0xbe, 0x71, 0x50, 0x3d, 0xda, 0xb5, 0xb2, 0x81, 0xb6, 0x66,
-	0x03, 0x6d, 0x75, 0xf2, 0x81, 0x6e, 0xad, 0xa9, 0xa4, 0xbf, 0x7f, 0xbd, 0x67, 0x38, 0x50, 0x01,
-	0xba, 0xb9, 0xff, 0x63, 0xed, 0x0e, 0x4f, 0x41, 0x2d, 0xe1, 0x24, 0xc1, 0xd4, 0x47, 0x2e, 0xf6,
-	0x91, 0x4f, 0x5c, 0x69, 0xae, 0xe7, 0xc8, 0x5c, 0x31, 0x94, 0xbc, 0x58, 0xb9, 0xbc, 0x58, 0x6d,
-	0x46, 0xe3, 0x56, 0x59, 0x21, 0x9d, 0xcd, 0xdc, 0xb1, 0x85, 0xfd, 0x0e, 0x71, 0x25, 0x7c, 0x0a,
-	0x6a, 0x6a, 0x76, 0x16, 0x5f, 0xcc, 0xdc, 0xd0, 0x75, 0x3b, 0xfa, 0x7b, 0x75, 0xd3, 0xc9, 0x6e,
-	0x46, 0xf8, 0xea, 0xe4, 0xba, 0x0c, 0xf0, 0x09, 0xa8, 0x32, 0x8e, 0xbd, 0x90, 0x20, 0xad, 0x46,
-	0x5b, 0xff, 0x54, 0x8d, 0x40, 0x46, 0x53, 0xd7, 0x8d, 0xfb, 0xe0, 0x3f, 0x99, 0xd8, 0x75, 0xb1,
-	0x90, 0x9f, 0xe7, 0xa2, 0xb3, 0x20, 0x47, 0xc6, 0x0d, 0x39, 0x6a, 0xfc, 0xb4, 0x02, 0x4a, 0xcd,
-	0x5e, 0xef, 0x3d, 0x28, 0xe3, 0x2c, 0xe0, 0xda, 0x4d, 0xfd, 0x7b, 0x04, 0xd6, 0xd5, 0x3f, 0x01,
-	0x71, 0x22, 0x08, 0x9f, 0x12, 0x2d, 0x9c, 0x05, 0x54, 0x49, 0x31, 0x9c, 0x0c, 0x01, 0x47, 0x60,
-	0xe3, 0x59, 0xca, 0xe4, 0x35, 0xb3, 0x98, 0x8e, 0xae, 0x6b, 0xc8, 0x0c, 0xda, 0x03, 0x40, 0x3c,
-	0xe3, 0x12, 0xf9, 0x24, 0x91, 0x93, 0x82, 0xda, 0x59, 0x51, 0x84, 0x8e, 0x02, 0xc0, 0x2f, 0x54,
-	0x6f, 0x52, 0x25, 0xf8, 0x69, 0x28, 0x69, 0x12, 0x52, 0xc2, 0x0b, 0xea, 0xe4, 0x96, 0xe6, 0xf4,
-	0xe6, 0x18, 0x95, 0xa9, 0x64, 0x52, 0x8d, 0x3a, 0x8b, 0x83, 0x82, 0x7a, 0x58, 0xd1, 0x84, 0x2e,
-	0x8b, 0x03, 0x38, 0x00, 0xd5, 0x0c, 0x27, 0x26, 0x8c, 0xcb, 0x82, 0xda, 0x97, 0x65, 0x34, 0x52,
-	0x04, 0xf8, 0x25, 0xa8, 0x09, 0x22, 0x65, 0x48, 0x22, 0x12, 0x4b, 0xa4, 0xb3, 0xcf, 0x35, 0xa8,
-	0xc8, 0x2c, 0x6d, 0x5d, 0xb3, 0x86, 0x0a, 0xd5, 0xf8, 0xa1, 0x0c, 0xd6, 0x86, 0x4c, 0x50, 0xbd,
-	0x23, 0x3e, 0x00, 0x9b, 0x92, 0x63, 0x9f, 0x70, 0x84, 0x7d, 0x9f, 0x13, 0x21, 0xb2, 0x86, 0x76,
-	0x36, 0xb2, 0xbb, 0xcd, 0xec, 0xe6, 0xbc, 0xdb, 0x97, 0xff, 0x9d, 0x6e, 0x6f, 0x81, 0xb2, 0xa0,
-	0x5f, 0x17, 0xed, 0x3b, 0xed, 0x0b, 0x4f, 0xc0, 0x6a, 0xf6, 0x2d, 0x50, 0xb0, 0xd7, 0x72, 0x6f,
-	0x35, 0x0c, 0x2c, 0x21, 0x31, 0x8a, 0x99, 0x2a, 0x08, 0x0e, 0x0b, 0x76, 0xd9, 0xba, 0x82, 0xf4,
-	0x73, 0xc6, 0x3b, 0xee, 0xfd, 0xd5, 0xf7, 0xb3, 0xf7, 0x1f, 0x80, 0xdd, 0x10, 0x0b, 0x89, 0xd2,
-	0xc4, 0xc7, 0x92, 0xf8, 0xc8, 0x0d, 0x99, 0x77, 0x81, 0xe2, 0x34, 0x72, 0x09, 0xd7, 0xed, 0x59,
-	0x72, 0xee, 0xa8, 0x07, 0xce, 0x32, 0x7b, 0x4b, 0x99, 0xfb, 0xda, 0xda, 0xc0, 0x60, 0x2b, 0x9f,
-	0xe7, 0x51, 0x8c, 0x13, 0x31, 0x61, 0x12, 0x7e, 0x04, 0x4a, 0x38, 0x8a, 0x74, 0x5b, 0x54, 0x8f,
-	0x6e, 0x5b, 0x37, 0x3f, 0x4e, 0xad, 0x66, 0xaf, 0x97, 0x6f, 0x04, 0xf5, 0x14, 0xfc, 0x3f, 0x58,
-	0x97, 0x34, 0x22, 0x42, 0xe2, 0x28, 0x41, 0x91, 0xd0, 0xfd, 0x52, 0x72, 0xaa, 0xf3, 0x7b, 0x3d,
-	0xd1, 0xf8, 0xd6, 0x00, 0x1b, 0x9d, 0xbe, 0xd3, 0x0c, 0x43, 0xe6, 0xe9, 0x25, 0x05, 0xb7, 0xc1,
-	0x8a, 0xde, 0x81, 0xb9, 0xd4, 0x66, 0x07, 0xe8, 0x81, 0x55, 0x1c, 0xb1, 0x34, 0x96, 0xe6, 0xf2,
-	0x7e, 0xe9, 0xcf, 0x57, 0xd2, 0xc7, 0x2a, 0x81, 0x1f, 0x5f, 0xef, 0x1d, 0xbc, 0x43, 0x05, 0x95,
-	0x83, 0x70, 0x72, 0xf4, 0xbd, 0x4f, 0x41, 0xa5, 0x43, 0x39, 0xc9, 0xea, 0xb6, 0x0b, 0x76, 0x3a,
-	0xa7, 0xce, 0x71, 0x7b, 0x7c, 0x3a, 0xe8, 0xa3, 0xb3, 0xfe, 0x68, 0x78, 0xdc, 0x3e, 0x3d, 0x39,
-	0x3d, 0xee, 0xd4, 0x96, 0xe0, 0x1a, 0x28, 0x77, 0x07, 0xfd, 0x87, 0x35, 0x03, 0x56, 0xc0, 0xca,
-	0xe8, 0xb3, 0x81, 0x33, 0xae, 0x2d, 0xdf, 0x0b, 0xc0, 0xe6, 0xf8, 0x12, 0x27, 0x6d, 0x1c, 0x7a,
-	0x83, 0x44, 0x13, 0xf6, 0xc1, 0xff, 0xc6, 0x8f, 0x9b, 0x43, 0xd4, 0x6e, 0x76, 0xdb, 0x68, 0x30,
-	0xfc, 0x63, 0xd0, 0x68, 0x38, 0x18, 0xd7, 0x0c, 0xb8, 0x0d, 0x6a, 0x8f, 0xce, 0x06, 0xe3, 0x63,
-	0xd4, 0x1c, 0x8d, 0x8e, 0xc7, 0x68, 0xf4, 0xb8, 0x39, 0xac, 0x2d, 0xc3, 0xdb, 0x60, 0xab, 0xd5,
-	0x1c, 0xdd, 0xb8, 0x59, 0x6a, 0x3d, 0x7c, 0xf1, 0xa6, 0x6e, 0xbc, 0x7c, 0x53, 0x37, 0x7e, 0x7d,
-	0x53, 0x37, 0xbe, 0x7b, 0x5b, 0x5f, 0x7a, 0xf9, 0xb6, 0xbe, 0xf4, 0xf3, 0xdb, 0xfa, 0xd2, 0x93,
-	0xfb, 0x7f, 0x35, 0x81, 0xb3, 0x1f, 0x18, 0xfa, 0xe5, 0xdd, 0x55, 0xfd, 0x85, 0xf0, 0xc9, 0xef,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x43, 0xfe, 0x66, 0xfb, 0x7f, 0x0c, 0x00, 0x00,
+	// 1611 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcd, 0x72, 0xdb, 0xc8,
+	0x11, 0xc7, 0x45, 0x52, 0xa2, 0xc5, 0xa1, 0x44, 0x41, 0x63, 0xc9, 0x81, 0x5c, 0xb1, 0x24, 0xb3,
+	0x2a, 0x89, 0xca, 0x29, 0x93, 0xb1, 0x72, 0xda, 0xca, 0x89, 0x5f, 0x72, 0x58, 0x45, 0x8a, 0x34,
+	0x48, 0xaf, 0x92, 0xad, 0x4d, 0x4d, 0x86, 0x40, 0x8b, 0x9c, 0x08, 0xc0, 0x40, 0x98, 0x81, 0x24,
+	0x6f, 0xde, 0x20, 0xb9, 0xa4, 0x52, 0x39, 0x24, 0xaf, 0x90, 0x17, 0xc8, 0x31, 0xd7, 0x3d, 0xee,
+	0x31, 0x95, 0xc3, 0x6e, 0xca, 0x7e, 0x91, 0xd4, 0xcc, 0x80, 0x14, 0xa5, 0xdd, 0x4a, 0xbc, 0xd8,
+	0xf5, 0xc9, 0x9a, 0xe9, 0x99, 0x5f, 0x37, 0x1a, 0xdd, 0xff, 0x06, 0x8d, 0x1e, 0x87, 0x6c, 0xc2,
+	0xe2, 0xa4, 0x1e, 0x41, 0x1c, 0xd5, 0xaf, 0x8e, 0xeb, 0x42, 0x52, 0x09, 0xb5, 0x28, 0xe6, 0x92,
+	0xe3, 0x8a, 0xb1, 0xd5, 0x94, 0xad, 0x76, 0x75, 0xfc, 0x78, 0x67, 0xca, 0xa7, 0x5c, 0x9b, 0xea,
+	0xea, 0x2f, 0x73, 0xea, 0xf1, 0xbe, 0xcb, 0x45, 0xc0, 0x45, 0x7d, 0x42, 0x05, 0xd4, 0xaf, 0x5e,
+	0x4c, 0x40, 0xd2, 0x17, 0x75, 0x97, 0xb3, 0x30, 0xb5, 0xef, 0x19, 0x3b, 0x31, 0x17, 0xcd, 0x62,
+	0x7e, 0x75, 0xca, 0xf9, 0xd4, 0x87, 0xba, 0x5e, 0x4d, 0x92, 0xf3, 0xba, 0x97, 0xc4, 0x54, 0x32,
+	0x9e, 0x5e, 0xad, 0xfe, 0x79, 0x03, 0x15, 0xfb, 0x34, 0xbe, 0x00, 0x89, 0xfb, 0x68, 0x35, 0xa2,
+	0x2c, 0xb6, 0x73, 0x87, 0xb9, 0xa3, 0x52, 0xf3, 0xa3, 0xcf, 0xbf, 0x3c, 0x58, 0xf9, 0xf7, 0x97,
+	0x07, 0x2f, 0xa6, 0x4c, 0xce, 0x92, 0x49, 0xcd, 0xe5, 0x41, 0xfd, 0x54, 0x07, 0xdb, 0x9a, 0x51,
+	0x16, 0xd6, 0xd3, 0x87, 0xba, 0xa9, 0xbb, 0x3c, 0x08, 0x78, 0x58, 0xa7, 0x42, 0x80, 0xac, 0x0d,
+	0x29, 0x8b, 0x1d, 0x8d, 0xc1, 0x36, 0x7a, 0x00, 0x21, 0x9d, 0xf8, 0xe0, 0xd9, 0xf9, 0xc3, 0xdc,
+	0xd1, 0xba, 0x33, 0x5f, 0x2a, 0xcb, 0x15, 0xc4, 0x82, 0xf1, 0xd0, 0xae, 0x1c, 0xe6, 0x8e, 0x56,
+	0x9d, 0xf9, 0x12, 0xcf, 0x90, 0x1d, 0x50, 0x16, 0x4a, 0x08, 0x69, 0xe8, 0x02, 0x09, 0x68, 0x3c,
+	0x65, 0x21, 0xd1, 0x01, 0xdb, 0x05, 0x1d, 0x56, 0x2d, 0x0d, 0xeb, 0xc7, 0x4b, 0x61, 0xa5, 0xd9,
+	0x31, 0xff, 0x3c, 0x17, 0xde, 0x45, 0x5d, 0xbe, 0x89, 0x40, 0xd4, 0xda, 0xe0, 0x3a, 0x8f, 0x96,
+	0x78, 0x7d, 0x8d, 0x73, 0x14, 0x0d, 0xbf, 0x42, 0x1b, 0x01, 0xbd, 0x21, 0x3e, 0x5c, 0x41, 0x4c,
+	0xa7, 0x60, 0xaf, 0x66, 0xa2, 0x97, 0x03, 0x7a, 0xd3, 0x4b, 0x11, 0xf8, 0xf7, 0xa8, 0xea, 0x53,
+	0x09, 0x42, 0x12, 0x37, 0x09, 0x12, 0x9f, 0x4a, 0x76, 0x05, 0x24, 0x8a, 0x21, 0x60, 0x49, 0x40,
+	0xce, 0x63, 0xea, 0xaa, 0xb4, 0xdb, 0x6b, 0x99, 0x1c, 0x1d, 0x18, 0x72, 0x6b, 0x01, 0x1e, 0x1a,
+	0xee, 0x49, 0x8a, 0xc5, 0x9f, 0x22, 0x0c, 0x37, 0xee, 0x8c, 0x86, 0x53, 0x20, 0xe7, 0x00, 0x69,
+	0xce, 0x8a, 0x99, 0x9c, 0x59, 0x73, 0xd2, 0x09, 0x80, 0xc9, 0xd6, 0x14, 0xd9, 0xe0, 0x72, 0xf1,
+	0x46, 0x48, 0x08, 0xc8, 0x79, 0x12, 0x7a, 0x4b, 0x3e, 0x1e, 0x64, 0xf2, 0xb1, 0xbb, 0xe0, 0x9d,
+	0x24, 0xa1, 0xb7, 0x70, 0x34, 0x41, 0xbb, 0x3e, 0xbb, 0x4c, 0x98, 0xa7, 0x56, 0xe1, 0x92, 0x97,
+	0xf5, 0x4c, 0x5e, 0x1e, 0x2e, 0xc1, 0x16, 0x3e, 0x7e, 0x87, 0xf6, 0x22, 0x1a, 0x4b, 0x46, 0x7d,
+	0xb2, 0xec, 0xcb, 0xf8, 0x29, 0x65, 0xf2, 0xf3, 0x83, 0x14, 0xd8, 0xbb, 0xe5, 0x19, 0x5f, 0x2f,
+	0xd0, 0xae, 0x4a, 0x17, 0x0b, 0xa7, 0x8a, 0x0f, 0x04, 0x22, 0xee, 0xce, 0x08, 0xf3, 0x6c, 0xa4,
+	0xfc, 0x38, 0x38, 0x35, 0x3a, 0x54, 0x42, 0x47, 0x99, 0xba, 0x1e, 0x7e, 0x8d, 0x76, 0xe4, 0x35,
+	0x8d, 0x88, 0xcf, 0xf9, 0xc5, 0x84, 0xba, 0x17, 0xe4, 0x9a, 0x85, 0x1e, 0xbf, 0xb6, 0xcb, 0x87,
+	0xb9, 0xa3, 0xf2, 0xf1, 0x5e, 0xcd, 0x34, 0x74, 0x6d, 0xde, 0xd0, 0xb5, 0x76, 0xda, 0xd0, 0xcd,
+	0x75, 0x15, 0xf4, 0x5f, 0xbf, 0x3a, 0xc8, 0x39, 0x58, 0x01, 0x7a, 0xe9, 0xfd, 0x33, 0x7d, 0x1d,
+	0x77, 0x91, 0x15, 0xc5, 0x10, 0x51, 0xe6, 0x91, 0x09, 0xf5, 0x88, 0x07, 0x13, 0x69, 0x6f, 0xa4,
+	0xc8, 0x54, 0x31, 0x94, 0xbc, 0xd4, 0x52, 0x79, 0xa9, 0xb5, 0x38, 0x0b, 0x9b, 0xab, 0x0a, 0xe9,
+	0x54, 0xd2, 0x8b, 0x4d, 0xea, 0xb5, 0x61, 0x22, 0xf1, 0xa7, 0xc8, 0x52, 0xbd, 0xb3, 0xfc, 0x60,
+	0xf6, 0xa6, 0xce, 0xdb, 0xf1, 0xb7, 0xcb, 0x9b, 0x0e, 0xb6, 0x12, 0xd0, 0x9b, 0x93, 0xdb, 0x34,
+	0xe0, 0x4f, 0x50, 0x99, 0xc7, 0xd4, 0xf5, 0x81, 0x68, 0x35, 0xda, 0xfa, 0xae, 0x6a, 0x84, 0x0c,
+	0x4d, 0xfd, 0x8d, 0x87, 0xc8, 0x92, 0x31, 0xd5, 0x51, 0x0b, 0x77, 0x06, 0x5e, 0xe2, 0x83, 0x6d,
+	0xe9, 0x24, 0x1c, 0xd4, 0xee, 0x2a, 0x71, 0x6d, 0x6c, 0xce, 0x8d, 0xd2, 0x63, 0x69, 0x2a, 0xb6,
+	0xe4, 0xdd, 0x6d, 0x7c, 0x89, 0x9e, 0x44, 0x31, 0x73, 0x81, 0x9c, 0xfb, 0x89, 0x2b, 0x13, 0x53,
+	0x4a, 0x3e, 0x0b, 0x98, 0x4c, 0x0b, 0x6a, 0x3b, 0x53, 0x41, 0x3d, 0xd6, 0xd0, 0x93, 0x5b, 0x66,
+	0x4f, 0x21, 0x4d, 0x4d, 0xfd, 0x16, 0xed, 0xb0, 0x90, 0xe9, 0xfa, 0xbd, 0x23, 0x90, 0x38, 0x93,
+	0x27, 0x9c, 0xb2, 0x96, 0xc4, 0xb1, 0xfa, 0x97, 0x1c, 0xda, 0xba, 0xf7, 0xfc, 0xf8, 0x27, 0x68,
+	0xcb, 0xf5, 0xb9, 0x00, 0x8f, 0x5c, 0x03, 0x5c, 0x78, 0xf4, 0x8d, 0xb0, 0x73, 0x87, 0x85, 0xa3,
+	0x4d, 0xa7, 0x62, 0xb6, 0xcf, 0xd2, 0x5d, 0xfc, 0x2b, 0xf4, 0x70, 0x59, 0xc3, 0x4d, 0xf5, 0x0a,
+	0x3b, 0x7f, 0x58, 0x38, 0x2a, 0x1f, 0x3f, 0xbd, 0x9f, 0xe6, 0xfe, 0xed, 0x51, 0x53, 0xa8, 0x69,
+	0xa2, 0x71, 0x70, 0xdf, 0x20, 0xaa, 0x1d, 0xb4, 0xfd, 0xb5, 0xe3, 0x78, 0x0f, 0xad, 0x0b, 0x49,
+	0x63, 0x49, 0x02, 0xa1, 0x27, 0x57, 0xc1, 0x79, 0xa0, 0xd7, 0x7d, 0x81, 0x77, 0x51, 0x11, 0x42,
+	0x4f, 0x19, 0xf2, 0xda, 0xb0, 0x06, 0xa1, 0xd7, 0x17, 0xd5, 0xe7, 0x68, 0xdb, 0x4c, 0xbc, 0x1e,
+	0x15, 0xf2, 0xe3, 0x74, 0xf2, 0x2c, 0xcd, 0xa4, 0xdc, 0x9d, 0x99, 0x54, 0xfd, 0xe7, 0x1a, 0x2a,
+	0x34, 0xfa, 0xfd, 0x0f, 0x30, 0x1e, 0xe7, 0x0e, 0xd7, 0xef, 0x0e, 0xc1, 0x57, 0x68, 0x43, 0x75,
+	0x22, 0x89, 0x41, 0x40, 0x7c, 0x05, 0x76, 0x3e, 0xd3, 0x7b, 0x2d, 0x2b, 0x86, 0x63, 0x10, 0x78,
+	0x84, 0x36, 0x2f, 0x13, 0x2e, 0x6f, 0x99, 0xd9, 0x86, 0xe9, 0x86, 0x86, 0xcc, 0xa1, 0x7d, 0x84,
+	0xc4, 0x65, 0x2c, 0x89, 0x07, 0x91, 0x9c, 0x65, 0x1c, 0xa0, 0x25, 0x45, 0x68, 0x2b, 0x00, 0xfe,
+	0x35, 0xb2, 0x4c, 0x27, 0x05, 0x89, 0x2f, 0x59, 0xe4, 0x33, 0x88, 0x33, 0x0e, 0xcb, 0x2d, 0xcd,
+	0xe9, 0x2f, 0x30, 0x2a, 0x52, 0xc9, 0xa5, 0xd2, 0x7b, 0x1e, 0x4e, 0x33, 0x0e, 0xc5, 0x92, 0x26,
+	0xf4, 0x78, 0x38, 0xc5, 0x03, 0x54, 0x36, 0x38, 0x31, 0xe3, 0xb1, 0xcc, 0x38, 0x00, 0x4d, 0x44,
+	0x23, 0x45, 0xc0, 0xbf, 0x41, 0x96, 0x00, 0x29, 0x7d, 0x08, 0x20, 0x94, 0x44, 0x47, 0x6f, 0x97,
+	0x32, 0x0b, 0xea, 0xd6, 0x2d, 0x6b, 0xa8, 0x50, 0xd5, 0xbf, 0xad, 0xa2, 0xf5, 0x21, 0x17, 0x4c,
+	0x7f, 0x28, 0xfc, 0x08, 0x55, 0x94, 0x86, 0x41, 0x4c, 0xa8, 0xe7, 0xc5, 0x20, 0x4c, 0xd7, 0x94,
+	0x9c, 0x4d, 0xb3, 0xdb, 0x30, 0x9b, 0x8b, 0x6a, 0xcf, 0x7f, 0x3f, 0xd5, 0xde, 0x44, 0xab, 0x82,
+	0x7d, 0x96, 0xb5, 0xee, 0xf4, 0x5d, 0x7c, 0x82, 0x8a, 0x46, 0xef, 0x32, 0xd6, 0x5a, 0x7a, 0x5b,
+	0x35, 0x03, 0x8f, 0x20, 0x24, 0x21, 0x57, 0x09, 0xa1, 0x7e, 0xc6, 0x2a, 0xdb, 0x50, 0x90, 0xd3,
+	0x94, 0xf1, 0x9e, 0x1f, 0x7f, 0xc5, 0x0f, 0xf3, 0xf1, 0xf7, 0x11, 0xda, 0xf3, 0xa9, 0x90, 0x24,
+	0x89, 0x3c, 0x2a, 0xc1, 0x23, 0x13, 0x9f, 0xbb, 0x17, 0x24, 0x4c, 0x82, 0x09, 0xc4, 0xba, 0x3c,
+	0x0b, 0xce, 0x23, 0x75, 0xe0, 0xb5, 0xb1, 0x37, 0x95, 0xf9, 0x54, 0x5b, 0xab, 0x14, 0x6d, 0xa5,
+	0xfd, 0x3c, 0x0a, 0x69, 0x24, 0x66, 0x5c, 0xe2, 0x9f, 0xa2, 0x02, 0x0d, 0x02, 0x5d, 0x16, 0xe5,
+	0xe3, 0x87, 0xf7, 0x05, 0xbb, 0xd1, 0xef, 0xa7, 0x12, 0xad, 0x4e, 0xe1, 0xa7, 0x68, 0x43, 0xb2,
+	0x00, 0x84, 0xa4, 0x41, 0x74, 0xab, 0xb4, 0xe5, 0xc5, 0x5e, 0x5f, 0x54, 0xff, 0x90, 0x43, 0x9b,
+	0xed, 0x53, 0xa7, 0xe1, 0xfb, 0xdc, 0xd5, 0xb3, 0x0c, 0xef, 0xa0, 0x35, 0xfd, 0x21, 0x94, 0x4a,
+	0xad, 0x59, 0x60, 0x17, 0x15, 0x69, 0xc0, 0x93, 0x50, 0xa6, 0xb3, 0xe2, 0x7f, 0x7c, 0x97, 0xfc,
+	0x4c, 0x05, 0xf0, 0xf7, 0xaf, 0x0e, 0x8e, 0xde, 0x23, 0x83, 0xea, 0x82, 0x70, 0x52, 0x74, 0xf5,
+	0x8f, 0x79, 0x54, 0x1e, 0xeb, 0x4a, 0x1f, 0x49, 0x2a, 0x85, 0x2a, 0xaa, 0x2b, 0xee, 0x27, 0x01,
+	0xd8, 0xb9, 0x6f, 0xfd, 0x6e, 0xba, 0xa1, 0x74, 0xd2, 0xdb, 0x4a, 0xb4, 0x63, 0xa0, 0x3e, 0xfb,
+	0x0c, 0x3c, 0x12, 0x85, 0x7e, 0x56, 0xd1, 0x9e, 0x33, 0x86, 0xa1, 0xaf, 0x7a, 0xe6, 0x1c, 0x40,
+	0xd8, 0x85, 0x4c, 0x81, 0xe9, 0xbb, 0xf8, 0x09, 0x42, 0x61, 0x12, 0x10, 0xdd, 0xdb, 0x42, 0xf7,
+	0xcd, 0xaa, 0x53, 0x0a, 0x93, 0x40, 0xa7, 0x40, 0x54, 0xff, 0x51, 0x40, 0x56, 0x8b, 0x87, 0x1e,
+	0x33, 0x55, 0x3c, 0x88, 0x3d, 0x88, 0x71, 0x05, 0xe5, 0x99, 0x97, 0xbe, 0x9a, 0x3c, 0xf3, 0xf0,
+	0x23, 0x54, 0x34, 0xda, 0x60, 0x1e, 0xca, 0x49, 0x57, 0x0b, 0x89, 0x28, 0x7c, 0x3f, 0x12, 0xd1,
+	0x47, 0xdb, 0x32, 0x66, 0xd3, 0x29, 0xc4, 0xc4, 0x9d, 0x87, 0xa4, 0x23, 0xae, 0x1c, 0x1f, 0x7e,
+	0xfd, 0xe3, 0x4c, 0x1f, 0x5c, 0x84, 0xee, 0x58, 0xf2, 0xde, 0x8e, 0xea, 0xf2, 0x39, 0xce, 0x08,
+	0x6a, 0xc6, 0x2e, 0x4f, 0x21, 0x5a, 0x49, 0x17, 0x32, 0x56, 0xfc, 0x0e, 0x32, 0xd6, 0x46, 0x9b,
+	0x70, 0x03, 0x6e, 0x32, 0xff, 0x81, 0xa3, 0x1b, 0xf4, 0x3d, 0xbe, 0xc2, 0x37, 0x16, 0xb7, 0x4e,
+	0x00, 0x9e, 0xfd, 0x02, 0x95, 0xda, 0x2c, 0x06, 0xd3, 0xff, 0x7b, 0x68, 0xb7, 0xdd, 0x75, 0x3a,
+	0xad, 0x71, 0x77, 0x70, 0x4a, 0x5e, 0x9f, 0x8e, 0x86, 0x9d, 0x56, 0xf7, 0xa4, 0xdb, 0x69, 0x5b,
+	0x2b, 0x78, 0x1d, 0xad, 0xf6, 0x06, 0xa7, 0x2f, 0xad, 0x1c, 0x2e, 0xa1, 0xb5, 0xd1, 0x2f, 0x07,
+	0xce, 0xd8, 0xca, 0x3f, 0x9b, 0xa2, 0xca, 0xf8, 0x9a, 0x46, 0x2d, 0xea, 0xbb, 0x83, 0x48, 0x13,
+	0x0e, 0xd1, 0x0f, 0xc7, 0x67, 0x8d, 0x21, 0x69, 0x35, 0x7a, 0x2d, 0x32, 0x18, 0x7e, 0x33, 0x68,
+	0x34, 0x1c, 0x8c, 0xad, 0x1c, 0xde, 0x41, 0xd6, 0xab, 0xd7, 0x83, 0x71, 0x87, 0x34, 0x46, 0xa3,
+	0xce, 0x98, 0x8c, 0xce, 0x1a, 0x43, 0x2b, 0x8f, 0x1f, 0xa2, 0xad, 0x66, 0x63, 0x74, 0x67, 0xb3,
+	0xf0, 0xcc, 0x45, 0xd6, 0xfd, 0x57, 0x85, 0x9f, 0xa2, 0x27, 0x63, 0xa7, 0xfb, 0xf2, 0x65, 0xc7,
+	0x21, 0xad, 0xc1, 0x69, 0xbb, 0xfb, 0x0d, 0xbe, 0x76, 0xd1, 0xf6, 0xd0, 0xe9, 0xb6, 0x3a, 0xa4,
+	0x31, 0x26, 0x03, 0x87, 0x34, 0x9a, 0x83, 0x8f, 0x3b, 0x56, 0xee, 0xfe, 0x76, 0xb3, 0xd3, 0x1b,
+	0x9c, 0x59, 0xf9, 0xe6, 0xcb, 0xcf, 0xdf, 0xee, 0xe7, 0xbe, 0x78, 0xbb, 0x9f, 0xfb, 0xcf, 0xdb,
+	0xfd, 0xdc, 0x9f, 0xde, 0xed, 0xaf, 0x7c, 0xf1, 0x6e, 0x7f, 0xe5, 0x5f, 0xef, 0xf6, 0x57, 0x3e,
+	0x79, 0xfe, 0xff, 0x6a, 0x71, 0xfe, 0x5f, 0x32, 0xfa, 0x1d, 0x4d, 0x8a, 0xfa, 0x37, 0xd5, 0xcf,
+	0xff, 0x3b, 0x00, 0x3a, 0x9b, 0xbf, 0xc2, 0xb1, 0x11, 0x00, 0x00,
 }
 
 func (m *Market) Marshal() (dAtA []byte, err error) {
@@ -604,6 +934,42 @@ func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.InitialMarginRatio.Size()
+		i -= size
+		if _, err := m.InitialMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.PriceFluctuationLimitRatio.Size()
+		i -= size
+		if _, err := m.PriceFluctuationLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size, err := m.TradingSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
 	{
 		size := m.OraclePair.Size()
 		i -= size
@@ -639,12 +1005,12 @@ func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x62
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapLookbackWindow):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapLookbackWindow):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintState(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintState(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x5a
 	if len(m.FundingRateEpochId) > 0 {
@@ -747,6 +1113,94 @@ func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TradingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaintenanceWindows) > 0 {
+		for iNdEx := len(m.MaintenanceWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaintenanceWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClosedWeekdays) > 0 {
+		dAtA5 := make([]byte, len(m.ClosedWeekdays)*10)
+		var j4 int
+		for _, num := range m.ClosedWeekdays {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintState(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.EndMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.StartMs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MarketLastVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1048,16 +1502,154 @@ func (m *DNRAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TraderStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *TraderStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraderStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumTrades != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.NumTrades))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConditionalOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConditionalOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConditionalOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExecutionFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Size_.Size()
+		i -= size
+		if _, err := m.Size_.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TriggerCondition != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TriggerCondition))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *Market) Size() (n int) {
 	if m == nil {
@@ -1099,6 +1691,49 @@ func (m *Market) Size() (n int) {
 	}
 	l = m.OraclePair.Size()
 	n += 1 + l + sovState(uint64(l))
+	l = m.TradingSchedule.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.PriceFluctuationLimitRatio.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.InitialMarginRatio.Size()
+	n += 2 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *TradingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClosedWeekdays) > 0 {
+		l = 0
+		for _, e := range m.ClosedWeekdays {
+			l += sovState(uint64(e))
+		}
+		n += 1 + sovState(uint64(l)) + l
+	}
+	if len(m.MaintenanceWindows) > 0 {
+		for _, e := range m.MaintenanceWindows {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MaintenanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartMs != 0 {
+		n += 1 + sovState(uint64(m.StartMs))
+	}
+	if m.EndMs != 0 {
+		n += 1 + sovState(uint64(m.EndMs))
+	}
 	return n
 }
 
@@ -1200,6 +1835,51 @@ func (m *DNRAllocation) Size() (n int) {
 	return n
 }
 
+func (m *TraderStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Volume.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.NumTrades != 0 {
+		n += 1 + sovState(uint64(m.NumTrades))
+	}
+	return n
+}
+
+func (m *ConditionalOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.TriggerCondition != 0 {
+		n += 1 + sovState(uint64(m.TriggerCondition))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Size_.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.ExecutionFee.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
 func sovState(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1712,61 +2392,44 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingSchedule", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthState
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthState
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MarketLastVersion) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TradingSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarketLastVersion: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketLastVersion: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFluctuationLimitRatio", wireType)
 			}
-			m.Version = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1776,24 +2439,731 @@ func (m *MarketLastVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
+			if err := m.PriceFluctuationLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ClosedWeekdays = append(m.ClosedWeekdays, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthState
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthState
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ClosedWeekdays) == 0 {
+					m.ClosedWeekdays = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowState
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ClosedWeekdays = append(m.ClosedWeekdays, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedWeekdays", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceWindows = append(m.MaintenanceWindows, MaintenanceWindow{})
+			if err := m.MaintenanceWindows[len(m.MaintenanceWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartMs", wireType)
+			}
+			m.StartMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndMs", wireType)
+			}
+			m.EndMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketLastVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketLastVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketLastVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AMM) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AMM: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AMM: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqrtDepth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SqrtDepth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLong", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -1802,7 +3172,7 @@ func (m *MarketLastVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AMM) Unmarshal(dAtA []byte) error {
+func (m *Position) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1825,15 +3195,15 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AMM: wiretype end group for non-group")
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AMM: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1861,13 +3231,11 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1895,13 +3263,13 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1929,13 +3297,13 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SqrtDepth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1963,13 +3331,13 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SqrtDepth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenNotional", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1997,13 +3365,13 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OpenNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLong", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2031,15 +3399,84 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalLong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedBlockNumber", wireType)
+			}
+			m.LastUpdatedBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdatedBlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amm", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2049,31 +3486,30 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalShort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
 			}
-			m.Version = 0
+			m.TimestampMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2083,16 +3519,66 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				m.TimestampMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DNRAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DNRAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DNRAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2102,23 +3588,42 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2143,7 +3648,7 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Position) Unmarshal(dAtA []byte) error {
+func (m *TraderStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2166,15 +3671,15 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+			return fmt.Errorf("proto: TraderStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TraderStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2202,11 +3707,13 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2234,13 +3741,13 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2268,15 +3775,15 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTrades", wireType)
 			}
-			var stringLen uint64
+			m.NumTrades = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2286,29 +3793,83 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NumTrades |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthState
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConditionalOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConditionalOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConditionalOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2336,13 +3897,11 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OpenNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2370,15 +3929,15 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedBlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerCondition", wireType)
 			}
-			m.LastUpdatedBlockNumber = 0
+			m.TriggerCondition = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2388,66 +3947,16 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdatedBlockNumber |= int64(b&0x7F) << shift
+				m.TriggerCondition |= TriggerCondition(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthState
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReserveSnapshot: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReserveSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2457,30 +3966,31 @@ func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			m.TimestampMs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2490,83 +4000,29 @@ func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimestampMs |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DNRAllocation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DNRAllocation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DNRAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 2:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2593,8 +4049,7 @@ func (m *DNRAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,37 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TraderStatsRetentionEpochs is the number of DnR epochs, before the
+	// current one, whose trader statistics are kept.
+	TraderStatsRetentionEpochs uint64 = 12
+
+	// MaxTraderStatsPrunesPerBlock bounds the number of expired trader
+	// statistics deleted by a single end blocker.
+	MaxTraderStatsPrunesPerBlock uint64 = 100
+)
+
+func (s TraderStats) Validate() error {
+	if s.Volume.IsNil() || s.RealizedPnl.IsNil() || s.Fees.IsNil() {
+		return fmt.Errorf("nil value in trader stats: %s", s.String())
+	}
+	if s.Volume.IsNegative() {
+		return fmt.Errorf("trader volume cannot be negative: %s", s.Volume)
+	}
+	if s.Fees.IsNegative() {
+		return fmt.Errorf("trader fees cannot be negative: %s", s.Fees)
+	}
+	return nil
+}
+
+func (e GenesisState_TraderStatsEntry) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.Trader); err != nil {
+		return fmt.Errorf("invalid trader address %q: %w", e.Trader, err)
+	}
+	return e.Stats.Validate()
+}
@@ -359,13 +359,14 @@ func (s *IntegrationTestSuite) TestNewExitPoolCmd() {
 			expectedCoin4: sdk.ZeroInt(),
 		},
 		{ // Looks with a bug
-			name:          "exit pool with sufficient balance",
-			poolId:        poolID,
-			poolSharesOut: fmt.Sprintf("100000000000000000000nibiru/pool/%d", poolID),
+			name:   "exit pool with sufficient balance",
+			poolId: poolID,
+			// all of the creator's shares, i.e. the initial supply minus the locked minimum liquidity
+			poolSharesOut: fmt.Sprintf("99999900000000000000nibiru/pool/%d", poolID),
 			expectErr:     false,
 			expectedCode:  0,
-			expectedCoin3: sdk.NewInt(99), // Received coin-3 minus 1 exit pool fee
-			expectedCoin4: sdk.NewInt(99), // Received coin-4 minus 1 exit pool fee
+			expectedCoin3: sdk.NewInt(98), // Received coin-3 minus exit pool fee, rounded down
+			expectedCoin4: sdk.NewInt(98), // Received coin-4 minus exit pool fee, rounded down
 		},
 	}
 
@@ -476,13 +477,14 @@ func (s *IntegrationTestSuite) TestNewExitStablePoolCmd() {
 			expectedCoin5: sdk.ZeroInt(),
 		},
 		{ // Looks with a bug
-			name:          "exit pool with sufficient balance",
-			poolId:        poolID,
-			poolSharesOut: fmt.Sprintf("100000000000000000000nibiru/pool/%d", poolID),
+			name:   "exit pool with sufficient balance",
+			poolId: poolID,
+			// all of the creator's shares, i.e. the initial supply minus the locked minimum liquidity
+			poolSharesOut: fmt.Sprintf("99999900000000000000nibiru/pool/%d", poolID),
 			expectErr:     false,
 			expectedCode:  0,
-			expectedCoin3: sdk.NewInt(99), // Received coin-3 minus 1 exit pool fee
-			expectedCoin5: sdk.NewInt(99), // Received coin-5 minus 1 exit pool fee
+			expectedCoin3: sdk.NewInt(98), // Received coin-3 minus exit pool fee, rounded down
+			expectedCoin5: sdk.NewInt(98), // Received coin-5 minus exit pool fee, rounded down
 		},
 	}
 
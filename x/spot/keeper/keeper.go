@@ -371,8 +371,20 @@ func (k Keeper) NewPool(
 		return 0, err
 	}
 
-	// Mint the initial 100.000000000000000000 pool share tokens to the sender
-	newPoolShares, err := k.mintPoolShareToAccount(ctx, pool.Id, sender, types.InitPoolSharesSupply)
+	// Permanently lock the minimum liquidity by minting it to a burn address,
+	// so that the pool's share supply can never be fully redeemed.
+	lockedShares := params.MinimumLiquidity
+	if lockedShares.IsNil() {
+		lockedShares = sdk.ZeroInt()
+	}
+	if lockedShares.IsPositive() {
+		if _, err = k.mintPoolShareToAccount(ctx, pool.Id, types.LockedLiquidityAddress, lockedShares); err != nil {
+			return 0, err
+		}
+	}
+
+	// Mint the rest of the initial 100.000000000000000000 pool share tokens to the sender
+	newPoolShares, err := k.mintPoolShareToAccount(ctx, pool.Id, sender, types.InitPoolSharesSupply.Sub(lockedShares))
 	if err != nil {
 		return 0, err
 	}
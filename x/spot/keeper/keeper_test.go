@@ -231,6 +231,7 @@ func TestNewPool(t *testing.T) {
 			"uatom",
 			"uosmo",
 		},
+		/*minimumLiquidity=*/ sdk.NewInt(1000),
 	))
 
 	userAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
@@ -289,6 +290,140 @@ func TestNewPool(t *testing.T) {
 		TotalWeight: sdk.NewInt(2 << 30),
 		TotalShares: sdk.NewCoin("nibiru/pool/1", sdkmath.NewIntWithDecimal(100, 18)),
 	}, retrievedPool)
+
+	// the minimum liquidity is locked and the rest of the shares go to the creator
+	require.Equal(t,
+		sdk.NewCoin("nibiru/pool/1", sdk.NewInt(1000)),
+		app.BankKeeper.GetBalance(ctx, types.LockedLiquidityAddress, "nibiru/pool/1"),
+	)
+	require.Equal(t,
+		sdk.NewCoin("nibiru/pool/1", sdkmath.NewIntWithDecimal(100, 18).SubRaw(1000)),
+		app.BankKeeper.GetBalance(ctx, userAddr, "nibiru/pool/1"),
+	)
+}
+
+func TestNewPoolLocksMinimumLiquidity(t *testing.T) {
+	tests := []struct {
+		name             string
+		minimumLiquidity sdkmath.Int
+	}{
+		{name: "no minimum liquidity", minimumLiquidity: sdk.ZeroInt()},
+		{name: "default minimum liquidity", minimumLiquidity: types.DefaultParams().MinimumLiquidity},
+		{name: "large minimum liquidity", minimumLiquidity: sdkmath.NewIntWithDecimal(1, 18)},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+
+			poolCreationFeeCoin := sdk.NewInt64Coin(denoms.NIBI, 1000*common.TO_MICRO)
+			app.SpotKeeper.SetParams(ctx, types.NewParams(
+				/*startingPoolNumber=*/ 1,
+				/*poolCreationFee=*/ sdk.NewCoins(poolCreationFeeCoin),
+				/*whitelistedAssets*/ []string{"uatom", "uosmo"},
+				/*minimumLiquidity=*/ tc.minimumLiquidity,
+			))
+
+			userAddr := testutil.AccAddress()
+			require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, userAddr, sdk.NewCoins(
+				sdk.NewCoin("uatom", sdk.NewInt(1000)),
+				sdk.NewCoin("uosmo", sdk.NewInt(1000)),
+				poolCreationFeeCoin,
+			)))
+
+			poolId, err := app.SpotKeeper.NewPool(ctx,
+				userAddr,
+				types.PoolParams{
+					SwapFee:  sdk.NewDecWithPrec(3, 2),
+					ExitFee:  sdk.NewDecWithPrec(3, 2),
+					PoolType: types.PoolType_BALANCER,
+					A:        sdk.ZeroInt(),
+				},
+				[]types.PoolAsset{
+					{Token: sdk.NewCoin("uatom", sdk.NewInt(1000)), Weight: sdk.OneInt()},
+					{Token: sdk.NewCoin("uosmo", sdk.NewInt(1000)), Weight: sdk.OneInt()},
+				})
+			require.NoError(t, err)
+
+			shareDenom := types.GetPoolShareBaseDenom(poolId)
+			lockedShares := app.BankKeeper.GetBalance(ctx, types.LockedLiquidityAddress, shareDenom)
+			userShares := app.BankKeeper.GetBalance(ctx, userAddr, shareDenom)
+
+			require.Equal(t, tc.minimumLiquidity, lockedShares.Amount)
+			require.Equal(t, types.InitPoolSharesSupply, lockedShares.Amount.Add(userShares.Amount))
+			require.Equal(t, types.InitPoolSharesSupply, app.BankKeeper.GetSupply(ctx, shareDenom).Amount)
+		})
+	}
+}
+
+// TestMinimumLiquidityPreventsPoolDrain runs the attack the locked liquidity
+// guards against: the creator drains the pool, skews the price of what is left
+// with a small single asset deposit, and a later trader swaps at that price.
+func TestMinimumLiquidityPreventsPoolDrain(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+
+	poolCreationFeeCoin := sdk.NewInt64Coin(denoms.NIBI, 1000*common.TO_MICRO)
+	app.SpotKeeper.SetParams(ctx, types.NewParams(
+		/*startingPoolNumber=*/ 1,
+		/*poolCreationFee=*/ sdk.NewCoins(poolCreationFeeCoin),
+		/*whitelistedAssets*/ []string{"uatom", "uosmo"},
+		/*minimumLiquidity=*/ types.DefaultParams().MinimumLiquidity,
+	))
+
+	deposit := sdkmath.NewIntWithDecimal(1, 14)
+	attacker := testutil.AccAddress()
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, attacker, sdk.NewCoins(
+		sdk.NewCoin("uatom", deposit.Add(sdk.NewInt(1_000_000))),
+		sdk.NewCoin("uosmo", deposit),
+		poolCreationFeeCoin,
+	)))
+
+	poolId, err := app.SpotKeeper.NewPool(ctx,
+		attacker,
+		types.PoolParams{
+			SwapFee:  sdk.ZeroDec(),
+			ExitFee:  sdk.ZeroDec(),
+			PoolType: types.PoolType_BALANCER,
+			A:        sdk.ZeroInt(),
+		},
+		[]types.PoolAsset{
+			{Token: sdk.NewCoin("uatom", deposit), Weight: sdk.OneInt()},
+			{Token: sdk.NewCoin("uosmo", deposit), Weight: sdk.OneInt()},
+		})
+	require.NoError(t, err)
+
+	t.Log("the creator exits with all of their shares")
+	shareDenom := types.GetPoolShareBaseDenom(poolId)
+	_, err = app.SpotKeeper.ExitPool(ctx, attacker, poolId,
+		app.BankKeeper.GetBalance(ctx, attacker, shareDenom))
+	require.NoError(t, err)
+
+	// the locked shares keep one millionth of the deposit in the pool
+	pool, err := app.SpotKeeper.FetchPool(ctx, poolId)
+	require.NoError(t, err)
+	lockedReserve := deposit.Mul(types.DefaultParams().MinimumLiquidity).Quo(types.InitPoolSharesSupply)
+	require.EqualValues(t, sdk.NewInt(100_000_000), lockedReserve)
+	for _, poolAsset := range pool.PoolAssets {
+		require.True(t, poolAsset.Token.Amount.GTE(lockedReserve), poolAsset.Token)
+	}
+
+	t.Log("the creator skews the price with a small single asset deposit")
+	_, _, _, err = app.SpotKeeper.JoinPool(ctx, attacker, poolId,
+		sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1_000_000))), true)
+	require.NoError(t, err)
+
+	t.Log("a trader swaps at the price of the pool")
+	trader := testutil.AccAddress()
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, trader,
+		sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(10_000)))))
+	tokenOut, err := app.SpotKeeper.SwapExactAmountIn(ctx, trader, poolId,
+		sdk.NewCoin("uatom", sdk.NewInt(10_000)), "uosmo")
+	require.NoError(t, err)
+
+	// the price moved by about 1%, instead of by the ratio of the deposit to
+	// the dust left in a drained pool
+	require.True(t, tokenOut.Amount.GTE(sdk.NewInt(9_800)), tokenOut)
 }
 
 func TestNewPoolNotEnoughFunds(t *testing.T) {
@@ -298,6 +433,7 @@ func TestNewPoolNotEnoughFunds(t *testing.T) {
 		/*startingPoolNumber=*/ 1,
 		/*poolCreationFee=*/ sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1000*common.TO_MICRO)),
 		/*whitelistedAssets*/ []string{},
+		/*minimumLiquidity=*/ sdk.NewInt(1000),
 	))
 
 	userAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
@@ -442,6 +578,7 @@ func TestNewPoolDups(t *testing.T) {
 			"bar",
 			"foo",
 		},
+		/*minimumLiquidity=*/ sdk.NewInt(1000),
 	))
 
 	poolParams := types.PoolParams{
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+// Migrator runs the in-place store migrations of the spot module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 sets the MinimumLiquidity param. Chains started before the param
+// existed have no value for it, and reading the param set panics on the
+// missing key.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if !m.keeper.paramstore.Has(ctx, types.KeyMinimumLiquidity) {
+		m.keeper.paramstore.Set(ctx, types.KeyMinimumLiquidity, types.DefaultParams().MinimumLiquidity)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/spot/keeper"
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+
+	// remove the param, as on a chain started before it existed
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramStore.Delete(types.KeyMinimumLiquidity)
+	require.Panics(t, func() { app.SpotKeeper.GetParams(ctx) })
+
+	require.NoError(t, keeper.NewMigrator(app.SpotKeeper).Migrate2to3(ctx))
+	require.Equal(t, types.DefaultParams(), app.SpotKeeper.GetParams(ctx))
+
+	// a param that is already set is left unchanged
+	params := types.DefaultParams()
+	params.MinimumLiquidity = params.MinimumLiquidity.MulRaw(2)
+	app.SpotKeeper.SetParams(ctx, params)
+	require.NoError(t, keeper.NewMigrator(app.SpotKeeper).Migrate2to3(ctx))
+	require.Equal(t, params, app.SpotKeeper.GetParams(ctx))
+}
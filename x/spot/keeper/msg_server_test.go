@@ -426,24 +426,31 @@ func TestCreateExitJoinPool(t *testing.T) {
 			_, err = msgServer.ExitPool(sdk.WrapSDKContext(ctx), &msgExitPool)
 			require.NoError(t, err)
 
+			// the locked minimum liquidity keeps a remainder of each asset in the pool
+			lockedAssets := sdk.NewCoins(
+				sdk.NewInt64Coin(denoms.NUSD, 1),
+				sdk.NewInt64Coin(denoms.USDC, 1),
+			)
 			require.Equal(
 				t,
-				tc.senderInitialFunds.Sub(sdk.NewInt64Coin(denoms.NIBI, 1e9)),
+				tc.senderInitialFunds.Sub(sdk.NewInt64Coin(denoms.NIBI, 1e9)).Sub(lockedAssets...),
 				app.BankKeeper.GetAllBalances(ctx, tc.creatorAddr),
 			)
 
 			msgJoinPool := types.MsgJoinPool{
 				Sender:      tc.creatorAddr.String(),
 				PoolId:      1,
-				TokensIn:    tc.senderInitialFunds.Sub(sdk.NewInt64Coin(denoms.NIBI, 1e9)),
+				TokensIn:    tc.senderInitialFunds.Sub(sdk.NewInt64Coin(denoms.NIBI, 1e9)).Sub(lockedAssets...),
 				UseAllCoins: tc.useAllCoins,
 			}
 			_, err = msgServer.JoinPool(sdk.WrapSDKContext(ctx), &msgJoinPool)
 			require.NoError(t, err)
 
+			// rejoining with 999 of each asset, against the 1 of each asset backing
+			// the locked shares, mints 999 times the locked shares
 			require.Equal(
 				t,
-				sdk.NewCoins(poolShares),
+				sdk.NewCoins(sdk.NewCoin(poolShares.Denom, types.DefaultMinimumLiquidity.MulRaw(999))),
 				app.BankKeeper.GetAllBalances(ctx, tc.creatorAddr),
 			)
 		})
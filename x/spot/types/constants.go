@@ -2,6 +2,7 @@ package types
 
 import (
 	sdkmath "cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 const (
@@ -27,6 +28,15 @@ var (
 	// InitPoolSharesSupply is the amount of new shares to initialize a pool with.
 	InitPoolSharesSupply = OneDisplayPoolShare.MulRaw(100)
 
+	// DefaultMinimumLiquidity is the default amount of pool shares locked on
+	// pool creation: one millionth of the initial supply. Since every pool
+	// starts with the same share supply regardless of its deposit, the lock is
+	// sized relative to that supply, so that the locked shares keep one
+	// millionth of the initial deposit of each asset in the pool. A fixed
+	// amount like 1000 shares would only back 1e-17 of the deposit, which
+	// rounds down to nothing and lets the creator drain the pool.
+	DefaultMinimumLiquidity = InitPoolSharesSupply.QuoRaw(1_000_000)
+
 	// MaxUserSpecifiedWeight Pool creators can specify a weight in [1, MaxUserSpecifiedWeight)
 	// for every token in the balancer pool.
 	//
@@ -34,4 +44,9 @@ var (
 	// This is done so that LBP's / smooth weight changes can actually happen smoothly,
 	// without complex precision loss / edge effects.
 	MaxUserSpecifiedWeight = sdkmath.NewIntFromUint64(1 << 20)
+
+	// LockedLiquidityAddress is the burn address that receives the minimum
+	// liquidity locked on pool creation. No private key exists for this
+	// address, so shares sent to it can never be withdrawn.
+	LockedLiquidityAddress = authtypes.NewModuleAddress("spot-locked-liquidity")
 )
@@ -3,6 +3,7 @@ package types
 import (
 	fmt "fmt"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
@@ -13,17 +14,21 @@ import (
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// KeyMinimumLiquidity is the param store key of Params.MinimumLiquidity.
+var KeyMinimumLiquidity = []byte("MinimumLiquidity")
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams(startingPoolNumber uint64, poolCreationFee sdk.Coins, whitelistedAssets []string) Params {
+func NewParams(startingPoolNumber uint64, poolCreationFee sdk.Coins, whitelistedAssets []string, minimumLiquidity sdkmath.Int) Params {
 	return Params{
 		StartingPoolNumber: startingPoolNumber,
 		PoolCreationFee:    poolCreationFee,
 		WhitelistedAsset:   whitelistedAssets,
+		MinimumLiquidity:   minimumLiquidity,
 	}
 }
 
@@ -38,6 +43,7 @@ func DefaultParams() Params {
 			denoms.NUSD,
 			denoms.USDT,
 		},
+		MinimumLiquidity: DefaultMinimumLiquidity,
 	}
 }
 
@@ -47,6 +53,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair([]byte("StartingPoolNumber"), &p.StartingPoolNumber, validatePoolNumber),
 		paramtypes.NewParamSetPair([]byte("PoolCreationFee"), &p.PoolCreationFee, validatePoolCreationFee),
 		paramtypes.NewParamSetPair([]byte("WhitelistedAsset"), &p.WhitelistedAsset, func(value interface{}) error { return nil }),
+		paramtypes.NewParamSetPair(KeyMinimumLiquidity, &p.MinimumLiquidity, validateMinimumLiquidity),
 	}
 }
 
@@ -72,12 +79,40 @@ func validatePoolCreationFee(i interface{}) error {
 	return nil
 }
 
+func validateMinimumLiquidity(i interface{}) error {
+	v, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset minimum liquidity disables the lock
+	if v.IsNil() {
+		return nil
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("minimum liquidity must be non-negative: %s", v)
+	}
+
+	if v.GTE(InitPoolSharesSupply) {
+		return fmt.Errorf(
+			"minimum liquidity %s must be less than the initial pool share supply %s",
+			v, InitPoolSharesSupply)
+	}
+
+	return nil
+}
+
 // Validate validates the set of params
 func (p Params) Validate() error {
 	if err := validatePoolCreationFee(p.PoolCreationFee); err != nil {
 		return err
 	}
 
+	if err := validateMinimumLiquidity(p.MinimumLiquidity); err != nil {
+		return err
+	}
+
 	return nil
 }
 
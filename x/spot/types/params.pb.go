@@ -34,6 +34,10 @@ type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
 	// The assets that can be used to create liquidity pools
 	WhitelistedAsset []string `protobuf:"bytes,3,rep,name=whitelisted_asset,json=whitelistedAsset,proto3" json:"whitelisted_asset,omitempty"`
+	// The amount of pool shares permanently locked when a pool is created.
+	// These shares are sent to a burn address so that the first liquidity
+	// provider can never drain the pool through share-rounding attacks.
+	MinimumLiquidity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=minimum_liquidity,json=minimumLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minimum_liquidity" yaml:"minimum_liquidity"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -96,29 +100,32 @@ func init() {
 func init() { proto.RegisterFile("nibiru/spot/v1/params.proto", fileDescriptor_532c93f2cfe0dc59) }
 
 var fileDescriptor_532c93f2cfe0dc59 = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x3f, 0x4f, 0xc2, 0x40,
-	0x18, 0xc6, 0x5b, 0x20, 0x24, 0xd6, 0x44, 0xa5, 0x61, 0x28, 0x98, 0xb4, 0x84, 0xa9, 0xd1, 0xd8,
-	0xb3, 0xba, 0xb1, 0x09, 0xc6, 0xc9, 0x10, 0xc2, 0xe8, 0xd2, 0x5c, 0xcb, 0x59, 0x2e, 0xb6, 0x7d,
-	0x9b, 0xde, 0x81, 0x32, 0xfb, 0x05, 0x4c, 0x5c, 0x1c, 0x9d, 0xfd, 0x24, 0x8c, 0x8c, 0x4e, 0x68,
-	0xe0, 0x1b, 0xf8, 0x09, 0x4c, 0xef, 0xce, 0x84, 0xc4, 0xe9, 0xfe, 0xfc, 0xde, 0xe7, 0x7d, 0x9f,
-	0xbc, 0x8f, 0x71, 0x9c, 0xd1, 0x90, 0x16, 0x33, 0xc4, 0x72, 0xe0, 0x68, 0xee, 0xa3, 0x1c, 0x17,
-	0x38, 0x65, 0x5e, 0x5e, 0x00, 0x07, 0xf3, 0x40, 0x42, 0xaf, 0x84, 0xde, 0xdc, 0x6f, 0x37, 0x63,
-	0x88, 0x41, 0x20, 0x54, 0xde, 0x64, 0x55, 0xdb, 0x8e, 0x80, 0xa5, 0xc0, 0x50, 0x88, 0x19, 0x41,
-	0x73, 0x3f, 0x24, 0x1c, 0xfb, 0x28, 0x02, 0x9a, 0x29, 0xde, 0x92, 0x3c, 0x90, 0x42, 0xf9, 0x90,
-	0xa8, 0xfb, 0x5c, 0x31, 0xea, 0x23, 0x31, 0xd1, 0x3c, 0x37, 0x9a, 0x8c, 0xe3, 0x82, 0xd3, 0x2c,
-	0x0e, 0x72, 0x80, 0x24, 0xc8, 0x66, 0x69, 0x48, 0x0a, 0x4b, 0xef, 0xe8, 0x6e, 0x6d, 0x6c, 0xfe,
-	0xb1, 0x11, 0x40, 0x32, 0x14, 0xc4, 0x7c, 0xd5, 0x8d, 0x86, 0xa8, 0x8c, 0x0a, 0x82, 0x39, 0x85,
-	0x2c, 0xb8, 0x27, 0xc4, 0xaa, 0x74, 0xaa, 0xee, 0xfe, 0x45, 0xcb, 0x53, 0x73, 0x4a, 0x53, 0x9e,
-	0x32, 0xe5, 0x0d, 0x80, 0x66, 0xfd, 0xdb, 0xe5, 0xda, 0xd1, 0x7e, 0xd6, 0x8e, 0xb5, 0xc0, 0x69,
-	0xd2, 0xeb, 0xfe, 0xeb, 0xd0, 0xfd, 0xf8, 0x72, 0xdc, 0x98, 0xf2, 0xe9, 0x2c, 0xf4, 0x22, 0x48,
-	0x95, 0x61, 0x75, 0x9c, 0xb1, 0xc9, 0x03, 0xe2, 0x8b, 0x9c, 0x30, 0xd1, 0x8c, 0x8d, 0x0f, 0x4b,
-	0xfd, 0x40, 0xc9, 0x6f, 0x08, 0x31, 0x4f, 0x8d, 0xc6, 0xe3, 0x94, 0x72, 0x92, 0x50, 0xc6, 0xc9,
-	0x24, 0xc0, 0x8c, 0x11, 0x6e, 0x55, 0x3b, 0x55, 0x77, 0x6f, 0x7c, 0xb4, 0x03, 0xae, 0xca, 0xff,
-	0x5e, 0xed, 0xed, 0xdd, 0xd1, 0xfa, 0xd7, 0xcb, 0x8d, 0xad, 0xaf, 0x36, 0xb6, 0xfe, 0xbd, 0xb1,
-	0xf5, 0x97, 0xad, 0xad, 0xad, 0xb6, 0xb6, 0xf6, 0xb9, 0xb5, 0xb5, 0xbb, 0x93, 0x1d, 0x1f, 0x43,
-	0x91, 0xc5, 0x60, 0x8a, 0x69, 0x86, 0x54, 0x68, 0x4f, 0x32, 0x36, 0xe1, 0x27, 0xac, 0x8b, 0x95,
-	0x5e, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xe7, 0xf4, 0x89, 0x01, 0xd2, 0x01, 0x00, 0x00,
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0xeb, 0xd3, 0x40,
+	0x18, 0xc6, 0x93, 0xb6, 0x14, 0x1a, 0x41, 0xdb, 0xd0, 0x21, 0xad, 0x90, 0x84, 0x0c, 0x12, 0x14,
+	0x73, 0x46, 0xb7, 0x6e, 0xb6, 0x22, 0x28, 0xa5, 0x94, 0x8c, 0x2e, 0xe1, 0x92, 0x9e, 0xe9, 0x61,
+	0xee, 0x2e, 0xe6, 0x2e, 0xad, 0xfd, 0x16, 0x82, 0x8b, 0xa3, 0xb3, 0x9f, 0xa4, 0x63, 0x47, 0x71,
+	0xa8, 0xd2, 0x7e, 0x03, 0x67, 0x07, 0xc9, 0xe5, 0x8a, 0x85, 0x2e, 0xff, 0x29, 0xb9, 0xf7, 0xf7,
+	0xbe, 0xef, 0xf3, 0x1c, 0xf7, 0x18, 0x0f, 0x29, 0x4e, 0x70, 0x59, 0x01, 0x5e, 0x30, 0x01, 0x36,
+	0x21, 0x28, 0x60, 0x09, 0x09, 0x0f, 0x8a, 0x92, 0x09, 0x66, 0xde, 0x6f, 0x60, 0x50, 0xc3, 0x60,
+	0x13, 0x8e, 0x87, 0x19, 0xcb, 0x98, 0x44, 0xa0, 0xfe, 0x6b, 0xba, 0xc6, 0x76, 0xca, 0x38, 0x61,
+	0x1c, 0x24, 0x90, 0x23, 0xb0, 0x09, 0x13, 0x24, 0x60, 0x08, 0x52, 0x86, 0xa9, 0xe2, 0xa3, 0x86,
+	0xc7, 0xcd, 0x60, 0x73, 0x68, 0x90, 0xf7, 0xb7, 0x65, 0x74, 0x97, 0x52, 0xd1, 0x7c, 0x66, 0x0c,
+	0xb9, 0x80, 0xa5, 0xc0, 0x34, 0x8b, 0x0b, 0xc6, 0xf2, 0x98, 0x56, 0x24, 0x41, 0xa5, 0xa5, 0xbb,
+	0xba, 0xdf, 0x89, 0xcc, 0x0b, 0x5b, 0x32, 0x96, 0x2f, 0x24, 0x31, 0xbf, 0xe8, 0xc6, 0x40, 0x76,
+	0xa6, 0x25, 0x82, 0x02, 0x33, 0x1a, 0xbf, 0x47, 0xc8, 0x6a, 0xb9, 0x6d, 0xff, 0xde, 0xf3, 0x51,
+	0xa0, 0x74, 0x6a, 0x53, 0x81, 0x32, 0x15, 0xcc, 0x18, 0xa6, 0xd3, 0xf9, 0xfe, 0xe8, 0x68, 0x7f,
+	0x8e, 0x8e, 0xb5, 0x83, 0x24, 0x9f, 0x78, 0x37, 0x1b, 0xbc, 0xef, 0xbf, 0x1c, 0x3f, 0xc3, 0x62,
+	0x5d, 0x25, 0x41, 0xca, 0x88, 0x32, 0xac, 0x3e, 0x4f, 0xf9, 0xea, 0x03, 0x10, 0xbb, 0x02, 0x71,
+	0xb9, 0x8c, 0x47, 0x0f, 0xea, 0xf9, 0x99, 0x1a, 0x7f, 0x8d, 0x90, 0xf9, 0xc4, 0x18, 0x6c, 0xd7,
+	0x58, 0xa0, 0x1c, 0x73, 0x81, 0x56, 0x31, 0xe4, 0x1c, 0x09, 0xab, 0xed, 0xb6, 0xfd, 0x5e, 0xd4,
+	0xbf, 0x02, 0x2f, 0xeb, 0xba, 0xb9, 0x35, 0x06, 0x04, 0x53, 0x4c, 0x2a, 0x12, 0xe7, 0xf8, 0x63,
+	0x85, 0x57, 0x58, 0xec, 0xac, 0x8e, 0xab, 0xfb, 0xbd, 0xe9, 0xdb, 0xda, 0xe6, 0xcf, 0xa3, 0xf3,
+	0xe8, 0x0e, 0x56, 0xde, 0x50, 0xf1, 0xff, 0x42, 0x37, 0x0b, 0xbd, 0xa8, 0xaf, 0x6a, 0xf3, 0x4b,
+	0x69, 0xd2, 0xf9, 0xfa, 0xcd, 0xd1, 0xa6, 0xaf, 0xf6, 0x27, 0x5b, 0x3f, 0x9c, 0x6c, 0xfd, 0xf7,
+	0xc9, 0xd6, 0x3f, 0x9f, 0x6d, 0xed, 0x70, 0xb6, 0xb5, 0x1f, 0x67, 0x5b, 0x7b, 0xf7, 0xf8, 0x4a,
+	0x75, 0x21, 0x43, 0x30, 0x5b, 0x43, 0x4c, 0x81, 0x4a, 0xcb, 0xa7, 0x26, 0x2f, 0x52, 0x3d, 0xe9,
+	0xca, 0xb7, 0x7c, 0xf1, 0x6f, 0x00, 0xc6, 0x79, 0xb3, 0xca, 0x4b, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -141,6 +148,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinimumLiquidity.Size()
+		i -= size
+		if _, err := m.MinimumLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.WhitelistedAsset) > 0 {
 		for iNdEx := len(m.WhitelistedAsset) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.WhitelistedAsset[iNdEx])
@@ -204,6 +221,8 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = m.MinimumLiquidity.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -327,6 +346,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.WhitelistedAsset = append(m.WhitelistedAsset, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
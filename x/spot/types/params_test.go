@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	withMinimumLiquidity := func(amount sdk.Int) types.Params {
+		params := types.DefaultParams()
+		params.MinimumLiquidity = amount
+		return params
+	}
+
+	testCases := []struct {
+		name     string
+		params   types.Params
+		expError bool
+	}{
+		{name: "default", params: types.DefaultParams(), expError: false},
+		{name: "zero minimum liquidity", params: withMinimumLiquidity(sdk.ZeroInt()), expError: false},
+		{name: "unset minimum liquidity", params: withMinimumLiquidity(sdk.Int{}), expError: false},
+		{name: "negative minimum liquidity", params: withMinimumLiquidity(sdk.NewInt(-1)), expError: true},
+		{name: "minimum liquidity equal to initial supply", params: withMinimumLiquidity(types.InitPoolSharesSupply), expError: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
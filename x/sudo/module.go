@@ -15,6 +15,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/sudo/cli"
 	sudokeeper "github.com/NibiruChain/nibiru/x/sudo/keeper"
 	simulation "github.com/NibiruChain/nibiru/x/sudo/simulation"
@@ -118,7 +119,7 @@ func (am AppModule) Name() string {
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), sudokeeper.NewQuerier(am.keeper))
-	types.RegisterMsgServer(cfg.MsgServer(), sudokeeper.NewMsgServer(am.keeper))
+	types.RegisterMsgServer(common.RecoverMsgServer(cfg.MsgServer()), sudokeeper.NewMsgServer(am.keeper))
 }
 
 // RegisterInvariants registers the capability module's invariants.
@@ -25,6 +25,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/tokenfactory/cli"
 	"github.com/NibiruChain/nibiru/x/tokenfactory/keeper"
 	"github.com/NibiruChain/nibiru/x/tokenfactory/simulation"
@@ -145,7 +146,7 @@ func (am AppModule) QuerierRoute() string {
 // RegisterServices registers a GRPC query service to respond to the
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
+	types.RegisterMsgServer(common.RecoverMsgServer(cfg.MsgServer()), am.keeper)
 	types.RegisterQueryServer(
 		cfg.QueryServer(), am.keeper.Querier(),
 	)